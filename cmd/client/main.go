@@ -2,24 +2,72 @@ package main
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
 	"github.com/gdamore/tcell/v2"
 
 	"github.com/ShukinDmitriy/GophKeeper/internal/client"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/breach"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/cli"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/clipboard"
 	"github.com/ShukinDmitriy/GophKeeper/internal/client/config"
 	"github.com/ShukinDmitriy/GophKeeper/internal/client/event"
 	"github.com/ShukinDmitriy/GophKeeper/internal/client/http"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/session"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/sshagent"
 	"github.com/ShukinDmitriy/GophKeeper/internal/client/tui"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/version"
 	appLogger "github.com/ShukinDmitriy/GophKeeper/internal/logger"
 )
 
 func main() {
-	conf, err := config.NewConfig()
+	osArgs := os.Args[1:]
+	// Git запускает helper credential.helper=gophkeeper как git-credential-gophkeeper <действие>
+	if strings.HasPrefix(filepath.Base(os.Args[0]), "git-credential-") {
+		osArgs = append([]string{cli.GitCredentialCommand}, osArgs...)
+	}
+
+	// До чтения конфигурации язык берется из окружения, чтобы ее ошибки тоже были переведены
+	i18n.SetLocale(i18n.Detect(""))
+	profile, args, err := config.ProfileArg(osArgs)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(cli.ExitUsage)
+	}
+	if config.VersionArg(args) {
+		fmt.Println(version.Get())
+		return
+	}
+
+	conf, err := config.Load(profile)
 	if err != nil {
-		panic(err)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(cli.ExitError)
 	}
+	i18n.SetLocale(i18n.Detect(conf.Locale))
 	appLog := appLogger.NewLogger(conf.LogLevel, conf.LogPath)
-	httpClient := http.NewClient(conf, appLog)
+	httpClient, err := http.NewClient(conf, appLog)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(cli.ExitError)
+	}
+
+	clipboardService := clipboard.NewClipboard()
+
+	// Без команды или с командой tui запускается консольный интерфейс, остальные команды выполняются без него
+	if len(args) > 0 && args[0] != "tui" {
+		sessionStore, err := session.NewStore(conf.Profile)
+		if err != nil {
+			appLog.Fatal("Failed to create session store", err)
+		}
+		commands := cli.NewCLI(conf, httpClient, sessionStore, clipboardService, os.Stdin, os.Stdout, os.Stderr)
+		os.Exit(commands.Run(context.Background(), args))
+	}
+
 	eventBus := event.NewObservable()
 	screen, err := tcell.NewScreen()
 	if err != nil {
@@ -30,13 +78,51 @@ func main() {
 		appLog.Fatal("Failed to create tuiService")
 		return
 	}
+	tuiService.SetClipboard(clipboardService, conf.ClipboardTimeout)
+	tuiService.SetLockTimeout(conf.LockTimeout)
+	tuiService.SetRevealTimeout(conf.RevealTimeout)
+	if conf.BreachFile != "" {
+		breachList, err := breach.Open(conf.BreachFile)
+		if err != nil {
+			appLog.Error("Failed to open breach list ", err.Error())
+		} else {
+			defer breachList.Close()
+			tuiService.SetBreachList(breachList)
+		}
+	}
 	tClient := client.NewClient(
 		appLog,
 		conf,
 		eventBus,
 		httpClient,
+		session.NewStore,
 		tuiService,
 	)
+	if conf.SSHAgent.Enabled {
+		var confirm sshagent.ConfirmFunc
+		if conf.SSHAgent.Confirm {
+			confirm = tuiService.ConfirmSSHSign
+		}
+		socketPath := conf.SSHAgent.Socket
+		if socketPath == "" {
+			socketPath = sshagent.DefaultSocketPath()
+		}
+
+		sshAgent := sshagent.New(confirm)
+		listener, err := sshagent.Listen(socketPath)
+		if err != nil {
+			appLog.Error("Failed to start ssh agent ", err.Error())
+		} else {
+			defer listener.Close()
+			go func() {
+				if err := sshAgent.Serve(listener); err != nil {
+					appLog.Error("ssh agent stopped ", err.Error())
+				}
+			}()
+			tClient.SetSSHAgent(sshAgent)
+			appLog.Info("SSH agent is listening on " + socketPath)
+		}
+	}
 
 	ctx := context.Background()
 	appLog.Info("Running GophKeeper client")
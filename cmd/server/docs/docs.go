@@ -15,6 +15,105 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
+        "/admin/users/{id}/quota": {
+            "get": {
+                "description": "Использование хранилища и действующие лимиты пользователя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserUsage"
+                        }
+                    },
+                    "400": {
+                        "description": "BadRequest"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not found"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            },
+            "put": {
+                "description": "Задать индивидуальные лимиты пользователя, пустое значение - лимит по умолчанию",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "parameters": [
+                    {
+                        "type": "number",
+                        "description": "id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.UserQuota"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserUsage"
+                        }
+                    },
+                    "400": {
+                        "description": "BadRequest"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "403": {
+                        "description": "Forbidden"
+                    },
+                    "404": {
+                        "description": "Not found"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
         "/data": {
             "get": {
                 "description": "Получение списка данных",
@@ -34,7 +133,12 @@ const docTemplate = `{
                             1,
                             2,
                             3,
-                            4
+                            4,
+                            5,
+                            6,
+                            7,
+                            8,
+                            9
                         ],
                         "type": "integer",
                         "x-enum-varnames": [
@@ -42,7 +146,12 @@ const docTemplate = `{
                             "DataTypeCredentials",
                             "DataTypeText",
                             "DataTypeBinary",
-                            "DataTypeBankCard"
+                            "DataTypeBankCard",
+                            "DataTypeSSHKey",
+                            "DataTypeIdentity",
+                            "DataTypeNote",
+                            "DataTypeWiFi",
+                            "DataTypeAPIKey"
                         ],
                         "name": "type",
                         "in": "query"
@@ -112,6 +221,133 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "413": {
+                        "description": "Item too large"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    },
+                    "507": {
+                        "description": "Quota exceeded"
+                    }
+                }
+            }
+        },
+        "/data/batch": {
+            "post": {
+                "description": "Выполнить пакет операций создания, изменения и удаления данных в одной транзакции.\nВ атомарном режиме (atomic) ошибка любой операции откатывает весь пакет.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Data"
+                ],
+                "parameters": [
+                    {
+                        "description": "data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/requests.DataBatch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Все операции выполнены",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DataBatchResult"
+                            }
+                        }
+                    },
+                    "207": {
+                        "description": "Часть операций завершилась ошибкой",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DataBatchResult"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "BadRequest"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "413": {
+                        "description": "Item too large"
+                    },
+                    "422": {
+                        "description": "Пакет откачен",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/models.DataBatchResult"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    },
+                    "507": {
+                        "description": "Quota exceeded"
+                    }
+                }
+            }
+        },
+        "/data/expiring": {
+            "get": {
+                "description": "Получение списка данных, которые истекли или истекут в ближайшие days дней",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Data"
+                ],
+                "parameters": [
+                    {
+                        "maximum": 3650,
+                        "minimum": 0,
+                        "type": "integer",
+                        "name": "days",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "name": "user_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/models.DataInfo"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "BadRequest"
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
                     "500": {
                         "description": "Internal server error"
                     }
@@ -199,8 +435,14 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized"
                     },
+                    "413": {
+                        "description": "Item too large"
+                    },
                     "500": {
                         "description": "Internal server error"
+                    },
+                    "507": {
+                        "description": "Quota exceeded"
                     }
                 }
             },
@@ -279,6 +521,31 @@ const docTemplate = `{
                 }
             }
         },
+        "/user/refresh": {
+            "post": {
+                "description": "Обновление токенов по refresh токену",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/responses.UserInfo"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
         "/user/register": {
             "post": {
                 "description": "Регистрация пользователя",
@@ -320,9 +587,122 @@ const docTemplate = `{
                     }
                 }
             }
+        },
+        "/user/usage": {
+            "get": {
+                "description": "Использование хранилища пользователем и его лимиты",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/models.UserUsage"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized"
+                    },
+                    "500": {
+                        "description": "Internal server error"
+                    }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "Версия сервера и API",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Version"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/version.Info"
+                        }
+                    }
+                }
+            }
         }
     },
     "definitions": {
+        "models.DataBatchOperationType": {
+            "type": "string",
+            "enum": [
+                "create",
+                "update",
+                "delete"
+            ],
+            "x-enum-varnames": [
+                "DataBatchCreate",
+                "DataBatchUpdate",
+                "DataBatchDelete"
+            ]
+        },
+        "models.DataBatchResult": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "$ref": "#/definitions/models.ErrorCode"
+                },
+                "data": {
+                    "$ref": "#/definitions/models.DataInfo"
+                },
+                "fields": {
+                    "description": "Fields - поля операции, не прошедшие проверку, и нарушенное правило",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "op": {
+                    "$ref": "#/definitions/models.DataBatchOperationType"
+                },
+                "status": {
+                    "type": "integer"
+                }
+            }
+        },
+        "models.DataField": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "maximum": 4,
+                    "minimum": 0,
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.FieldType"
+                        }
+                    ]
+                },
+                "value": {
+                    "type": "string"
+                }
+            }
+        },
         "models.DataInfo": {
             "type": "object",
             "required": [
@@ -333,12 +713,37 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "expires_at": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataField"
+                    }
+                },
                 "id": {
                     "type": "integer"
                 },
+                "password_changed_at": {
+                    "description": "PasswordChangedAt - когда последний раз менялось значение записи (сам секрет)",
+                    "type": "string"
+                },
+                "rotate_at": {
+                    "type": "string"
+                },
                 "type": {
                     "$ref": "#/definitions/models.DataType"
                 },
+                "updated_at": {
+                    "type": "string"
+                },
+                "urls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataURL"
+                    }
+                },
                 "value": {
                     "type": "string"
                 }
@@ -351,16 +756,180 @@ const docTemplate = `{
                 1,
                 2,
                 3,
-                4
+                4,
+                5,
+                6,
+                7,
+                8,
+                9
             ],
             "x-enum-varnames": [
                 "DataTypeUnknown",
                 "DataTypeCredentials",
                 "DataTypeText",
                 "DataTypeBinary",
-                "DataTypeBankCard"
+                "DataTypeBankCard",
+                "DataTypeSSHKey",
+                "DataTypeIdentity",
+                "DataTypeNote",
+                "DataTypeWiFi",
+                "DataTypeAPIKey"
             ]
         },
+        "models.DataURL": {
+            "type": "object",
+            "required": [
+                "url"
+            ],
+            "properties": {
+                "match": {
+                    "maximum": 5,
+                    "minimum": 0,
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.URLMatch"
+                        }
+                    ]
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "models.ErrorCode": {
+            "type": "string",
+            "enum": [
+                "bad_request",
+                "validation_failed",
+                "unauthorized",
+                "invalid_credentials",
+                "user_exists",
+                "forbidden",
+                "not_found",
+                "item_too_large",
+                "quota_exceeded",
+                "internal",
+                "batch_rolled_back"
+            ],
+            "x-enum-varnames": [
+                "ErrorCodeBadRequest",
+                "ErrorCodeValidation",
+                "ErrorCodeUnauthorized",
+                "ErrorCodeInvalidCredentials",
+                "ErrorCodeUserExists",
+                "ErrorCodeForbidden",
+                "ErrorCodeNotFound",
+                "ErrorCodeItemTooLarge",
+                "ErrorCodeQuotaExceeded",
+                "ErrorCodeInternal",
+                "ErrorCodeBatchRolledBack"
+            ]
+        },
+        "models.FieldType": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2,
+                3,
+                4
+            ],
+            "x-enum-varnames": [
+                "FieldTypeText",
+                "FieldTypeHidden",
+                "FieldTypeURL",
+                "FieldTypeDate",
+                "FieldTypeBoolean"
+            ]
+        },
+        "models.URLMatch": {
+            "type": "integer",
+            "enum": [
+                0,
+                1,
+                2,
+                3,
+                4,
+                5
+            ],
+            "x-enum-varnames": [
+                "URLMatchDomain",
+                "URLMatchHost",
+                "URLMatchStartsWith",
+                "URLMatchExact",
+                "URLMatchRegexp",
+                "URLMatchNever"
+            ]
+        },
+        "models.UserUsage": {
+            "type": "object",
+            "properties": {
+                "bytes": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "integer"
+                },
+                "max_bytes": {
+                    "type": "integer"
+                },
+                "max_item_size": {
+                    "type": "integer"
+                },
+                "max_items": {
+                    "type": "integer"
+                }
+            }
+        },
+        "requests.DataBatch": {
+            "type": "object",
+            "required": [
+                "operations",
+                "user_id"
+            ],
+            "properties": {
+                "atomic": {
+                    "type": "boolean"
+                },
+                "operations": {
+                    "description": "Operations - операции пакета, не больше DataBatchMaxOperations",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/requests.DataBatchOperation"
+                    }
+                },
+                "user_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "requests.DataBatchOperation": {
+            "type": "object",
+            "required": [
+                "op"
+            ],
+            "properties": {
+                "data": {
+                    "$ref": "#/definitions/requests.DataModel"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "op": {
+                    "enum": [
+                        "create",
+                        "update",
+                        "delete"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/models.DataBatchOperationType"
+                        }
+                    ]
+                }
+            }
+        },
         "requests.DataModel": {
             "type": "object",
             "required": [
@@ -371,9 +940,27 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "expires_at": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataField"
+                    }
+                },
+                "rotate_at": {
+                    "type": "string"
+                },
                 "type": {
                     "$ref": "#/definitions/models.DataType"
                 },
+                "urls": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/models.DataURL"
+                    }
+                },
                 "user_id": {
                     "type": "integer"
                 },
@@ -401,6 +988,23 @@ const docTemplate = `{
                 }
             }
         },
+        "requests.UserQuota": {
+            "type": "object",
+            "properties": {
+                "max_bytes": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "max_item_size": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "max_items": {
+                    "type": "integer",
+                    "minimum": 0
+                }
+            }
+        },
         "requests.UserRegister": {
             "type": "object",
             "required": [
@@ -433,6 +1037,23 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "version.Info": {
+            "type": "object",
+            "properties": {
+                "api_version": {
+                    "type": "integer"
+                },
+                "build_time": {
+                    "type": "string"
+                },
+                "commit": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
         }
     }
 }`
@@ -2,8 +2,10 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
 	"database/sql"
 	"errors"
+	"fmt"
 	"net/http"
 	"os"
 	"path"
@@ -11,10 +13,12 @@ import (
 	appLogger "github.com/ShukinDmitriy/GophKeeper/internal/logger"
 
 	_ "github.com/ShukinDmitriy/GophKeeper/cmd/server/docs"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/version"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/auth"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/config"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/controllers"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/quota"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/repositories"
 	"github.com/golang-migrate/migrate/v4"
 	migratePostgres "github.com/golang-migrate/migrate/v4/database/postgres"
@@ -32,6 +36,11 @@ import (
 // @version 1.0
 // @BasePath /api
 func main() {
+	if len(os.Args) > 1 && (os.Args[1] == "--version" || os.Args[1] == "-version") {
+		fmt.Println(version.Get())
+		return
+	}
+
 	fx.New(
 		fx.Provide(
 			// Конфигурация
@@ -86,15 +95,23 @@ func main() {
 			repositories.NewUserRepository,
 			// данных
 			repositories.NewDataRepository,
+			// квот
+			repositories.NewQuotaRepository,
 			// Аутентификация
 			auth.NewAuthUser,
 			// Сервис работы с аутентификацией
 			auth.NewAuthService,
+			// Сервис квот пользователей
+			quota.NewQuotaService,
 			// Контроллеры:
 			// пользователя
 			controllers.NewUserController,
 			// данных
 			controllers.NewDataController,
+			// администрирования
+			controllers.NewAdminController,
+			// версии
+			controllers.NewVersionController,
 			// http сервер
 			func(
 				lc fx.Lifecycle,
@@ -103,12 +120,16 @@ func main() {
 				authService *auth.AuthService,
 				userController *controllers.UserController,
 				dataController *controllers.DataController,
+				adminController *controllers.AdminController,
+				versionController *controllers.VersionController,
 			) *echo.Echo {
 				httpServer := server.NewHTTPServer(
 					conf,
 					authService,
 					userController,
 					dataController,
+					adminController,
+					versionController,
 				)
 
 				lc.Append(fx.Hook{
@@ -116,7 +137,14 @@ func main() {
 						go func() {
 							var err error
 							if conf.EnableHTTPS {
-								err = httpServer.StartTLS(conf.RunAddress, "ssl/localhost.crt", "ssl/device.key")
+								var tlsConfig *tls.Config
+								tlsConfig, err = server.NewTLSConfig(conf)
+								if err == nil {
+									err = httpServer.StartServer(&http.Server{
+										Addr:      conf.RunAddress,
+										TLSConfig: tlsConfig,
+									})
+								}
 							} else {
 								err = httpServer.Start(conf.RunAddress)
 							}
@@ -126,7 +154,7 @@ func main() {
 								return
 							}
 
-							appLog.Info("Running GophKeeper")
+							appLog.Info("Running GophKeeper ", version.Get())
 						}()
 
 						return nil
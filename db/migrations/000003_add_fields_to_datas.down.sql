@@ -0,0 +1,3 @@
+alter table datas
+    drop column if exists urls,
+    drop column if exists fields;
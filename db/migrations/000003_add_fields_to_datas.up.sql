@@ -0,0 +1,3 @@
+alter table datas
+    add column if not exists fields jsonb not null default '[]'::jsonb,
+    add column if not exists urls   jsonb not null default '[]'::jsonb;
@@ -0,0 +1,6 @@
+drop index if exists idx_datas_rotate_at;
+drop index if exists idx_datas_expires_at;
+
+alter table datas
+    drop column if exists rotate_at,
+    drop column if exists expires_at;
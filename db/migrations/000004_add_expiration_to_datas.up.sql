@@ -0,0 +1,9 @@
+alter table datas
+    add column if not exists expires_at timestamptz,
+    add column if not exists rotate_at  timestamptz;
+
+create index if not exists idx_datas_expires_at
+    on datas (expires_at) where expires_at is not null;
+
+create index if not exists idx_datas_rotate_at
+    on datas (rotate_at) where rotate_at is not null;
@@ -0,0 +1,4 @@
+drop table if exists user_quotas;
+
+alter table datas
+    drop column if exists size;
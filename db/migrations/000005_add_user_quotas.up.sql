@@ -0,0 +1,24 @@
+alter table datas
+    add column if not exists size bigint not null default 0;
+
+-- размер считается так же, как DataModel.Size()
+update datas
+set size = octet_length(value)
+    + coalesce(octet_length(description), 0)
+    + coalesce((select sum(octet_length(coalesce(field ->> 'name', '')) + octet_length(coalesce(field ->> 'value', '')))
+                from jsonb_array_elements(fields) as field), 0)
+    + coalesce((select sum(octet_length(coalesce(url ->> 'url', '')))
+                from jsonb_array_elements(urls) as url), 0);
+
+create table if not exists user_quotas
+(
+    user_id       bigint not null
+        primary key
+        constraint fk_user_quotas_users
+            references users,
+    max_items     bigint,
+    max_bytes     bigint,
+    max_item_size bigint,
+    created_at    timestamp with time zone,
+    updated_at    timestamp with time zone
+);
@@ -0,0 +1,2 @@
+alter table datas
+    drop column if exists password_changed_at;
@@ -0,0 +1,6 @@
+alter table datas
+    add column if not exists password_changed_at timestamptz;
+
+update datas
+set password_changed_at = updated_at
+where password_changed_at is null;
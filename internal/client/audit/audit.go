@@ -0,0 +1,150 @@
+// Package audit содержит проверку хранилища: слабые, повторяющиеся и давно не менявшиеся пароли.
+// Проверка выполняется на клиенте по расшифрованным записям.
+package audit
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"slices"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// Параметры проверки по умолчанию
+const (
+	DefaultMinEntropy = 50
+	DefaultMaxAgeDays = 90
+)
+
+// Options - параметры проверки
+type Options struct {
+	// MinEntropy - пароли с меньшей энтропией считаются слабыми
+	MinEntropy float64
+	// MaxAgeDays - сколько дней пароль может не меняться
+	MaxAgeDays int
+}
+
+// DefaultOptions - параметры проверки по умолчанию
+func DefaultOptions() Options {
+	return Options{
+		MinEntropy: DefaultMinEntropy,
+		MaxAgeDays: DefaultMaxAgeDays,
+	}
+}
+
+// Entry - учетные данные в отчете. Сам пароль в отчет не попадает.
+type Entry struct {
+	ID          uint   `json:"id"`
+	Description string `json:"description"`
+	Login       string `json:"login"`
+	// PasswordChangedAt - когда последний раз менялся пароль
+	PasswordChangedAt time.Time `json:"password_changed_at"`
+	AgeDays           int       `json:"age_days"`
+	Strength          Strength  `json:"strength"`
+}
+
+// Report - отчет о состоянии хранилища
+type Report struct {
+	Total  int       `json:"total"`
+	Weak   []Entry   `json:"weak"`
+	Reused [][]Entry `json:"reused"`
+	Old    []Entry   `json:"old"`
+	// Score - доля учетных данных без замечаний, в процентах
+	Score int `json:"score"`
+}
+
+// Run - проверить учетные данные из dataList, записи других типов пропускаются
+func Run(dataList []models.DataInfo, now time.Time, opts Options) (Report, error) {
+	report := Report{
+		Weak:   []Entry{},
+		Reused: [][]Entry{},
+		Old:    []Entry{},
+	}
+
+	entries := make(map[uint]Entry)
+	issues := make(map[uint]bool)
+	groups := make(map[[sha256.Size]byte][]Entry)
+	var order [][sha256.Size]byte
+
+	for _, data := range dataList {
+		if data.Type != models.DataTypeCredentials {
+			continue
+		}
+		value := models.CredentialsValue{}
+		if err := models.DecodeValue(data.Value, &value); err != nil {
+			return report, err
+		}
+		report.Total++
+
+		entry := Entry{
+			ID:                data.ID,
+			Description:       data.Description,
+			Login:             value.Login,
+			PasswordChangedAt: data.PasswordChanged(),
+			AgeDays:           int(now.Sub(data.PasswordChanged()).Hours() / 24),
+			Strength:          Estimate(value.Password),
+		}
+		entries[data.ID] = entry
+
+		if value.Password != "" {
+			if entry.Strength.Entropy < opts.MinEntropy {
+				report.Weak = append(report.Weak, entry)
+				issues[data.ID] = true
+			}
+
+			sum := sha256.Sum256([]byte(value.Password))
+			if _, ok := groups[sum]; !ok {
+				order = append(order, sum)
+			}
+			groups[sum] = append(groups[sum], entry)
+		}
+	}
+
+	for _, sum := range order {
+		if len(groups[sum]) < 2 {
+			continue
+		}
+		report.Reused = append(report.Reused, groups[sum])
+		for _, entry := range groups[sum] {
+			issues[entry.ID] = true
+		}
+	}
+
+	for _, data := range Stale(dataList, opts.MaxAgeDays, now) {
+		if entry, ok := entries[data.ID]; ok {
+			report.Old = append(report.Old, entry)
+			issues[data.ID] = true
+		}
+	}
+
+	slices.SortStableFunc(report.Weak, func(a, b Entry) int {
+		return cmp.Compare(a.Strength.Entropy, b.Strength.Entropy)
+	})
+
+	report.Score = 100
+	if report.Total > 0 {
+		report.Score = 100 * (report.Total - len(issues)) / report.Total
+	}
+
+	return report, nil
+}
+
+// Stale - записи, пароль которых не менялся days дней или срок смены которых прошел.
+// Результат отсортирован от давно измененных к недавним.
+func Stale(dataList []models.DataInfo, days int, now time.Time) []models.DataInfo {
+	deadline := now.AddDate(0, 0, -days)
+
+	res := make([]models.DataInfo, 0, len(dataList))
+	for _, data := range dataList {
+		if data.PasswordChanged().Before(deadline) || data.Expired(now) {
+			res = append(res, data)
+		}
+	}
+
+	slices.SortStableFunc(res, func(a, b models.DataInfo) int {
+		return a.PasswordChanged().Compare(b.PasswordChanged())
+	})
+
+	return res
+}
@@ -0,0 +1,100 @@
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/audit"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/stretchr/testify/assert"
+)
+
+// credentials - учетные данные с паролем password, измененные в updatedAt
+func credentials(t *testing.T, id uint, password string, updatedAt time.Time) models.DataInfo {
+	value, err := models.EncodeValue(models.CredentialsValue{Login: "user", Password: password})
+	assert.Nil(t, err)
+
+	return models.DataInfo{ID: id, Type: models.DataTypeCredentials, Value: value, UpdatedAt: updatedAt}
+}
+
+func TestEstimate(t *testing.T) {
+	tests := []struct {
+		password string
+		weak     bool
+		patterns []string
+	}{
+		{password: "password", weak: true, patterns: []string{audit.PatternCommon}},
+		{password: "aaaaaaaaaaaa", weak: true, patterns: []string{audit.PatternRepeat}},
+		{password: "abcdefgh123456", weak: true, patterns: []string{audit.PatternSequence}},
+		{password: "asdfghjkl", weak: true, patterns: []string{audit.PatternKeyboard}},
+		{password: "Dragon2024", weak: true},
+		{password: "surf-polar-comet-mosaic-avid", weak: false, patterns: []string{audit.PatternDictionary}},
+		{password: "6K[3}J:3Ftef&H7;G8Ne", weak: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.password, func(t *testing.T) {
+			strength := audit.Estimate(tt.password)
+			assert.Equal(t, tt.weak, strength.Entropy < audit.DefaultMinEntropy, "entropy %.1f", strength.Entropy)
+			for _, pattern := range tt.patterns {
+				assert.Contains(t, strength.Patterns, pattern)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	strong := "6K[3}J:3Ftef&H7;G8Ne"
+
+	dataList := []models.DataInfo{
+		credentials(t, 1, "qwerty", now),
+		credentials(t, 2, strong, now),
+		credentials(t, 3, strong, now.AddDate(0, 0, -10)),
+		credentials(t, 4, "x7#Kp2$wQz9!mR4v", now.AddDate(-1, 0, 0)),
+		credentials(t, 5, "Tq8@zL3#vN6!pW1m", now),
+		{ID: 6, Type: models.DataTypeText},
+	}
+
+	report, err := audit.Run(dataList, now, audit.DefaultOptions())
+	assert.Nil(t, err)
+	assert.Equal(t, 5, report.Total)
+	assert.Len(t, report.Weak, 1)
+	assert.Equal(t, uint(1), report.Weak[0].ID)
+	assert.Len(t, report.Reused, 1)
+	assert.Equal(t, uint(2), report.Reused[0][0].ID)
+	assert.Equal(t, uint(3), report.Reused[0][1].ID)
+	assert.Len(t, report.Old, 1)
+	assert.Equal(t, uint(4), report.Old[0].ID)
+	assert.Equal(t, 20, report.Score)
+}
+
+func TestStale(t *testing.T) {
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	yesterday := now.AddDate(0, 0, -1)
+
+	dataList := []models.DataInfo{
+		{ID: 1, UpdatedAt: now.AddDate(0, 0, -10)},
+		{ID: 2, UpdatedAt: now.AddDate(0, 0, -100)},
+		{ID: 3, UpdatedAt: now.AddDate(0, 0, -5), RotateAt: &yesterday},
+		{ID: 4, UpdatedAt: now.AddDate(0, 0, -200)},
+	}
+
+	ids := func(dataList []models.DataInfo) []uint {
+		res := make([]uint, 0, len(dataList))
+		for _, data := range dataList {
+			res = append(res, data.ID)
+		}
+
+		return res
+	}
+
+	assert.Equal(t, []uint{4, 2, 3}, ids(audit.Stale(dataList, 90, now)))
+	assert.Equal(t, []uint{4, 2, 1, 3}, ids(audit.Stale(dataList, 1, now)))
+	assert.Equal(t, []uint{3}, ids(audit.Stale(dataList, 365, now)))
+
+	// Изменение описания не сбрасывает возраст пароля
+	changed := now.AddDate(0, 0, -120)
+	dataList = []models.DataInfo{{ID: 5, UpdatedAt: now, PasswordChangedAt: &changed}}
+	assert.Equal(t, []uint{5}, ids(audit.Stale(dataList, 90, now)))
+}
@@ -0,0 +1,221 @@
+package audit
+
+import (
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/generator"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+)
+
+// Виды слабых мест пароля, название для вывода - PatternTitle
+const (
+	PatternCommon     = "common"
+	PatternDictionary = "dictionary"
+	PatternRepeat     = "repeat"
+	PatternSequence   = "sequence"
+	PatternKeyboard   = "keyboard"
+)
+
+// commonPasswords - самые распространенные пароли из утечек, по убыванию популярности
+var commonPasswords = []string{
+	"123456", "password", "123456789", "12345678", "12345", "qwerty", "1234567", "111111",
+	"123123", "1234567890", "000000", "abc123", "password1", "1234", "iloveyou", "qwerty123",
+	"1q2w3e4r", "admin", "qwertyuiop", "654321", "555555", "lovely", "7777777", "welcome",
+	"888888", "princess", "dragon", "123qwe", "sunshine", "666666", "football", "monkey",
+	"letmein", "master", "login", "passw0rd", "shadow", "superman", "michael", "baseball",
+	"trustno1", "hello", "freedom", "whatever", "qazwsx", "starwars", "zaq12wsx", "password123",
+	"1qaz2wsx", "ashley", "mustang", "access", "charlie", "batman", "secret", "solo",
+	"йцукен", "пароль", "qwerty1", "changeme", "default", "root", "test", "guest",
+}
+
+// keyboardRows - ряды клавиатуры, которые часто набирают подряд
+var keyboardRows = []string{
+	"1234567890", "qwertyuiop", "asdfghjkl", "zxcvbnm",
+	"йцукенгшщзхъ", "фывапролджэ", "ячсмитьбю",
+	"1qaz2wsx3edc4rfv", "qazwsxedc",
+}
+
+// minPatternLength - минимальная длина повтора, последовательности или ряда клавиатуры
+const minPatternLength = 3
+
+// minWordLength - минимальная длина словарного слова
+const minWordLength = 4
+
+// Strength - оценка стойкости пароля
+type Strength struct {
+	Entropy  float64  `json:"entropy"`
+	Level    string   `json:"level"`
+	Patterns []string `json:"patterns,omitempty"`
+}
+
+// PatternTitles - названия слабых мест пароля на выбранном языке
+func PatternTitles(patterns []string) []string {
+	titles := make([]string, 0, len(patterns))
+	for _, pattern := range patterns {
+		titles = append(titles, i18n.T("pattern."+pattern))
+	}
+
+	return titles
+}
+
+// match - найденный в пароле шаблон: подстрока [start, end) и сколько бит она стоит
+type match struct {
+	start   int
+	end     int
+	bits    float64
+	pattern string
+}
+
+// Estimate - оценить энтропию пароля. Пароль разбивается на словарные слова, повторы,
+// последовательности и ряды клавиатуры так, чтобы суммарная энтропия была минимальной
+// (как в zxcvbn), остальные символы оцениваются по размеру алфавита.
+func Estimate(password string) Strength {
+	runes := []rune(password)
+	if len(runes) == 0 {
+		return Strength{Level: generator.Strength(0)}
+	}
+
+	lower := []rune(strings.ToLower(password))
+	if index := slices.Index(commonPasswords, string(lower)); index >= 0 {
+		entropy := math.Log2(float64(index + 2))
+		return Strength{Entropy: entropy, Level: generator.Strength(entropy), Patterns: []string{PatternCommon}}
+	}
+
+	matches := findMatches(runes, lower)
+	charBits := math.Log2(float64(poolSize(runes)))
+
+	// best[i] - минимальная энтропия первых i символов, from[i] - шаблон, которым она достигнута
+	best := make([]float64, len(runes)+1)
+	from := make([]*match, len(runes)+1)
+	for i := 1; i <= len(runes); i++ {
+		best[i] = best[i-1] + charBits
+		for j := range matches {
+			m := &matches[j]
+			if m.end == i && best[m.start]+m.bits < best[i] {
+				best[i] = best[m.start] + m.bits
+				from[i] = m
+			}
+		}
+	}
+
+	var patterns []string
+	for i := len(runes); i > 0; {
+		m := from[i]
+		if m == nil {
+			i--
+			continue
+		}
+		if !slices.Contains(patterns, m.pattern) {
+			patterns = append(patterns, m.pattern)
+		}
+		i = m.start
+	}
+
+	entropy := best[len(runes)]
+
+	return Strength{Entropy: entropy, Level: generator.Strength(entropy), Patterns: patterns}
+}
+
+// findMatches - найти в пароле все шаблоны
+func findMatches(runes []rune, lower []rune) []match {
+	var matches []match
+	wordBits := math.Log2(float64(len(generator.Wordlist)))
+
+	for start := range runes {
+		// Повтор одного символа
+		end := start + 1
+		for end < len(runes) && runes[end] == runes[start] {
+			end++
+		}
+		if end-start >= minPatternLength {
+			matches = append(matches, match{start, end, math.Log2(float64(poolSize(runes[start:start+1]))) + math.Log2(float64(end-start)), PatternRepeat})
+		}
+
+		// Последовательность с шагом 1 (abc, 321)
+		for _, step := range []rune{1, -1} {
+			end = start + 1
+			for end < len(runes) && lower[end]-lower[end-1] == step {
+				end++
+			}
+			if end-start >= minPatternLength {
+				matches = append(matches, match{start, end, 4 + math.Log2(float64(end-start)), PatternSequence})
+			}
+		}
+
+		// Ряд клавиатуры
+		for _, row := range keyboardRows {
+			rowRunes := []rune(row)
+			index := slices.Index(rowRunes, lower[start])
+			if index < 0 {
+				continue
+			}
+			end = start + 1
+			for end < len(runes) && index+end-start < len(rowRunes) && lower[end] == rowRunes[index+end-start] {
+				end++
+			}
+			if end-start >= minPatternLength {
+				bits := math.Log2(float64(len(keyboardRows)*len(rowRunes))) + math.Log2(float64(end-start))
+				matches = append(matches, match{start, end, bits, PatternKeyboard})
+			}
+		}
+
+		// Словарное слово, заглавные буквы добавляют немного энтропии
+		for _, word := range generator.Wordlist {
+			wordRunes := []rune(word)
+			if len(wordRunes) < minWordLength || start+len(wordRunes) > len(runes) {
+				continue
+			}
+			if string(lower[start:start+len(wordRunes)]) != word {
+				continue
+			}
+
+			bits := wordBits
+			if string(runes[start:start+len(wordRunes)]) != word {
+				bits++
+			}
+			matches = append(matches, match{start, start + len(wordRunes), bits, PatternDictionary})
+		}
+	}
+
+	return matches
+}
+
+// poolSize - размер алфавита по классам символов, которые встречаются в пароле
+func poolSize(runes []rune) int {
+	var lower, upper, digit, symbol, other bool
+	for _, r := range runes {
+		switch {
+		case r >= 'a' && r <= 'z':
+			lower = true
+		case r >= 'A' && r <= 'Z':
+			upper = true
+		case r >= '0' && r <= '9':
+			digit = true
+		case r < unicode.MaxASCII:
+			symbol = true
+		default:
+			other = true
+		}
+	}
+
+	size := 0
+	for _, class := range []struct {
+		present bool
+		size    int
+	}{
+		{lower, 26},
+		{upper, 26},
+		{digit, 10},
+		{symbol, 33},
+		{other, 66},
+	} {
+		if class.present {
+			size += class.size
+		}
+	}
+
+	return size
+}
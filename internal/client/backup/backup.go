@@ -0,0 +1,211 @@
+// Package backup содержит формат резервной копии хранилища: зашифрованный паролем архив
+// со всеми записями, а также выгрузку без шифрования в JSON и CSV
+package backup
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"golang.org/x/crypto/argon2"
+)
+
+var (
+	// ErrFormat - файл не является резервной копией GophKeeper
+	ErrFormat = errors.New("not a gophkeeper backup")
+	// ErrVersion - резервная копия создана более новой версией клиента
+	ErrVersion = errors.New("unsupported backup version")
+	// ErrPassword - неверный пароль или файл поврежден
+	ErrPassword = errors.New("invalid password or corrupted backup")
+	// ErrEmptyPassword - архив нельзя зашифровать пустым паролем
+	ErrEmptyPassword = errors.New("backup password is empty")
+)
+
+// Magic - сигнатура файла резервной копии
+var Magic = []byte("GKBACKUP")
+
+// Version - текущая версия формата
+const Version = 1
+
+// kdfArgon2id - идентификатор функции получения ключа в заголовке
+const kdfArgon2id = 1
+
+// Параметры Argon2id для новых архивов. Они сохраняются в заголовке, поэтому
+// изменение значений не ломает чтение старых копий.
+const (
+	argon2Time    = 3
+	argon2Memory  = 64 * 1024
+	argon2Threads = 4
+)
+
+// Наибольшие параметры Argon2id при чтении: подмененный заголовок не должен заставить
+// клиент выделить гигабайты памяти или считать ключ часами до проверки пароля
+const (
+	maxArgon2Time    = 64
+	maxArgon2Memory  = 1024 * 1024 // КиБ
+	maxArgon2Threads = 64
+)
+
+const (
+	saltSize   = 16
+	keySize    = 32
+	headerSize = 8 + 2 + 1 + 4 + 4 + 1 + saltSize + 12
+)
+
+// Archive - содержимое резервной копии
+type Archive struct {
+	CreatedAt     time.Time         `json:"created_at"`
+	ClientVersion string            `json:"client_version,omitempty"`
+	Records       []models.DataInfo `json:"records"`
+}
+
+// header - открытый заголовок архива. Он участвует в проверке целостности,
+// поэтому подмена параметров приводит к ошибке расшифровки.
+type header struct {
+	version uint16
+	kdf     byte
+	time    uint32
+	memory  uint32
+	threads uint8
+	salt    []byte
+	nonce   []byte
+}
+
+// marshal - заголовок в байтах
+func (h header) marshal() []byte {
+	buf := make([]byte, 0, headerSize)
+	buf = append(buf, Magic...)
+	buf = binary.BigEndian.AppendUint16(buf, h.version)
+	buf = append(buf, h.kdf)
+	buf = binary.BigEndian.AppendUint32(buf, h.time)
+	buf = binary.BigEndian.AppendUint32(buf, h.memory)
+	buf = append(buf, h.threads)
+	buf = append(buf, h.salt...)
+
+	return append(buf, h.nonce...)
+}
+
+// unmarshalHeader - разобрать заголовок
+func unmarshalHeader(data []byte) (header, error) {
+	if len(data) < headerSize || !bytes.Equal(data[:len(Magic)], Magic) {
+		return header{}, ErrFormat
+	}
+	data = data[len(Magic):]
+
+	h := header{version: binary.BigEndian.Uint16(data)}
+	if h.version > Version {
+		return header{}, fmt.Errorf("%w: %d", ErrVersion, h.version)
+	}
+	h.kdf = data[2]
+	h.time = binary.BigEndian.Uint32(data[3:])
+	h.memory = binary.BigEndian.Uint32(data[7:])
+	h.threads = data[11]
+	h.salt = data[12 : 12+saltSize]
+	h.nonce = data[12+saltSize : headerSize-len(Magic)]
+	if h.kdf != kdfArgon2id || h.time == 0 || h.threads == 0 {
+		return header{}, ErrFormat
+	}
+	if h.time > maxArgon2Time || h.memory > maxArgon2Memory || h.threads > maxArgon2Threads {
+		return header{}, fmt.Errorf("%w: argon2 time=%d memory=%d threads=%d", ErrFormat, h.time, h.memory, h.threads)
+	}
+
+	return h, nil
+}
+
+// newCipher - AES-256-GCM с ключом из пароля
+func (h header) newCipher(password string) (cipher.AEAD, error) {
+	key := argon2.IDKey([]byte(password), h.salt, h.time, h.memory, h.threads, keySize)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// Write - записать архив, зашифрованный паролем
+func Write(w io.Writer, archive Archive, password string) error {
+	if password == "" {
+		return ErrEmptyPassword
+	}
+
+	var payload bytes.Buffer
+	gz := gzip.NewWriter(&payload)
+	if err := json.NewEncoder(gz).Encode(archive); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+
+	h := header{
+		version: Version,
+		kdf:     kdfArgon2id,
+		time:    argon2Time,
+		memory:  argon2Memory,
+		threads: argon2Threads,
+		salt:    make([]byte, saltSize),
+		nonce:   make([]byte, 12),
+	}
+	if _, err := rand.Read(h.salt); err != nil {
+		return err
+	}
+	if _, err := rand.Read(h.nonce); err != nil {
+		return err
+	}
+
+	aead, err := h.newCipher(password)
+	if err != nil {
+		return err
+	}
+	headerData := h.marshal()
+
+	if _, err = w.Write(headerData); err != nil {
+		return err
+	}
+	_, err = w.Write(aead.Seal(nil, h.nonce, payload.Bytes(), headerData))
+
+	return err
+}
+
+// Read - прочитать и расшифровать архив
+func Read(r io.Reader, password string) (*Archive, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	h, err := unmarshalHeader(content)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := h.newCipher(password)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := aead.Open(nil, h.nonce, content[headerSize:], content[:headerSize])
+	if err != nil {
+		return nil, ErrPassword
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrFormat, err)
+	}
+	archive := &Archive{}
+	if err = json.NewDecoder(gz).Decode(archive); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrFormat, err)
+	}
+
+	return archive, nil
+}
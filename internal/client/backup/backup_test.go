@@ -0,0 +1,103 @@
+package backup_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/backup"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testArchive - архив с одной записью учетных данных
+func testArchive(t *testing.T) backup.Archive {
+	t.Helper()
+
+	value, err := models.EncodeValue(models.CredentialsValue{Login: "alice", Password: "secret"})
+	require.NoError(t, err)
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	return backup.Archive{
+		CreatedAt: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+		Records: []models.DataInfo{{
+			ID:          7,
+			Type:        models.DataTypeCredentials,
+			Description: "mail",
+			Value:       value,
+			Fields:      models.DataFields{{Name: "otp", Type: models.FieldTypeHidden, Value: "JBSWY3DPEHPK3PXP"}},
+			URLs:        models.DataURLs{{URL: "mail.example.com"}},
+			ExpiresAt:   &expiresAt,
+			UpdatedAt:   time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC),
+		}},
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	archive := testArchive(t)
+
+	var buf bytes.Buffer
+	require.NoError(t, backup.Write(&buf, archive, "backup-secret"))
+	assert.True(t, bytes.HasPrefix(buf.Bytes(), backup.Magic))
+	assert.NotContains(t, buf.String(), "mail")
+
+	res, err := backup.Read(bytes.NewReader(buf.Bytes()), "backup-secret")
+	require.NoError(t, err)
+	assert.Equal(t, archive, *res)
+
+	_, err = backup.Read(bytes.NewReader(buf.Bytes()), "wrong")
+	assert.ErrorIs(t, err, backup.ErrPassword)
+
+	// Заголовок защищен от подмены
+	tampered := bytes.Clone(buf.Bytes())
+	tampered[len(backup.Magic)+2+1+4+4+1] ^= 1
+	_, err = backup.Read(bytes.NewReader(tampered), "backup-secret")
+	assert.ErrorIs(t, err, backup.ErrPassword)
+
+	newer := bytes.Clone(buf.Bytes())
+	newer[len(backup.Magic)+1] = backup.Version + 1
+	_, err = backup.Read(bytes.NewReader(newer), "backup-secret")
+	assert.ErrorIs(t, err, backup.ErrVersion)
+
+	_, err = backup.Read(bytes.NewReader([]byte("plain text")), "backup-secret")
+	assert.ErrorIs(t, err, backup.ErrFormat)
+
+	// Завышенные параметры Argon2 отклоняются до получения ключа
+	expensive := bytes.Clone(buf.Bytes())
+	binary.BigEndian.PutUint32(expensive[len(backup.Magic)+2+1+4:], 1<<31)
+	_, err = backup.Read(bytes.NewReader(expensive), "backup-secret")
+	assert.ErrorIs(t, err, backup.ErrFormat)
+
+	assert.ErrorIs(t, backup.Write(&buf, archive, ""), backup.ErrEmptyPassword)
+}
+
+func TestWritePlain(t *testing.T) {
+	archive := testArchive(t)
+
+	var buf bytes.Buffer
+	require.NoError(t, backup.WriteJSON(&buf, archive))
+	var res struct {
+		Records []struct {
+			Type   string `json:"type"`
+			Fields []struct {
+				Name  string `json:"name"`
+				Value string `json:"value"`
+			} `json:"fields"`
+		} `json:"records"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &res))
+	require.Len(t, res.Records, 1)
+	assert.Equal(t, "credentials", res.Records[0].Type)
+	assert.Equal(t, "secret", res.Records[0].Fields[1].Value)
+
+	buf.Reset()
+	require.NoError(t, backup.WriteCSV(&buf, archive))
+	assert.Equal(t, "id,type,description,field,value,custom\n"+
+		"7,credentials,mail,login,alice,false\n"+
+		"7,credentials,mail,password,secret,false\n"+
+		"7,credentials,mail,otp,JBSWY3DPEHPK3PXP,true\n"+
+		"7,credentials,mail,url,mail.example.com,false\n", buf.String())
+}
@@ -0,0 +1,93 @@
+package backup
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// plainRecord - запись с раскрытыми полями значения
+type plainRecord struct {
+	ID          uint            `json:"id"`
+	Type        string          `json:"type"`
+	Description string          `json:"description"`
+	Fields      []records.Field `json:"fields"`
+	URLs        models.DataURLs `json:"urls,omitempty"`
+	ExpiresAt   *time.Time      `json:"expires_at,omitempty"`
+	RotateAt    *time.Time      `json:"rotate_at,omitempty"`
+	UpdatedAt   time.Time       `json:"updated_at"`
+}
+
+// plainArchive - архив без шифрования
+type plainArchive struct {
+	CreatedAt     time.Time     `json:"created_at"`
+	ClientVersion string        `json:"client_version,omitempty"`
+	Records       []plainRecord `json:"records"`
+}
+
+// WriteJSON - выгрузить архив в JSON без шифрования. Значения записей раскрываются
+// в поля, чтобы файл можно было прочитать без GophKeeper.
+func WriteJSON(w io.Writer, archive Archive) error {
+	res := plainArchive{
+		CreatedAt:     archive.CreatedAt,
+		ClientVersion: archive.ClientVersion,
+		Records:       make([]plainRecord, 0, len(archive.Records)),
+	}
+	for _, data := range archive.Records {
+		fields, err := records.ValueFields(data)
+		if err != nil {
+			return err
+		}
+		res.Records = append(res.Records, plainRecord{
+			ID:          data.ID,
+			Type:        records.Name(data.Type),
+			Description: data.Description,
+			Fields:      fields,
+			URLs:        data.URLs,
+			ExpiresAt:   data.ExpiresAt,
+			RotateAt:    data.RotateAt,
+			UpdatedAt:   data.UpdatedAt,
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(res)
+}
+
+// WriteCSV - выгрузить записи в CSV без шифрования: строка на каждое поле записи
+func WriteCSV(w io.Writer, archive Archive) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"id", "type", "description", "field", "value", "custom"}); err != nil {
+		return err
+	}
+
+	for _, data := range archive.Records {
+		fields, err := records.ValueFields(data)
+		if err != nil {
+			return err
+		}
+		id := strconv.FormatUint(uint64(data.ID), 10)
+		for _, field := range fields {
+			err = writer.Write([]string{id, records.Name(data.Type), data.Description, field.Name, field.Value, strconv.FormatBool(field.Custom)})
+			if err != nil {
+				return err
+			}
+		}
+		for _, dataURL := range data.URLs {
+			err = writer.Write([]string{id, records.Name(data.Type), data.Description, "url", dataURL.URL, "false"})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
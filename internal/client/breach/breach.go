@@ -0,0 +1,306 @@
+// Package breach содержит проверку паролей по локальному списку хешей из утечек
+// в формате Have I Been Pwned. Пароли и их хеши никуда не отправляются.
+package breach
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"golang.org/x/crypto/md4"
+)
+
+// Kind - алгоритм хешей в списке
+type Kind int
+
+// Поддерживаемые алгоритмы
+const (
+	KindSHA1 Kind = iota
+	KindNTLM
+)
+
+// PrefixLength - длина префикса хеша в формате k-anonymity (файлы 00000.txt ... FFFFF.txt)
+const PrefixLength = 5
+
+// maxLineLength - максимальная длина строки списка: хеш, двоеточие, количество и перевод строки
+const maxLineLength = 128
+
+// ErrUnknownFormat - файл не похож на список хешей HIBP
+var ErrUnknownFormat = i18n.NewError("breach.unknown_format")
+
+// String - название алгоритма
+func (k Kind) String() string {
+	if k == KindNTLM {
+		return "NTLM"
+	}
+
+	return "SHA-1"
+}
+
+// Hash - хеш пароля в формате списка: SHA-1 или NTLM (MD4 от UTF-16LE) в верхнем регистре
+func Hash(kind Kind, password string) string {
+	if kind == KindNTLM {
+		hash := md4.New()
+		for _, r := range utf16.Encode([]rune(password)) {
+			hash.Write([]byte{byte(r), byte(r >> 8)})
+		}
+
+		return strings.ToUpper(hex.EncodeToString(hash.Sum(nil)))
+	}
+
+	sum := sha1.Sum([]byte(password))
+
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+// kindByLength - алгоритм по длине хеша в строке
+func kindByLength(length int) (Kind, error) {
+	switch length {
+	case sha1.Size * 2:
+		return KindSHA1, nil
+	case md4.Size * 2:
+		return KindNTLM, nil
+	}
+
+	return 0, ErrUnknownFormat
+}
+
+// List - локальный список хешей: один отсортированный файл HASH:COUNT
+// или каталог с файлами по префиксам в формате k-anonymity (SUFFIX:COUNT)
+type List struct {
+	path  string
+	kind  Kind
+	dir   bool
+	file  *os.File
+	size  int64
+	index []int64
+}
+
+// Open - открыть список хешей. Для файла используется индекс <файл>.idx, если он построен.
+func Open(path string) (*List, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return openDir(path)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	list := &List{path: path, file: file, size: info.Size()}
+
+	line, err := readFirstLine(file)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+	list.kind, err = kindByLength(len(hashPart(line)))
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	list.index, err = readIndex(IndexPath(path), list.size)
+	if err != nil {
+		_ = file.Close()
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// openDir - открыть каталог с файлами по префиксам
+func openDir(path string) (*List, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		name := strings.TrimSuffix(entry.Name(), ".txt")
+		if entry.IsDir() || len(name) != PrefixLength || !isHex(name) {
+			continue
+		}
+
+		file, err := os.Open(filepath.Join(path, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		line, err := readFirstLine(file)
+		_ = file.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		kind, err := kindByLength(PrefixLength + len(hashPart(line)))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", entry.Name(), err)
+		}
+
+		return &List{path: path, kind: kind, dir: true}, nil
+	}
+
+	return nil, fmt.Errorf("%s: %w", path, ErrUnknownFormat)
+}
+
+// Kind - алгоритм хешей списка
+func (l *List) Kind() Kind {
+	return l.kind
+}
+
+// Indexed - используется ли индекс для поиска
+func (l *List) Indexed() bool {
+	return l.dir || l.index != nil
+}
+
+// Close - закрыть список
+func (l *List) Close() error {
+	if l.file == nil {
+		return nil
+	}
+
+	return l.file.Close()
+}
+
+// Count - сколько раз пароль встречается в утечках, 0 - не найден
+func (l *List) Count(password string) (int, error) {
+	hash := Hash(l.kind, password)
+
+	if l.dir {
+		data, err := os.ReadFile(filepath.Join(l.path, hash[:PrefixLength]+".txt"))
+		if errors.Is(err, os.ErrNotExist) {
+			return 0, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		return search(bytes.NewReader(data), 0, int64(len(data)), hash[PrefixLength:])
+	}
+
+	lo, hi := int64(0), l.size
+	if l.index != nil {
+		prefix, _ := strconv.ParseUint(hash[:indexPrefixLength], 16, 32)
+		lo, hi = l.index[prefix], l.index[prefix+1]
+	}
+
+	return search(l.file, lo, hi, hash)
+}
+
+// search - двоичный поиск хеша в отсортированных строках HASH:COUNT диапазона [lo, hi).
+// lo должен быть началом строки.
+func search(reader io.ReaderAt, lo int64, hi int64, hash string) (int, error) {
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		start, line, err := lineAfter(reader, mid)
+		if err != nil {
+			return 0, err
+		}
+		if line == nil || start >= hi {
+			hi = mid
+			continue
+		}
+
+		lineHash := string(bytes.ToUpper(hashPart(line)))
+		switch {
+		case lineHash == hash:
+			return countPart(line), nil
+		case lineHash < hash:
+			lo = start + int64(len(line))
+		default:
+			hi = start
+		}
+	}
+
+	return 0, nil
+}
+
+// lineAfter - первая строка, начинающаяся не раньше offset, вместе с переводом строки
+func lineAfter(reader io.ReaderAt, offset int64) (int64, []byte, error) {
+	start := offset
+	buf := make([]byte, maxLineLength*2)
+
+	if offset > 0 {
+		n, err := reader.ReadAt(buf, offset-1)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return 0, nil, err
+		}
+		newline := bytes.IndexByte(buf[:n], '\n')
+		if newline < 0 {
+			return 0, nil, nil
+		}
+		start = offset + int64(newline)
+	}
+
+	n, err := reader.ReadAt(buf[:maxLineLength], start)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return 0, nil, err
+	}
+	if n == 0 {
+		return start, nil, nil
+	}
+
+	line := buf[:n]
+	if newline := bytes.IndexByte(line, '\n'); newline >= 0 {
+		line = line[:newline+1]
+	}
+
+	return start, line, nil
+}
+
+// readFirstLine - первая строка файла
+func readFirstLine(reader io.ReaderAt) ([]byte, error) {
+	_, line, err := lineAfter(reader, 0)
+	if err != nil {
+		return nil, err
+	}
+	if line == nil {
+		return nil, ErrUnknownFormat
+	}
+
+	return line, nil
+}
+
+// hashPart - хеш из строки HASH:COUNT
+func hashPart(line []byte) []byte {
+	line = bytes.TrimRight(line, "\r\n")
+	if colon := bytes.IndexByte(line, ':'); colon >= 0 {
+		return line[:colon]
+	}
+
+	return line
+}
+
+// countPart - количество из строки HASH:COUNT, без количества считается 1
+func countPart(line []byte) int {
+	line = bytes.TrimRight(line, "\r\n")
+	colon := bytes.IndexByte(line, ':')
+	if colon < 0 {
+		return 1
+	}
+
+	count, err := strconv.Atoi(string(line[colon+1:]))
+	if err != nil || count < 1 {
+		return 1
+	}
+
+	return count
+}
+
+// isHex - строка из шестнадцатеричных цифр
+func isHex(text string) bool {
+	return strings.Trim(text, "0123456789abcdefABCDEF") == ""
+}
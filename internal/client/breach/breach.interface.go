@@ -0,0 +1,5 @@
+package breach
+
+type ListInterface interface {
+	Count(password string) (int, error)
+}
@@ -0,0 +1,108 @@
+package breach_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/breach"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// breachedPasswords - пароли тестового списка и количество утечек
+var breachedPasswords = map[string]int{
+	"password": 9545824,
+	"123456":   37359195,
+	"qwerty":   10556095,
+	"letmein":  1106207,
+	"dragon":   1173574,
+}
+
+// writeList - записать отсортированный список хешей с шумом из случайных строк
+func writeList(t *testing.T, kind breach.Kind) string {
+	lines := make([]string, 0, 1000)
+	for password, count := range breachedPasswords {
+		lines = append(lines, fmt.Sprintf("%s:%d", breach.Hash(kind, password), count))
+	}
+	for i := 0; i < 1000; i++ {
+		lines = append(lines, breach.Hash(kind, fmt.Sprintf("noise-%d", i))+":1")
+	}
+	slices.Sort(lines)
+
+	path := filepath.Join(t.TempDir(), "pwned.txt")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines, "\r\n")+"\r\n"), 0o600))
+
+	return path
+}
+
+func TestHash(t *testing.T) {
+	assert.Equal(t, "5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8", breach.Hash(breach.KindSHA1, "password"))
+	assert.Equal(t, "8846F7EAEE8FB117AD06BDD830B7586C", breach.Hash(breach.KindNTLM, "password"))
+}
+
+func TestListCount(t *testing.T) {
+	for _, kind := range []breach.Kind{breach.KindSHA1, breach.KindNTLM} {
+		t.Run(kind.String(), func(t *testing.T) {
+			path := writeList(t, kind)
+
+			check := func(indexed bool) {
+				list, err := breach.Open(path)
+				require.NoError(t, err)
+				defer list.Close()
+
+				assert.Equal(t, kind, list.Kind())
+				assert.Equal(t, indexed, list.Indexed())
+				for password, want := range breachedPasswords {
+					count, err := list.Count(password)
+					require.NoError(t, err)
+					assert.Equal(t, want, count, password)
+				}
+				count, err := list.Count("6K[3}J:3Ftef&H7;G8Ne")
+				require.NoError(t, err)
+				assert.Zero(t, count)
+			}
+
+			check(false)
+			require.NoError(t, breach.BuildIndex(path))
+			check(true)
+		})
+	}
+}
+
+func TestListDir(t *testing.T) {
+	dir := t.TempDir()
+	files := make(map[string][]string)
+	for password, count := range breachedPasswords {
+		hash := breach.Hash(breach.KindSHA1, password)
+		prefix := hash[:breach.PrefixLength]
+		files[prefix] = append(files[prefix], fmt.Sprintf("%s:%d", hash[breach.PrefixLength:], count))
+	}
+	for prefix, lines := range files {
+		slices.Sort(lines)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, prefix+".txt"), []byte(strings.Join(lines, "\n")), 0o600))
+	}
+
+	list, err := breach.Open(dir)
+	require.NoError(t, err)
+	defer list.Close()
+
+	count, err := list.Count("dragon")
+	require.NoError(t, err)
+	assert.Equal(t, breachedPasswords["dragon"], count)
+
+	count, err = list.Count("not breached")
+	require.NoError(t, err)
+	assert.Zero(t, count)
+}
+
+func TestOpenUnknownFormat(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "list.txt")
+	require.NoError(t, os.WriteFile(path, []byte("not a hash list\n"), 0o600))
+
+	_, err := breach.Open(path)
+	assert.ErrorIs(t, err, breach.ErrUnknownFormat)
+}
@@ -0,0 +1,111 @@
+package breach
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+)
+
+// indexPrefixLength - длина префикса хеша в индексе: 4 hex символа, 65536 диапазонов
+const indexPrefixLength = 4
+
+// indexSize - количество смещений в индексе: начало каждого диапазона и размер файла
+const indexSize = 1<<(indexPrefixLength*4) + 1
+
+// errNotSorted - строки списка хешей не отсортированы, индекс построить нельзя
+var errNotSorted = i18n.NewError("breach.not_sorted")
+
+// IndexPath - путь к индексу списка хешей
+func IndexPath(path string) string {
+	return path + ".idx"
+}
+
+// BuildIndex - построить индекс отсортированного файла HASH:COUNT: смещение первой строки
+// для каждого префикса из 4 символов. Поиск затем идет только внутри одного диапазона.
+func BuildIndex(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	index := make([]int64, indexSize)
+	next := 0
+	offset := int64(0)
+	previous := ""
+
+	reader := bufio.NewReaderSize(file, 1<<20)
+	for {
+		line, err := reader.ReadSlice('\n')
+		if len(line) > 0 {
+			hash := string(hashPart(line))
+			if len(hash) < indexPrefixLength {
+				return fmt.Errorf("%s: %w", path, ErrUnknownFormat)
+			}
+			if hash < previous {
+				return fmt.Errorf("%s: %w", path, errNotSorted)
+			}
+			previous = hash
+
+			prefix, parseErr := strconv.ParseUint(hash[:indexPrefixLength], 16, 32)
+			if parseErr != nil {
+				return fmt.Errorf("%s: %w", path, ErrUnknownFormat)
+			}
+			for ; next <= int(prefix); next++ {
+				index[next] = offset
+			}
+			offset += int64(len(line))
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+	for ; next < indexSize; next++ {
+		index[next] = offset
+	}
+
+	out, err := os.Create(IndexPath(path))
+	if err != nil {
+		return err
+	}
+	writer := bufio.NewWriter(out)
+	if err = binary.Write(writer, binary.LittleEndian, index); err == nil {
+		err = writer.Flush()
+	}
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// readIndex - прочитать индекс. Отсутствующий или устаревший индекс (файл изменился) не используется.
+func readIndex(path string, size int64) ([]int64, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	index := make([]int64, indexSize)
+	if err = binary.Read(bufio.NewReader(file), binary.LittleEndian, index); err != nil {
+		return nil, nil
+	}
+	if index[indexSize-1] != size {
+		return nil, nil
+	}
+
+	return index, nil
+}
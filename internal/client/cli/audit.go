@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/audit"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// audit - отчет о слабых, повторяющихся и давно не менявшихся паролях
+func (c *CLI) audit(ctx context.Context, args []string) error {
+	opts := audit.DefaultOptions()
+
+	flags := c.newFlagSet("audit")
+	flags.Float64Var(&opts.MinEntropy, "min-entropy", opts.MinEntropy, i18n.T("cli.flag.min_entropy"))
+	flags.IntVar(&opts.MaxAgeDays, "days", opts.MaxAgeDays, i18n.T("cli.flag.days"))
+	asJSON := flags.Bool("json", false, i18n.T("cli.flag.json"))
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if opts.MaxAgeDays < 0 || len(positional) > 0 {
+		flags.Usage()
+		return errUsage
+	}
+	if *asJSON {
+		c.output = outputJSON
+	}
+
+	var dataList []models.DataInfo
+	err = c.withSession(ctx, func() error {
+		dataList, err = c.http.GetList(ctx, models.DataTypeCredentials)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	report, err := audit.Run(dataList, time.Now(), opts)
+	if err != nil {
+		return err
+	}
+	if c.output == outputJSON {
+		return c.printJSON(report)
+	}
+
+	fmt.Fprintf(c.stdout, "%s\n\n", i18n.T("cli.audit.summary", report.Total, report.Score))
+
+	rows := make([][]string, 0, len(report.Weak)+len(report.Old))
+	for _, entry := range report.Weak {
+		details := i18n.T("strength.entropy", entry.Strength.Entropy, entry.Strength.Level)
+		if len(entry.Strength.Patterns) > 0 {
+			details += ": " + strings.Join(audit.PatternTitles(entry.Strength.Patterns), ", ")
+		}
+		rows = append(rows, auditRow(i18n.T("cli.audit.weak"), entry, details))
+	}
+	for i, group := range report.Reused {
+		for _, entry := range group {
+			rows = append(rows, auditRow(i18n.T("cli.audit.reused"), entry, i18n.T("cli.audit.group", i+1, len(group))))
+		}
+	}
+	for _, entry := range report.Old {
+		rows = append(rows, auditRow(i18n.T("cli.audit.old"), entry, i18n.T("cli.audit.age", entry.AgeDays)))
+	}
+
+	return c.printTable([]string{i18n.T("column.problem"), "ID", i18n.T("column.description"), i18n.T("column.login"), i18n.T("column.details")}, rows)
+}
+
+// auditRow - строка таблицы отчета
+func auditRow(problem string, entry audit.Entry, details string) []string {
+	return []string{problem, strconv.FormatUint(uint64(entry.ID), 10), entry.Description, entry.Login, details}
+}
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"context"
+	"os"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+)
+
+// login - войти и сохранить сессию
+func (c *CLI) login(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("login")
+	login := flags.String("login", c.config.Login, i18n.T("cli.flag.login"))
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) == 1 {
+		*login = positional[0]
+	}
+	if *login == "" || len(positional) > 1 {
+		flags.Usage()
+		return errUsage
+	}
+
+	if err = c.signIn(ctx, *login); err != nil {
+		return err
+	}
+
+	return c.saveSession(*login)
+}
+
+// logout - завершить сессию
+func (c *CLI) logout(_ context.Context, args []string) error {
+	flags := c.newFlagSet("logout")
+	if _, err := c.parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	return c.store.Delete()
+}
+
+// signIn - выполнить вход. Пароль берется из PasswordEnv или запрашивается.
+func (c *CLI) signIn(ctx context.Context, login string) error {
+	password, ok := os.LookupEnv(PasswordEnv)
+	if !ok {
+		var err error
+		password, err = c.readPassword(i18n.T("cli.password_for", login))
+		if err != nil {
+			return err
+		}
+	}
+	if password == "" {
+		return errEmptyPassword
+	}
+
+	return c.http.Login(ctx, commonRequests.UserLogin{
+		Login:    login,
+		Password: password,
+	})
+}
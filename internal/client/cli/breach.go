@@ -0,0 +1,105 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/breach"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// errNoBreachFile - не указан список хешей
+var errNoBreachFile = i18n.NewError("cli.breach.no_file")
+
+// breachResult - учетные данные, пароль которых найден в утечках
+type breachResult struct {
+	ID          uint   `json:"id"`
+	Description string `json:"description"`
+	Login       string `json:"login"`
+	Count       int    `json:"count"`
+}
+
+// breach - проверить пароли по локальному списку хешей из утечек. С флагом -index
+// строится индекс списка, ускоряющий поиск.
+func (c *CLI) breach(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("breach")
+	path := flags.String("file", c.config.BreachFile, i18n.T("cli.flag.breach_file"))
+	buildIndex := flags.Bool("index", false, i18n.T("cli.flag.breach_index"))
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if *path == "" {
+		return fmt.Errorf("%w: %w", errUsage, errNoBreachFile)
+	}
+
+	if *buildIndex {
+		if err = breach.BuildIndex(*path); err != nil {
+			return err
+		}
+		fmt.Fprintln(c.stderr, i18n.T("cli.breach.index_saved", breach.IndexPath(*path)))
+
+		return nil
+	}
+
+	ids := make(map[uint]bool, len(positional))
+	for _, arg := range positional {
+		id, err := parseID(arg)
+		if err != nil {
+			return err
+		}
+		ids[id] = true
+	}
+
+	list, err := breach.Open(*path)
+	if err != nil {
+		return err
+	}
+	defer list.Close()
+	if !list.Indexed() {
+		fmt.Fprintln(c.stderr, i18n.T("cli.breach.no_index", *path))
+	}
+
+	var dataList []models.DataInfo
+	err = c.withSession(ctx, func() error {
+		dataList, err = c.http.GetList(ctx, models.DataTypeCredentials)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	checked := 0
+	results := make([]breachResult, 0)
+	rows := make([][]string, 0)
+	for _, data := range dataList {
+		if len(ids) > 0 && !ids[data.ID] {
+			continue
+		}
+		value := models.CredentialsValue{}
+		if err = models.DecodeValue(data.Value, &value); err != nil {
+			return err
+		}
+		if value.Password == "" {
+			continue
+		}
+		checked++
+
+		count, err := list.Count(value.Password)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			continue
+		}
+
+		results = append(results, breachResult{ID: data.ID, Description: data.Description, Login: value.Login, Count: count})
+		rows = append(rows, []string{strconv.FormatUint(uint64(data.ID), 10), data.Description, value.Login, strconv.Itoa(count)})
+	}
+
+	fmt.Fprintln(c.stderr, i18n.T("cli.breach.summary", checked, len(results), list.Kind()))
+
+	return c.print(results, []string{"ID", i18n.T("column.description"), i18n.T("column.login"), i18n.T("column.breaches")}, rows)
+}
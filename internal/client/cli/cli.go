@@ -0,0 +1,298 @@
+// Package cli содержит команды клиента для работы без TUI
+package cli
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/clipboard"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/config"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/http"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/session"
+	"golang.org/x/term"
+)
+
+// Коды завершения команд
+const (
+	ExitOK           = 0
+	ExitError        = 1
+	ExitUsage        = 2
+	ExitUnauthorized = 3
+	ExitNotFound     = 4
+)
+
+// PasswordEnv - переменная окружения с паролем пользователя
+const PasswordEnv = "GOPHKEEPER_PASSWORD"
+
+var (
+	errEmptyPassword = i18n.NewError("cli.empty_password")
+	errUsage         = i18n.NewError("cli.usage_error")
+)
+
+// command - команда клиента, ее описание в каталоге сообщений по ключу "command.<имя>"
+type command struct {
+	run    func(c *CLI, ctx context.Context, args []string) error
+	hidden bool
+}
+
+// commands - команды клиента по имени
+var commands = map[string]command{
+	"login":    {run: (*CLI).login},
+	"logout":   {run: (*CLI).logout},
+	"list":     {run: (*CLI).list},
+	"get":      {run: (*CLI).get},
+	"add":      {run: (*CLI).add},
+	"edit":     {run: (*CLI).edit},
+	"rm":       {run: (*CLI).rm},
+	"stale":    {run: (*CLI).stale},
+	"version":  {run: (*CLI).version},
+	"audit":    {run: (*CLI).audit},
+	"breach":   {run: (*CLI).breach},
+	"generate": {run: (*CLI).generate},
+	"export":   {run: (*CLI).export},
+	"run":      {run: (*CLI).run},
+	"inject":   {run: (*CLI).inject},
+	"import":   {run: (*CLI).importData},
+
+	GitCredentialCommand:   {run: (*CLI).gitCredential},
+	"ssh-agent":            {run: (*CLI).sshAgent},
+	clipboard.ClearCommand: {run: (*CLI).clipboardClear, hidden: true},
+}
+
+// CLI - набор команд клиента
+type CLI struct {
+	config    *config.Config
+	http      http.ClientInterface
+	store     session.StoreInterface
+	clipboard clipboard.ClipboardInterface
+	stdin     io.Reader
+	stdout    io.Writer
+	stderr    io.Writer
+	output    string
+}
+
+// NewCLI - создать набор команд клиента
+func NewCLI(
+	config *config.Config,
+	http http.ClientInterface,
+	store session.StoreInterface,
+	clipboard clipboard.ClipboardInterface,
+	stdin io.Reader,
+	stdout io.Writer,
+	stderr io.Writer,
+) *CLI {
+	return &CLI{
+		config:    config,
+		http:      http,
+		store:     store,
+		clipboard: clipboard,
+		stdin:     stdin,
+		stdout:    stdout,
+		stderr:    stderr,
+		output:    defaultOutput(config),
+	}
+}
+
+// Run - выполнить команду, args[0] - имя команды. Возвращает код завершения.
+func (c *CLI) Run(ctx context.Context, args []string) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		c.usage()
+		if len(args) == 0 {
+			return ExitUsage
+		}
+		return ExitOK
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		fmt.Fprintln(c.stderr, i18n.T("cli.unknown_command", args[0]))
+		c.usage()
+		return ExitUsage
+	}
+
+	err := cmd.run(c, ctx, args[1:])
+	var childErr *childExitError
+	if err != nil && !errors.Is(err, flag.ErrHelp) && !errors.As(err, &childErr) {
+		if !errors.Is(err, errUsage) {
+			fmt.Fprintln(c.stderr, err)
+		}
+	}
+
+	return exitCode(err)
+}
+
+// exitCode - код завершения по ошибке команды
+func exitCode(err error) int {
+	var childErr *childExitError
+	switch {
+	case errors.As(err, &childErr):
+		return childErr.code
+	case err == nil, errors.Is(err, flag.ErrHelp):
+		return ExitOK
+	case errors.Is(err, errUsage):
+		return ExitUsage
+	case errors.Is(err, session.ErrNoSession),
+		errors.Is(err, http.ErrUserUnauthorized),
+		errors.Is(err, http.ErrInvalidAuth):
+		return ExitUnauthorized
+	case errors.Is(err, http.ErrNotFound), errors.Is(err, records.ErrUnknownField):
+		return ExitNotFound
+	}
+
+	return ExitError
+}
+
+// usage - вывести список команд
+func (c *CLI) usage() {
+	names := make([]string, 0, len(commands))
+	for name, cmd := range commands {
+		if !cmd.hidden {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(c.stderr, i18n.T("cli.usage"))
+	fmt.Fprintln(c.stderr, i18n.T("cli.usage_commands"))
+	for _, name := range names {
+		fmt.Fprintf(c.stderr, "  %-8s %s\n", name, i18n.T("command."+name))
+	}
+}
+
+// newFlagSet - набор флагов команды с общим флагом формата вывода
+func (c *CLI) newFlagSet(name string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	flags.StringVar(&c.output, "o", c.output, i18n.T("cli.flag.output"))
+	flags.StringVar(&c.output, "output", c.output, i18n.T("cli.flag.output"))
+
+	return flags
+}
+
+// parseFlags - разобрать флаги, которые могут идти вперемешку с позиционными аргументами
+func (c *CLI) parseFlags(flags *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := flags.Parse(args); err != nil {
+			if errors.Is(err, flag.ErrHelp) {
+				return nil, err
+			}
+			return nil, errUsage
+		}
+
+		args = flags.Args()
+		if len(args) == 0 {
+			break
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+
+	if c.output != outputTable && c.output != outputJSON {
+		fmt.Fprintln(c.stderr, i18n.T("cli.unknown_output", c.output))
+		return nil, errUsage
+	}
+
+	return positional, nil
+}
+
+// authenticate - восстановить сохраненную сессию, обновив истекший access токен
+func (c *CLI) authenticate(ctx context.Context) (*session.Session, error) {
+	s, err := c.store.Load()
+	if err != nil {
+		return nil, err
+	}
+	if s.Server != c.config.ServerAddress {
+		return nil, fmt.Errorf("%s: %w", i18n.T("cli.session_server", s.Server), session.ErrNoSession)
+	}
+
+	c.http.SetCookies(s.Cookies)
+	if s.AccessExpired(time.Now()) {
+		if err = c.http.Refresh(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return s, nil
+}
+
+// saveSession - сохранить сессию с обновленными сервером cookie
+func (c *CLI) saveSession(login string) error {
+	return c.store.Save(&session.Session{
+		Server:  c.config.ServerAddress,
+		Login:   login,
+		Cookies: c.http.Cookies(),
+	})
+}
+
+// withSession - выполнить действие в сохраненной сессии
+func (c *CLI) withSession(ctx context.Context, action func() error) error {
+	s, err := c.authenticate(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = action()
+
+	// Сервер мог обновить токены, сохраняем их даже при ошибке действия
+	if saveErr := c.saveSession(s.Login); saveErr != nil && err == nil {
+		err = saveErr
+	}
+
+	return err
+}
+
+// readPassword - прочитать пароль без отображения на экране
+func (c *CLI) readPassword(prompt string) (string, error) {
+	if file, ok := c.stdin.(*os.File); ok && term.IsTerminal(int(file.Fd())) {
+		fmt.Fprint(c.stderr, prompt)
+		password, err := term.ReadPassword(int(file.Fd()))
+		fmt.Fprintln(c.stderr)
+		if err != nil {
+			return "", fmt.Errorf("read password: %w", err)
+		}
+
+		return string(password), nil
+	}
+
+	line, err := bufio.NewReader(c.stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", fmt.Errorf("read password: %w", err)
+	}
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+// stdinIsTerminal - ввод идет с терминала, а не из файла или канала
+func (c *CLI) stdinIsTerminal() bool {
+	file, ok := c.stdin.(*os.File)
+
+	return ok && term.IsTerminal(int(file.Fd()))
+}
+
+// confirm - запросить подтверждение действия, согласием считается "y" или "yes"
+func (c *CLI) confirm(prompt string) (bool, error) {
+	fmt.Fprint(c.stderr, prompt)
+
+	line, err := bufio.NewReader(c.stdin).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("read answer: %w", err)
+	}
+	if !c.stdinIsTerminal() {
+		fmt.Fprintln(c.stderr)
+	}
+
+	answer := strings.ToLower(strings.TrimSpace(line))
+
+	return answer == "y" || answer == "yes", nil
+}
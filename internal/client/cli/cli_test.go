@@ -0,0 +1,536 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/audit"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/breach"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/config"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/generator"
+	clientHTTP "github.com/ShukinDmitriy/GophKeeper/internal/client/http"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/session"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/version"
+	clipboardMocks "github.com/ShukinDmitriy/GophKeeper/mocks/internal_/client/clipboard"
+	httpMocks "github.com/ShukinDmitriy/GophKeeper/mocks/internal_/client/http"
+	sessionMocks "github.com/ShukinDmitriy/GophKeeper/mocks/internal_/client/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+const testServer = "http://localhost:8080"
+
+// newTestCLI - команды с моками клиента и хранилища сессии
+func newTestCLI(t *testing.T, stdin string) (*CLI, *httpMocks.ClientInterface, *sessionMocks.StoreInterface, *bytes.Buffer) {
+	httpClient := httpMocks.NewClientInterface(t)
+	store := sessionMocks.NewStoreInterface(t)
+	stdout := &bytes.Buffer{}
+	c := NewCLI(
+		&config.Config{ServerAddress: testServer},
+		httpClient,
+		store,
+		clipboardMocks.NewClipboardInterface(t),
+		strings.NewReader(stdin),
+		stdout,
+		&bytes.Buffer{},
+	)
+
+	return c, httpClient, store, stdout
+}
+
+// expectSession - ожидать восстановления и сохранения сессии
+func expectSession(httpClient *httpMocks.ClientInterface, store *sessionMocks.StoreInterface) {
+	cookies := []*http.Cookie{{Name: "access-token", Value: "token"}}
+	store.EXPECT().Load().Return(&session.Session{Server: testServer, Login: "user", Cookies: cookies}, nil)
+	httpClient.EXPECT().SetCookies(cookies).Return()
+	httpClient.EXPECT().Cookies().Return(cookies)
+	store.EXPECT().Save(mock.Anything).Return(nil)
+}
+
+func credentials(t *testing.T) *models.DataInfo {
+	value, err := models.EncodeValue(models.CredentialsValue{Login: "alice", Password: "secret"})
+	require.NoError(t, err)
+
+	return &models.DataInfo{ID: 7, Type: models.DataTypeCredentials, Description: "mail", Value: value}
+}
+
+func TestParseFlags(t *testing.T) {
+	c, _, _, _ := newTestCLI(t, "")
+	flags := c.newFlagSet("get")
+	field := flags.String("field", "", "")
+
+	positional, err := c.parseFlags(flags, []string{"7", "--field", "password", "-o", "json", "8"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"7", "8"}, positional)
+	assert.Equal(t, "password", *field)
+	assert.Equal(t, outputJSON, c.output)
+
+	_, err = c.parseFlags(c.newFlagSet("list"), []string{"-o", "yaml"})
+	assert.ErrorIs(t, err, errUsage)
+}
+
+func TestExitCode(t *testing.T) {
+	assert.Equal(t, ExitOK, exitCode(nil))
+	assert.Equal(t, ExitUsage, exitCode(fmt.Errorf("wrap: %w", errUsage)))
+	assert.Equal(t, ExitUnauthorized, exitCode(session.ErrNoSession))
+	assert.Equal(t, ExitUnauthorized, exitCode(fmt.Errorf("wrap: %w", clientHTTP.ErrUserUnauthorized)))
+	assert.Equal(t, ExitNotFound, exitCode(fmt.Errorf("wrap: %w", clientHTTP.ErrNotFound)))
+	assert.Equal(t, ExitNotFound, exitCode(records.ErrUnknownField))
+	assert.Equal(t, ExitError, exitCode(clientHTTP.ErrServerProblem))
+}
+
+func TestRunUnknownCommand(t *testing.T) {
+	c, _, _, _ := newTestCLI(t, "")
+
+	assert.Equal(t, ExitUsage, c.Run(context.Background(), []string{"unknown"}))
+}
+
+func TestCommandUsage(t *testing.T) {
+	for name, cmd := range commands {
+		if cmd.hidden {
+			continue
+		}
+		assert.NotEqual(t, "command."+name, i18n.T("command."+name), name)
+	}
+}
+
+func TestGetMasksSecrets(t *testing.T) {
+	c, httpClient, store, stdout := newTestCLI(t, "")
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetData(mock.Anything, uint(7)).Return(credentials(t), nil)
+
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"get", "7", "-o", "json"}))
+
+	res := dataDetails{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &res))
+	assert.Equal(t, "credentials", res.Type)
+	assert.Equal(t, []records.Field{
+		{Name: "login", Value: "alice"},
+		{Name: "password", Value: secretMask, Secret: true},
+	}, res.Fields)
+}
+
+func TestGetField(t *testing.T) {
+	c, httpClient, store, stdout := newTestCLI(t, "")
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetData(mock.Anything, uint(7)).Return(credentials(t), nil)
+
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"get", "7", "--field", "password"}))
+	assert.Equal(t, "secret\n", stdout.String())
+}
+
+func TestGetCopy(t *testing.T) {
+	httpClient := httpMocks.NewClientInterface(t)
+	store := sessionMocks.NewStoreInterface(t)
+	cb := clipboardMocks.NewClipboardInterface(t)
+	stdout := &bytes.Buffer{}
+	c := NewCLI(
+		&config.Config{ServerAddress: testServer, ClipboardTimeout: time.Minute},
+		httpClient,
+		store,
+		cb,
+		strings.NewReader(""),
+		stdout,
+		&bytes.Buffer{},
+	)
+	expectSession(httpClient, store)
+	data := credentials(t)
+	data.Fields = models.DataFields{{Name: "otp", Value: "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"}}
+	httpClient.EXPECT().GetData(mock.Anything, uint(7)).Return(data, nil)
+	cb.EXPECT().Write("secret").Return(nil)
+	cb.EXPECT().ClearLater("secret", time.Minute).Return(nil)
+
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"get", "7", "--copy"}))
+	assert.Empty(t, stdout.String())
+
+	// Одноразовый код без --copy выводится
+	expectSession(httpClient, store)
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"get", "7", "--otp"}))
+	assert.Regexp(t, `^\d{6}\n$`, stdout.String())
+}
+
+func TestGetWithoutSession(t *testing.T) {
+	c, _, store, _ := newTestCLI(t, "")
+	store.EXPECT().Load().Return(nil, session.ErrNoSession)
+
+	assert.Equal(t, ExitUnauthorized, c.Run(context.Background(), []string{"get", "7"}))
+}
+
+func TestAddReadsSecretFromStdin(t *testing.T) {
+	c, httpClient, store, _ := newTestCLI(t, "secret\n")
+	expectSession(httpClient, store)
+	httpClient.EXPECT().CreateData(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, data commonRequests.DataModel) (*models.DataInfo, error) {
+			value := models.CredentialsValue{}
+			require.NoError(t, models.DecodeValue(data.Value, &value))
+			assert.Equal(t, models.CredentialsValue{Login: "alice", Password: "secret"}, value)
+			assert.Equal(t, models.DataFields{{Name: "otp", Value: "123"}}, data.Fields)
+
+			return &models.DataInfo{ID: 1, Type: data.Type, Description: data.Description}, nil
+		})
+
+	code := c.Run(context.Background(), []string{
+		"add", "--type", "credentials", "--description", "mail",
+		"--set", "login=alice", "--set", "password=-", "--field", "otp=123",
+	})
+	assert.Equal(t, ExitOK, code)
+}
+
+func TestRmNotFound(t *testing.T) {
+	c, httpClient, store, _ := newTestCLI(t, "")
+	expectSession(httpClient, store)
+	httpClient.EXPECT().Batch(mock.Anything, mock.Anything).Return([]models.DataBatchResult{
+		{Index: 0, Op: models.DataBatchDelete, ID: 1, Status: http.StatusAccepted},
+		{Index: 1, Op: models.DataBatchDelete, ID: 2, Status: http.StatusNotFound},
+	}, nil)
+
+	assert.Equal(t, ExitNotFound, c.Run(context.Background(), []string{"rm", "1", "2"}))
+}
+
+func TestVersionIncompatibleServer(t *testing.T) {
+	c, httpClient, _, stdout := newTestCLI(t, "")
+	server := version.Info{Version: "v2.0.0", APIVersion: version.APIVersion + 1}
+	httpClient.EXPECT().GetVersion(mock.Anything).Return(&server, nil)
+
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"version", "-o", "json"}))
+
+	res := versions{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &res))
+	assert.Equal(t, version.Get(), res.Client)
+	assert.Equal(t, &server, res.Server)
+	assert.Contains(t, c.stderr.(*bytes.Buffer).String(), "несовместима")
+}
+
+func TestExpiredSessionRefreshFails(t *testing.T) {
+	c, httpClient, store, _ := newTestCLI(t, "")
+	cookies := []*http.Cookie{{Name: session.AccessTokenCookie, Value: "token", Expires: time.Now().Add(-time.Minute)}}
+	store.EXPECT().Load().Return(&session.Session{Server: testServer, Login: "user", Cookies: cookies}, nil)
+	httpClient.EXPECT().SetCookies(cookies).Return()
+	httpClient.EXPECT().Refresh(mock.Anything).Return(clientHTTP.ErrUserUnauthorized)
+
+	assert.Equal(t, ExitUnauthorized, c.Run(context.Background(), []string{"list"}))
+}
+
+func TestProfileDefaultOutput(t *testing.T) {
+	httpClient := httpMocks.NewClientInterface(t)
+	store := sessionMocks.NewStoreInterface(t)
+	stdout := &bytes.Buffer{}
+	c := NewCLI(
+		&config.Config{ServerAddress: testServer, Output: outputJSON},
+		httpClient,
+		store,
+		clipboardMocks.NewClipboardInterface(t),
+		strings.NewReader(""),
+		stdout,
+		&bytes.Buffer{},
+	)
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetList(mock.Anything, models.DataType(0)).Return([]models.DataInfo{*credentials(t)}, nil)
+
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"list"}))
+
+	var res []dataSummary
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &res))
+	assert.Equal(t, uint(7), res[0].ID)
+}
+
+func TestGenerate(t *testing.T) {
+	c, _, _, stdout := newTestCLI(t, "")
+
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"generate", "-length", "12", "-no-symbols"}))
+	assert.Regexp(t, `^[a-zA-Z0-9]{12}\n$`, stdout.String())
+
+	stdout.Reset()
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"generate", "-words", "4", "-separator", ".", "-o", "json"}))
+	res := generator.Result{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &res))
+	assert.Len(t, strings.Split(res.Value, "."), 4)
+	assert.Greater(t, res.Entropy, 0.0)
+
+	assert.Equal(t, ExitUsage, c.Run(context.Background(), []string{"generate", "-length", "2"}))
+}
+
+func TestAuditJSON(t *testing.T) {
+	c, httpClient, store, stdout := newTestCLI(t, "")
+	expectSession(httpClient, store)
+	data := credentials(t)
+	data.UpdatedAt = time.Now()
+	httpClient.EXPECT().GetList(mock.Anything, models.DataTypeCredentials).Return([]models.DataInfo{*data}, nil)
+
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"audit", "--json"}))
+
+	res := audit.Report{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &res))
+	assert.Equal(t, 1, res.Total)
+	require.Len(t, res.Weak, 1)
+	assert.Equal(t, uint(7), res.Weak[0].ID)
+	assert.NotContains(t, stdout.String(), `"secret"`)
+}
+
+func TestBreach(t *testing.T) {
+	c, httpClient, store, stdout := newTestCLI(t, "")
+	path := filepath.Join(t.TempDir(), "pwned.txt")
+	require.NoError(t, os.WriteFile(path, []byte(breach.Hash(breach.KindSHA1, "secret")+":42\n"), 0o600))
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetList(mock.Anything, models.DataTypeCredentials).Return([]models.DataInfo{*credentials(t)}, nil)
+
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"breach", "-file", path, "-o", "json"}))
+
+	var res []breachResult
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &res))
+	assert.Equal(t, []breachResult{{ID: 7, Description: "mail", Login: "alice", Count: 42}}, res)
+
+	assert.Equal(t, ExitUsage, c.Run(context.Background(), []string{"breach"}))
+}
+
+func TestImport(t *testing.T) {
+	c, httpClient, store, stdout := newTestCLI(t, "")
+	path := filepath.Join(t.TempDir(), "passwords.csv")
+	require.NoError(t, os.WriteFile(path, []byte("name,url,username,password\nmail,https://mail.example.com,alice,secret\n,,,\n"), 0o600))
+
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"import", "-dry-run", "-o", "json", path}))
+
+	var previews []importPreview
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &previews))
+	assert.Equal(t, []importPreview{{Type: "credentials", Description: "mail", URLs: 1}}, previews)
+	assert.Contains(t, c.stderr.(*bytes.Buffer).String(), "пропущено: 1")
+
+	stdout.Reset()
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetList(mock.Anything, models.DataTypeUnknown).Return(nil, nil)
+	httpClient.EXPECT().Batch(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, batch commonRequests.DataBatch) ([]models.DataBatchResult, error) {
+			require.Len(t, batch.Operations, 1)
+			assert.Equal(t, models.DataBatchCreate, batch.Operations[0].Op)
+			assert.Equal(t, "mail", batch.Operations[0].Data.Description)
+
+			return []models.DataBatchResult{{Index: 0, Op: models.DataBatchCreate, ID: 9, Status: http.StatusCreated}}, nil
+		})
+
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"import", "-format", "csv", "-o", "json", path}))
+
+	var results []importResult
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &results))
+	require.Len(t, results, 1)
+	assert.Equal(t, uint(9), results[0].ID)
+
+	assert.Equal(t, ExitUsage, c.Run(context.Background(), []string{"import", "-format", "lastpass", path}))
+}
+
+func TestImportPartialFailure(t *testing.T) {
+	c, httpClient, store, stdout := newTestCLI(t, "")
+	path := filepath.Join(t.TempDir(), "passwords.csv")
+	csv := []byte("name,url,username,password\n")
+	for i := 0; i <= commonRequests.DataBatchMaxOperations; i++ {
+		csv = fmt.Appendf(csv, "mail%d,,alice,secret\n", i)
+	}
+	require.NoError(t, os.WriteFile(path, csv, 0o600))
+
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetList(mock.Anything, models.DataTypeUnknown).Return(nil, nil)
+	httpClient.EXPECT().Batch(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, batch commonRequests.DataBatch) ([]models.DataBatchResult, error) {
+			results := make([]models.DataBatchResult, 0, len(batch.Operations))
+			for i := range batch.Operations {
+				results = append(results, models.DataBatchResult{Index: i, Op: models.DataBatchCreate, ID: uint(i + 1), Status: http.StatusCreated})
+			}
+
+			return results, nil
+		}).Once()
+	httpClient.EXPECT().Batch(mock.Anything, mock.Anything).Return(nil, clientHTTP.ErrServerProblem).Once()
+
+	// Созданные до ошибки записи выводятся, команда завершается ошибкой
+	assert.NotEqual(t, ExitOK, c.Run(context.Background(), []string{"import", "-format", "csv", "-o", "json", path}))
+
+	var results []importResult
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &results))
+	assert.Len(t, results, commonRequests.DataBatchMaxOperations)
+	assert.Contains(t, c.stderr.(*bytes.Buffer).String(), fmt.Sprintf("создано записей: %d", commonRequests.DataBatchMaxOperations))
+}
+
+func TestExportBackupAndRestore(t *testing.T) {
+	t.Setenv(BackupPasswordEnv, "backup-secret")
+	c, httpClient, store, _ := newTestCLI(t, "")
+	path := filepath.Join(t.TempDir(), "vault.gkb")
+	data := credentials(t)
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetList(mock.Anything, models.DataTypeUnknown).Return([]models.DataInfo{*data}, nil)
+
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"export", path}))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(content), "alice")
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// Запись уже есть на сервере, поэтому повторное восстановление ничего не создает
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"import", path}))
+	assert.Contains(t, c.stderr.(*bytes.Buffer).String(), "создано записей: 0, уже были на сервере: 1")
+}
+
+func TestExportPlaintextConfirmation(t *testing.T) {
+	c, _, _, _ := newTestCLI(t, "n\n")
+	path := filepath.Join(t.TempDir(), "vault.json")
+
+	assert.Equal(t, ExitError, c.Run(context.Background(), []string{"export", "-format", "json", path}))
+	assert.NoFileExists(t, path)
+
+	c, httpClient, store, stdout := newTestCLI(t, "")
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetList(mock.Anything, models.DataTypeUnknown).Return([]models.DataInfo{*credentials(t)}, nil)
+
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"export", "-format", "csv", "-yes", "-"}))
+	assert.Contains(t, stdout.String(), "7,credentials,mail,password,secret,false")
+}
+
+func TestRun(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh is not available")
+	}
+	c, httpClient, store, stdout := newTestCLI(t, "")
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetList(mock.Anything, models.DataTypeUnknown).Return([]models.DataInfo{*credentials(t)}, nil)
+	t.Setenv("MAIL_PASSWORD", "gk://7/password")
+	envFile := filepath.Join(t.TempDir(), ".env")
+	require.NoError(t, os.WriteFile(envFile, []byte("MAIL_LOGIN=gk://mail/login\n"), 0o600))
+
+	code := c.Run(context.Background(), []string{"run", "-env-file", envFile, "--", "sh", "-c", `echo "$MAIL_LOGIN:$MAIL_PASSWORD"; exit 3`})
+	assert.Equal(t, 3, code)
+	assert.Equal(t, "<concealed by gophkeeper>:<concealed by gophkeeper>\n", stdout.String())
+
+	stdout.Reset()
+	code = c.Run(context.Background(), []string{"run", "-no-masking", "sh", "-c", `echo "$MAIL_PASSWORD"`})
+	assert.Equal(t, ExitOK, code)
+	assert.Equal(t, "secret\n", stdout.String())
+}
+
+func TestInject(t *testing.T) {
+	c, httpClient, store, _ := newTestCLI(t, "LOGIN={{ gk://7/login }}\nPASSWORD={{ gk://mail/password }}\n")
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetList(mock.Anything, models.DataTypeUnknown).Return([]models.DataInfo{*credentials(t)}, nil)
+	out := filepath.Join(t.TempDir(), ".env")
+
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{"inject", "-out", out}))
+
+	content, err := os.ReadFile(out)
+	require.NoError(t, err)
+	assert.Equal(t, "LOGIN=alice\nPASSWORD=secret\n", string(content))
+	info, err := os.Stat(out)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
+
+func TestGitCredential(t *testing.T) {
+	data := credentials(t)
+	data.URLs = models.DataURLs{{URL: "https://git.example.com", Match: models.URLMatchHost}}
+	input := "protocol=https\nhost=git.example.com\n"
+
+	c, httpClient, store, stdout := newTestCLI(t, input)
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetList(mock.Anything, models.DataTypeCredentials).Return([]models.DataInfo{*data}, nil)
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{GitCredentialCommand, "get"}))
+	assert.Equal(t, "username=alice\npassword=secret\n", stdout.String())
+
+	// Новый пароль для того же логина обновляет запись
+	c, httpClient, store, _ = newTestCLI(t, input+"username=alice\npassword=rotated\n")
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetList(mock.Anything, models.DataTypeCredentials).Return([]models.DataInfo{*data}, nil)
+	httpClient.EXPECT().UpdateData(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, updated models.DataInfo) (*models.DataInfo, error) {
+			value := models.CredentialsValue{}
+			require.NoError(t, models.DecodeValue(updated.Value, &value))
+			assert.Equal(t, models.CredentialsValue{Login: "alice", Password: "rotated"}, value)
+			return &updated, nil
+		})
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{GitCredentialCommand, "store"}))
+
+	// Другой логин сохраняется новой записью
+	c, httpClient, store, _ = newTestCLI(t, input+"username=bot\npassword=token\n")
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetList(mock.Anything, models.DataTypeCredentials).Return([]models.DataInfo{*data}, nil)
+	httpClient.EXPECT().CreateData(mock.Anything, mock.MatchedBy(func(model commonRequests.DataModel) bool {
+		return model.Description == "git.example.com" && model.Type == models.DataTypeCredentials
+	})).Return(&models.DataInfo{ID: 8}, nil)
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{GitCredentialCommand, "store"}))
+
+	// Удаляются только отклоненные учетные данные
+	c, httpClient, store, _ = newTestCLI(t, input+"username=alice\npassword=secret\n")
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetList(mock.Anything, models.DataTypeCredentials).Return([]models.DataInfo{*data}, nil)
+	httpClient.EXPECT().DeleteData(mock.Anything, uint(7)).Return(nil)
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{GitCredentialCommand, "erase"}))
+
+	c, httpClient, store, _ = newTestCLI(t, input+"username=alice\npassword=outdated\n")
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetList(mock.Anything, models.DataTypeCredentials).Return([]models.DataInfo{*data}, nil)
+	assert.Equal(t, ExitOK, c.Run(context.Background(), []string{GitCredentialCommand, "erase"}))
+}
+
+func TestSSHAgent(t *testing.T) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
+	require.NoError(t, err)
+	value, err := models.EncodeValue(models.SSHKeyValue{PrivateKey: string(pem.EncodeToMemory(block))})
+	require.NoError(t, err)
+	dataList := []models.DataInfo{
+		{ID: 3, Type: models.DataTypeSSHKey, Description: "deploy", Value: value},
+		{ID: 4, Type: models.DataTypeSSHKey, Description: "other", Value: value},
+	}
+	socketPath := filepath.Join(t.TempDir(), "agent", "agent.sock")
+
+	c, httpClient, store, stdout := newTestCLI(t, "")
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetList(mock.Anything, models.DataTypeSSHKey).Return(dataList, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan int, 1)
+	go func() {
+		done <- c.Run(ctx, []string{"ssh-agent", "-socket", socketPath, "3"})
+	}()
+
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(socketPath)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	conn, err := net.Dial("unix", socketPath)
+	require.NoError(t, err)
+	keys, err := agent.NewClient(conn).List()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+	assert.Equal(t, "deploy", keys[0].Comment)
+	require.NoError(t, conn.Close())
+
+	cancel()
+	assert.Equal(t, ExitOK, <-done)
+	assert.Equal(t, "SSH_AUTH_SOCK="+socketPath+"; export SSH_AUTH_SOCK;\n", stdout.String())
+
+	// Без ключей агент не запускается
+	c, httpClient, store, _ = newTestCLI(t, "")
+	expectSession(httpClient, store)
+	httpClient.EXPECT().GetList(mock.Anything, models.DataTypeSSHKey).Return(nil, nil)
+	assert.Equal(t, ExitError, c.Run(context.Background(), []string{"ssh-agent", "-socket", socketPath}))
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/clipboard"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+)
+
+// copyToClipboard - скопировать значение в буфер обмена и запланировать его очистку
+func (c *CLI) copyToClipboard(text string, name string) error {
+	err := c.clipboard.Write(text)
+	if err != nil {
+		return err
+	}
+
+	timeout := c.config.ClipboardTimeout
+	if timeout <= 0 {
+		fmt.Fprintln(c.stderr, i18n.T("cli.copied", name))
+		return nil
+	}
+
+	err = c.clipboard.ClearLater(text, timeout)
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T("cli.copied_no_clear", name), err)
+	}
+	fmt.Fprintln(c.stderr, i18n.T("cli.copied_for", name, timeout))
+
+	return nil
+}
+
+// clipboardClear - очистить буфер обмена через -after, если в нем осталось скопированное значение.
+// Запускается командой get --copy в фоне, сумма значения передается через stdin.
+func (c *CLI) clipboardClear(_ context.Context, args []string) error {
+	flags := c.newFlagSet(clipboard.ClearCommand)
+	after := flags.Duration("after", c.config.ClipboardTimeout, i18n.T("cli.flag.clear_after"))
+	if _, err := c.parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	return clipboard.WaitAndClear(c.clipboard, c.stdin, *after)
+}
@@ -0,0 +1,519 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	clientHTTP "github.com/ShukinDmitriy/GophKeeper/internal/client/http"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+)
+
+// secretMask - замена секретных значений при выводе
+const secretMask = "********"
+
+// stringList - флаг, который можно указать несколько раз
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// dataSummary - запись без значения для вывода списком
+type dataSummary struct {
+	ID          uint       `json:"id"`
+	Type        string     `json:"type"`
+	Description string     `json:"description"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	RotateAt    *time.Time `json:"rotate_at,omitempty"`
+}
+
+// dataDetails - запись с полями значения
+type dataDetails struct {
+	dataSummary
+	Fields []records.Field `json:"fields"`
+	URLs   models.DataURLs `json:"urls,omitempty"`
+}
+
+// newDataSummary - краткое описание записи
+func newDataSummary(data models.DataInfo) dataSummary {
+	return dataSummary{
+		ID:          data.ID,
+		Type:        records.Name(data.Type),
+		Description: data.Description,
+		UpdatedAt:   data.UpdatedAt,
+		ExpiresAt:   data.ExpiresAt,
+		RotateAt:    data.RotateAt,
+	}
+}
+
+// formatDate - дата для таблицы, пустая строка для отсутствующей даты
+func formatDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+
+	return date.Local().Format(time.DateOnly)
+}
+
+// parseID - разобрать идентификатор записи
+func parseID(text string) (uint, error) {
+	id, err := strconv.ParseUint(text, 10, 0)
+	if err != nil || id == 0 {
+		return 0, fmt.Errorf("%s: %w", i18n.T("cli.invalid_id", text), errUsage)
+	}
+
+	return uint(id), nil
+}
+
+// parseAssignment - разобрать аргумент вида name=value
+func parseAssignment(text string) (string, string, error) {
+	name, value, ok := strings.Cut(text, "=")
+	if !ok || strings.TrimSpace(name) == "" {
+		return "", "", fmt.Errorf("%s: %w", i18n.T("cli.invalid_pair", text), errUsage)
+	}
+
+	return strings.TrimSpace(name), value, nil
+}
+
+// list - вывести список записей
+func (c *CLI) list(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("list")
+	typeName := flags.String("type", "", i18n.T("cli.flag.list_type", typeNames()))
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		flags.Usage()
+		return errUsage
+	}
+
+	var dataType models.DataType
+	if *typeName != "" {
+		if dataType, err = parseType(*typeName); err != nil {
+			return err
+		}
+	}
+
+	var dataList []models.DataInfo
+	err = c.withSession(ctx, func() error {
+		dataList, err = c.http.GetList(ctx, dataType)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	summaries := make([]dataSummary, 0, len(dataList))
+	rows := make([][]string, 0, len(dataList))
+	for _, data := range dataList {
+		summary := newDataSummary(data)
+		summaries = append(summaries, summary)
+		rows = append(rows, []string{
+			strconv.FormatUint(uint64(summary.ID), 10),
+			summary.Type,
+			summary.Description,
+			summary.UpdatedAt.Local().Format(time.DateOnly),
+			formatDate(summary.ExpiresAt),
+		})
+	}
+
+	return c.print(summaries, []string{"ID", i18n.T("column.type"), i18n.T("column.description"), i18n.T("column.updated"), i18n.T("column.expires")}, rows)
+}
+
+// get - вывести запись или одно ее поле
+func (c *CLI) get(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("get")
+	fieldName := flags.String("field", "", i18n.T("cli.flag.field"))
+	reveal := flags.Bool("reveal", false, i18n.T("cli.flag.reveal"))
+	otp := flags.Bool("otp", false, i18n.T("cli.flag.otp"))
+	copyValue := flags.Bool("copy", false, i18n.T("cli.flag.copy"))
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		flags.Usage()
+		return errUsage
+	}
+	id, err := parseID(positional[0])
+	if err != nil {
+		return err
+	}
+
+	var data *models.DataInfo
+	err = c.withSession(ctx, func() error {
+		data, err = c.http.GetData(ctx, id)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	fields, err := records.ValueFields(*data)
+	if err != nil {
+		return err
+	}
+
+	if *otp {
+		code, err := records.OTPCode(fields, time.Now())
+		if err != nil {
+			return err
+		}
+		if *copyValue {
+			return c.copyToClipboard(code, i18n.T("cli.otp"))
+		}
+		_, err = fmt.Fprintln(c.stdout, code)
+
+		return err
+	}
+
+	if *copyValue {
+		name := *fieldName
+		if name == "" {
+			name = records.CopyField(data.Type)
+		}
+		field, err := records.FindField(fields, name)
+		if err != nil {
+			return err
+		}
+
+		return c.copyToClipboard(field.Value, field.Name)
+	}
+
+	// Запрошенное поле выводится как есть, чтобы его можно было передать в другую команду
+	if *fieldName != "" {
+		field, err := records.FindField(fields, *fieldName)
+		if err != nil {
+			return err
+		}
+		if c.output == outputJSON {
+			return c.printJSON(field)
+		}
+		_, err = fmt.Fprintln(c.stdout, field.Value)
+
+		return err
+	}
+
+	if !*reveal {
+		for i := range fields {
+			if fields[i].Secret && fields[i].Value != "" {
+				fields[i].Value = secretMask
+			}
+		}
+	}
+
+	details := dataDetails{
+		dataSummary: newDataSummary(*data),
+		Fields:      fields,
+		URLs:        data.URLs,
+	}
+
+	rows := [][]string{
+		{"id", strconv.FormatUint(uint64(details.ID), 10)},
+		{"type", details.Type},
+		{"description", details.Description},
+		{"updated_at", details.UpdatedAt.Local().Format(time.DateTime)},
+	}
+	if details.RotateAt != nil {
+		rows = append(rows, []string{"rotate_at", formatDate(details.RotateAt)})
+	}
+	if details.ExpiresAt != nil {
+		rows = append(rows, []string{"expires_at", formatDate(details.ExpiresAt)})
+	}
+	for _, field := range fields {
+		rows = append(rows, []string{field.Name, field.Value})
+	}
+	for _, dataURL := range data.URLs {
+		rows = append(rows, []string{"url", dataURL.URL})
+	}
+
+	return c.print(details, nil, rows)
+}
+
+// dataFlags - флаги изменения записи, общие для add и edit
+type dataFlags struct {
+	description *string
+	set         stringList
+	fields      stringList
+	urls        stringList
+}
+
+// newDataFlags - зарегистрировать флаги изменения записи
+func newDataFlags(flags *flag.FlagSet) *dataFlags {
+	res := &dataFlags{
+		description: flags.String("description", "", i18n.T("cli.flag.description")),
+	}
+	flags.Var(&res.set, "set", i18n.T("cli.flag.set"))
+	flags.Var(&res.fields, "field", i18n.T("cli.flag.custom_field"))
+	flags.Var(&res.urls, "url", i18n.T("cli.flag.url"))
+
+	return res
+}
+
+// apply - применить флаги к записи
+func (c *CLI) apply(flags *dataFlags, data *models.DataInfo) error {
+	value := models.NewValue(data.Type)
+	if value == nil {
+		return records.ErrUnknownType
+	}
+	if data.Value != "" {
+		if err := models.DecodeValue(data.Value, value); err != nil {
+			return fmt.Errorf("decode value: %w", err)
+		}
+	}
+
+	for _, assignment := range flags.set {
+		name, text, err := parseAssignment(assignment)
+		if err != nil {
+			return err
+		}
+		// Секреты не стоит передавать в аргументах, они видны в списке процессов
+		if text == "-" {
+			if text, err = c.readPassword(name + ": "); err != nil {
+				return err
+			}
+		}
+		if err = records.SetValueField(value, name, text); err != nil {
+			return err
+		}
+	}
+
+	for _, assignment := range flags.fields {
+		name, text, err := parseAssignment(assignment)
+		if err != nil {
+			return err
+		}
+		data.Fields = setCustomField(data.Fields, name, text)
+	}
+
+	for _, dataURL := range flags.urls {
+		data.URLs = append(data.URLs, models.DataURL{URL: dataURL})
+	}
+
+	encoded, err := models.EncodeValue(value)
+	if err != nil {
+		return err
+	}
+	data.Value = encoded
+
+	return nil
+}
+
+// setCustomField - изменить пользовательское поле или добавить новое
+func setCustomField(fields models.DataFields, name string, value string) models.DataFields {
+	for i := range fields {
+		if strings.EqualFold(fields[i].Name, name) {
+			fields[i].Value = value
+			return fields
+		}
+	}
+
+	return append(fields, models.DataField{Name: name, Value: value})
+}
+
+// add - создать запись
+func (c *CLI) add(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("add")
+	typeName := flags.String("type", "", i18n.T("cli.flag.add_type", typeNames()))
+	changes := newDataFlags(flags)
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if *typeName == "" || len(positional) > 0 {
+		flags.Usage()
+		return errUsage
+	}
+	dataType, err := parseType(*typeName)
+	if err != nil {
+		return err
+	}
+
+	data := models.DataInfo{
+		Type:        dataType,
+		Description: *changes.description,
+	}
+	if err = c.apply(changes, &data); err != nil {
+		return err
+	}
+
+	var created *models.DataInfo
+	err = c.withSession(ctx, func() error {
+		created, err = c.http.CreateData(ctx, commonRequests.DataModel{
+			Type:        data.Type,
+			Description: data.Description,
+			Value:       data.Value,
+			Fields:      data.Fields,
+			URLs:        data.URLs,
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.printChanged(*created)
+}
+
+// edit - изменить запись
+func (c *CLI) edit(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("edit")
+	changes := newDataFlags(flags)
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		flags.Usage()
+		return errUsage
+	}
+	id, err := parseID(positional[0])
+	if err != nil {
+		return err
+	}
+
+	var updated *models.DataInfo
+	err = c.withSession(ctx, func() error {
+		data, err := c.http.GetData(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		flags.Visit(func(f *flag.Flag) {
+			if f.Name == "description" {
+				data.Description = *changes.description
+			}
+		})
+		if err = c.apply(changes, data); err != nil {
+			return err
+		}
+
+		updated, err = c.http.UpdateData(ctx, *data)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.printChanged(*updated)
+}
+
+// printChanged - вывести созданную или измененную запись
+func (c *CLI) printChanged(data models.DataInfo) error {
+	summary := newDataSummary(data)
+
+	return c.print(summary, []string{"ID", i18n.T("column.type"), i18n.T("column.description")}, [][]string{{
+		strconv.FormatUint(uint64(summary.ID), 10),
+		summary.Type,
+		summary.Description,
+	}})
+}
+
+// rm - удалить записи
+func (c *CLI) rm(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("rm")
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		flags.Usage()
+		return errUsage
+	}
+
+	operations := make([]commonRequests.DataBatchOperation, 0, len(positional))
+	for _, text := range positional {
+		id, err := parseID(text)
+		if err != nil {
+			return err
+		}
+		operations = append(operations, commonRequests.DataBatchOperation{
+			Op: models.DataBatchDelete,
+			ID: id,
+		})
+	}
+
+	// Удаление идет пакетами, чтобы получить результат по каждой записи
+	results := make([]models.DataBatchResult, 0, len(operations))
+	err = c.withSession(ctx, func() error {
+		for start := 0; start < len(operations); start += commonRequests.DataBatchMaxOperations {
+			end := min(start+commonRequests.DataBatchMaxOperations, len(operations))
+			chunk, err := c.http.Batch(ctx, commonRequests.DataBatch{Operations: operations[start:end]})
+			if err != nil {
+				return err
+			}
+			for _, result := range chunk {
+				result.Index += start
+				results = append(results, result)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	rows := make([][]string, 0, len(results))
+	failed, notFound := 0, 0
+	for _, result := range results {
+		status := i18n.T("cli.deleted")
+		if result.Status != http.StatusOK && result.Status != http.StatusAccepted {
+			failed++
+			status = i18n.T(i18n.CodeKey(result.Code))
+			if result.Status == http.StatusNotFound {
+				notFound++
+				status = i18n.T("cli.not_found")
+			}
+		}
+		rows = append(rows, []string{strconv.FormatUint(uint64(operations[result.Index].ID), 10), status})
+	}
+
+	if err = c.print(results, []string{"ID", i18n.T("column.status")}, rows); err != nil {
+		return err
+	}
+
+	switch {
+	case failed == 0:
+		return nil
+	case failed == notFound:
+		return fmt.Errorf("%s: %w", i18n.T("cli.not_found_count", notFound), clientHTTP.ErrNotFound)
+	}
+
+	return i18n.NewError("data.delete_failed", failed)
+}
+
+// parseType - тип записи по короткому имени
+func parseType(name string) (models.DataType, error) {
+	typeInfo, ok := records.ByName(name)
+	if !ok {
+		return 0, fmt.Errorf("%s: %w", i18n.T("cli.unknown_type", name, typeNames()), errUsage)
+	}
+
+	return typeInfo.Type, nil
+}
+
+// typeNames - короткие имена типов записей через запятую
+func typeNames() string {
+	names := make([]string, 0, len(records.Types))
+	for _, typeInfo := range records.Types {
+		names = append(names, typeInfo.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
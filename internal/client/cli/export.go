@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/backup"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/version"
+)
+
+// BackupPasswordEnv - переменная окружения с паролем резервной копии
+const BackupPasswordEnv = "GOPHKEEPER_BACKUP_PASSWORD"
+
+// Форматы выгрузки
+const (
+	exportBackup = "backup"
+	exportJSON   = "json"
+	exportCSV    = "csv"
+)
+
+var (
+	errExportCanceled   = i18n.NewError("cli.export.canceled")
+	errPasswordMismatch = i18n.NewError("cli.password_mismatch")
+)
+
+// export - выгрузить все записи в зашифрованную резервную копию или, после
+// подтверждения, в JSON или CSV без шифрования
+func (c *CLI) export(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("export")
+	format := flags.String("format", exportBackup, i18n.T("cli.flag.export_format"))
+	yes := flags.Bool("yes", false, i18n.T("cli.flag.yes"))
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		flags.Usage()
+		return errUsage
+	}
+	path := positional[0]
+
+	var password string
+	switch *format {
+	case exportBackup:
+		if password, err = c.backupPassword(true); err != nil {
+			return err
+		}
+	case exportJSON, exportCSV:
+		if !*yes {
+			ok, err := c.confirm(i18n.T("cli.export.confirm", path))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return errExportCanceled
+			}
+		}
+	default:
+		return fmt.Errorf("%s: %w", i18n.T("cli.unknown_format", *format), errUsage)
+	}
+
+	var dataList []models.DataInfo
+	err = c.withSession(ctx, func() error {
+		dataList, err = c.http.GetList(ctx, models.DataTypeUnknown)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	archive := backup.Archive{
+		CreatedAt:     time.Now().UTC(),
+		ClientVersion: version.Get().Version,
+		Records:       dataList,
+	}
+
+	err = c.writeSecretFile(path, func(w io.Writer) error {
+		switch *format {
+		case exportJSON:
+			return backup.WriteJSON(w, archive)
+		case exportCSV:
+			return backup.WriteCSV(w, archive)
+		}
+		return backup.Write(w, archive, password)
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(c.stderr, i18n.T("cli.export.done", len(dataList)))
+
+	return nil
+}
+
+// writeSecretFile - записать данные в файл с доступом только для владельца
+// или в stdout, если путь "-". Недописанный файл удаляется.
+func (c *CLI) writeSecretFile(path string, write func(w io.Writer) error) error {
+	if path == "-" {
+		return write(c.stdout)
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	// Права существующего файла не меняются при открытии
+	if err = file.Chmod(0o600); err != nil {
+		file.Close()
+		return err
+	}
+
+	err = write(file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+	}
+
+	return err
+}
+
+// backupPassword - пароль резервной копии из BackupPasswordEnv или с терминала.
+// Для нового архива пароль на терминале запрашивается дважды.
+func (c *CLI) backupPassword(create bool) (string, error) {
+	password, ok := os.LookupEnv(BackupPasswordEnv)
+	if !ok {
+		var err error
+		if password, err = c.readPassword(i18n.T("cli.export.password")); err != nil {
+			return "", err
+		}
+		if create && c.stdinIsTerminal() {
+			repeat, err := c.readPassword(i18n.T("cli.export.repeat"))
+			if err != nil {
+				return "", err
+			}
+			if repeat != password {
+				return "", errPasswordMismatch
+			}
+		}
+	}
+	if password == "" {
+		return "", errEmptyPassword
+	}
+
+	return password, nil
+}
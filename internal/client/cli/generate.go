@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/generator"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+)
+
+// generate - сгенерировать пароль или парольную фразу. Значение выводится в stdout,
+// оценка энтропии - в stderr, чтобы значение можно было передать в другую команду.
+func (c *CLI) generate(_ context.Context, args []string) error {
+	passwordOpts := generator.DefaultPasswordOptions()
+	passphraseOpts := generator.DefaultPassphraseOptions()
+
+	flags := c.newFlagSet("generate")
+	flags.IntVar(&passwordOpts.Length, "length", passwordOpts.Length, i18n.T("cli.flag.length"))
+	noLower := flags.Bool("no-lower", false, i18n.T("cli.flag.no_lower"))
+	noUpper := flags.Bool("no-upper", false, i18n.T("cli.flag.no_upper"))
+	noDigits := flags.Bool("no-digits", false, i18n.T("cli.flag.no_digits"))
+	noSymbols := flags.Bool("no-symbols", false, i18n.T("cli.flag.no_symbols"))
+	ambiguous := flags.Bool("ambiguous", false, i18n.T("cli.flag.ambiguous"))
+	words := flags.Int("words", 0, i18n.T("cli.flag.words"))
+	flags.StringVar(&passphraseOpts.Separator, "separator", passphraseOpts.Separator, i18n.T("cli.flag.separator"))
+	flags.BoolVar(&passphraseOpts.Capitalize, "capitalize", false, i18n.T("cli.flag.capitalize"))
+	flags.BoolVar(&passphraseOpts.Number, "number", false, i18n.T("cli.flag.number"))
+	copyValue := flags.Bool("copy", false, i18n.T("cli.flag.generate_copy"))
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		flags.Usage()
+		return errUsage
+	}
+
+	var res generator.Result
+	if *words > 0 {
+		passphraseOpts.Words = *words
+		res, err = generator.Passphrase(passphraseOpts)
+	} else {
+		passwordOpts.Lower = !*noLower
+		passwordOpts.Upper = !*noUpper
+		passwordOpts.Digits = !*noDigits
+		passwordOpts.Symbols = !*noSymbols
+		passwordOpts.ExcludeAmbiguous = !*ambiguous
+		res, err = generator.Password(passwordOpts)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %w", errUsage, err)
+	}
+
+	if c.output == outputJSON && !*copyValue {
+		return c.printJSON(res)
+	}
+	fmt.Fprintln(c.stderr, i18n.T("cli.entropy", res.Entropy, generator.Strength(res.Entropy)))
+	if *copyValue {
+		return c.copyToClipboard(res.Value, i18n.T("cli.password"))
+	}
+	_, err = fmt.Fprintln(c.stdout, res.Value)
+
+	return err
+}
@@ -0,0 +1,81 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/gitcred"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// GitCredentialCommand - команда протокола git credential helper. Клиент, запущенный под
+// именем git-credential-gophkeeper, сразу выполняет ее.
+const GitCredentialCommand = "git-credential"
+
+// gitCredential - git credential helper: get выводит логин и пароль для адреса,
+// store сохраняет или обновляет учетные данные, erase удаляет отклоненные
+func (c *CLI) gitCredential(ctx context.Context, args []string) error {
+	flags := c.newFlagSet(GitCredentialCommand)
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		flags.Usage()
+		return errUsage
+	}
+	action := positional[0]
+	if action != "get" && action != "store" && action != "erase" {
+		// Неизвестные действия по протоколу нужно молча пропускать
+		return nil
+	}
+
+	cred, err := gitcred.Read(c.stdin)
+	if err != nil {
+		return err
+	}
+
+	return c.withSession(ctx, func() error {
+		dataList, err := c.http.GetList(ctx, models.DataTypeCredentials)
+		if err != nil {
+			return err
+		}
+		match, found := gitcred.Find(dataList, cred)
+
+		switch action {
+		case "get":
+			if !found {
+				return nil
+			}
+			return gitcred.Credential{Username: match.Value.Login, Password: match.Value.Password}.Write(c.stdout)
+		case "store":
+			if cred.Username == "" || cred.Password == "" {
+				return nil
+			}
+			if !found {
+				data, err := gitcred.NewData(cred)
+				if err != nil {
+					return err
+				}
+				_, err = c.http.CreateData(ctx, data)
+				return err
+			}
+			if match.Value.Password == cred.Password {
+				return nil
+			}
+
+			match.Value.Password = cred.Password
+			if match.Data.Value, err = models.EncodeValue(match.Value); err != nil {
+				return err
+			}
+			_, err = c.http.UpdateData(ctx, match.Data)
+			return err
+		}
+
+		// erase: удаляются только те учетные данные, которые git отклонил
+		if !found || cred.Password == "" || match.Value.Password != cred.Password {
+			return nil
+		}
+
+		return c.http.DeleteData(ctx, match.Data.ID)
+	})
+}
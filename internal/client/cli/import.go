@@ -0,0 +1,193 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/importer"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// importPreview - запись, которая будет создана при импорте
+type importPreview struct {
+	Type        string `json:"type"`
+	Description string `json:"description"`
+	Folder      string `json:"folder,omitempty"`
+	Fields      int    `json:"fields"`
+	URLs        int    `json:"urls"`
+}
+
+// importResult - результат создания записи при импорте
+type importResult struct {
+	importPreview
+	ID     uint             `json:"id,omitempty"`
+	Status int              `json:"status"`
+	Code   models.ErrorCode `json:"code,omitempty"`
+}
+
+// newImportPreview - описание записи для предпросмотра
+func newImportPreview(item importer.Item) importPreview {
+	return importPreview{
+		Type:        records.Name(item.Data.Type),
+		Description: item.Data.Description,
+		Folder:      item.Folder,
+		Fields:      len(item.Data.Fields),
+		URLs:        len(item.Data.URLs),
+	}
+}
+
+// importData - импортировать записи из резервной копии или экспорта другого менеджера
+// паролей. С флагом -dry-run только показывает, какие записи будут созданы.
+func (c *CLI) importData(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("import")
+	formatName := flags.String("format", "", i18n.T("cli.flag.import_format", importer.Names()))
+	dryRun := flags.Bool("dry-run", false, i18n.T("cli.flag.dry_run"))
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		flags.Usage()
+		return errUsage
+	}
+	path := positional[0]
+
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	format, ok := importer.ByName(*formatName)
+	if *formatName != "" && !ok {
+		return fmt.Errorf("%s: %w", i18n.T("cli.import.unknown_format", *formatName, importer.Names()), errUsage)
+	}
+	if !ok {
+		head := make([]byte, 512)
+		n, err := io.ReadFull(file, head)
+		if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+			return err
+		}
+		if format, err = importer.Detect(path, head[:n]); err != nil {
+			return fmt.Errorf("%s: %w", i18n.T("cli.import.detect_failed", importer.Names()), errUsage)
+		}
+		if _, err = file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+	}
+
+	options := importer.Options{}
+	switch {
+	case format.Name == importer.FormatBackup:
+		if options.Password, err = c.backupPassword(false); err != nil {
+			return err
+		}
+	case format.NeedPassword:
+		if options.Password, err = c.readPassword(i18n.T("cli.import.password")); err != nil {
+			return err
+		}
+	}
+
+	result, err := format.Parse(file, options)
+	if err != nil {
+		return err
+	}
+	for _, skipped := range result.Skipped {
+		fmt.Fprintln(c.stderr, i18n.T("cli.import.skipped", skipped.Name, skipped.Reason))
+	}
+
+	if *dryRun {
+		previews := make([]importPreview, 0, len(result.Items))
+		for _, item := range result.Items {
+			previews = append(previews, newImportPreview(item))
+		}
+		rows := make([][]string, 0, len(previews))
+		for _, preview := range previews {
+			rows = append(rows, []string{
+				preview.Type,
+				preview.Description,
+				preview.Folder,
+				strconv.Itoa(preview.Fields),
+				strconv.Itoa(preview.URLs),
+			})
+		}
+		fmt.Fprintln(c.stderr, i18n.T("cli.import.preview", format.Name, len(previews), len(result.Skipped)))
+
+		return c.print(previews, []string{i18n.T("column.type"), i18n.T("column.description"), i18n.T("column.folder"), i18n.T("column.fields"), "URL"}, rows)
+	}
+
+	if len(result.Items) == 0 {
+		fmt.Fprintln(c.stderr, i18n.T("cli.import.empty"))
+		return nil
+	}
+
+	created, duplicates := 0, 0
+	err = c.withSession(ctx, func() error {
+		// Записи, которые уже есть на сервере, пропускаются, поэтому импорт можно повторять
+		existing, err := c.http.GetList(ctx, models.DataTypeUnknown)
+		if err != nil {
+			return err
+		}
+		var items []importer.Item
+		items, duplicates = importer.Dedupe(result.Items, existing)
+
+		// Если пакет завершился ошибкой, результаты уже отправленных пакетов все равно выводятся
+		batchResults, uploadErr := importer.Upload(ctx, c.http, items)
+		if uploadErr != nil && len(batchResults) == 0 {
+			return uploadErr
+		}
+
+		results := make([]importResult, 0, len(batchResults))
+		for _, batchResult := range batchResults {
+			results = append(results, importResult{
+				importPreview: newImportPreview(items[batchResult.Index]),
+				ID:            batchResult.ID,
+				Status:        batchResult.Status,
+				Code:          batchResult.Code,
+			})
+			if batchResult.Status == http.StatusCreated {
+				created++
+			}
+		}
+
+		if err = c.printImport(results); err != nil {
+			return err
+		}
+
+		return uploadErr
+	})
+	if err != nil {
+		if created > 0 {
+			fmt.Fprintln(c.stderr, i18n.T("cli.import.summary", created, duplicates))
+		}
+		return err
+	}
+
+	fmt.Fprintln(c.stderr, i18n.T("cli.import.summary", created, duplicates))
+	if created+duplicates < len(result.Items) {
+		return i18n.NewError("cli.import.failed", len(result.Items)-created-duplicates)
+	}
+
+	return nil
+}
+
+// printImport - вывести результат импорта
+func (c *CLI) printImport(results []importResult) error {
+	rows := make([][]string, 0, len(results))
+	for _, res := range results {
+		status := strconv.FormatUint(uint64(res.ID), 10)
+		if res.Status != http.StatusCreated {
+			status = i18n.T(i18n.CodeKey(res.Code))
+		}
+		rows = append(rows, []string{res.Type, res.Description, res.Folder, status})
+	}
+
+	return c.print(results, []string{i18n.T("column.type"), i18n.T("column.description"), i18n.T("column.folder"), "ID"}, rows)
+}
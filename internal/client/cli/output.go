@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/config"
+)
+
+// Форматы вывода
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+// defaultOutput - формат вывода из профиля или таблица
+func defaultOutput(config *config.Config) string {
+	if config.Output == "" {
+		return outputTable
+	}
+
+	return config.Output
+}
+
+// printJSON - вывести значение в формате JSON
+func (c *CLI) printJSON(value interface{}) error {
+	encoder := json.NewEncoder(c.stdout)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(value)
+}
+
+// printTable - вывести таблицу с выравниванием колонок
+func (c *CLI) printTable(header []string, rows [][]string) error {
+	writer := tabwriter.NewWriter(c.stdout, 0, 0, 2, ' ', 0)
+	if len(header) > 0 {
+		fmt.Fprintln(writer, strings.Join(header, "\t"))
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			// Переводы строк ломают таблицу
+			row[i] = strings.NewReplacer("\n", `\n`, "\t", " ").Replace(cell)
+		}
+		fmt.Fprintln(writer, strings.Join(row, "\t"))
+	}
+
+	return writer.Flush()
+}
+
+// print - вывести результат в выбранном формате
+func (c *CLI) print(value interface{}, header []string, rows [][]string) error {
+	if c.output == outputJSON {
+		return c.printJSON(value)
+	}
+
+	return c.printTable(header, rows)
+}
@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/secretref"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// childExitError - дочерний процесс завершился с ненулевым кодом, клиент завершается с тем же кодом
+type childExitError struct {
+	code int
+}
+
+func (e *childExitError) Error() string {
+	return i18n.T("cli.run.exit_code", e.code)
+}
+
+// newResolver - загрузить записи для разрешения ссылок gk://
+func (c *CLI) newResolver(ctx context.Context) (*secretref.Resolver, error) {
+	var dataList []models.DataInfo
+	err := c.withSession(ctx, func() error {
+		var err error
+		dataList, err = c.http.GetList(ctx, models.DataTypeUnknown)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return secretref.NewResolver(dataList), nil
+}
+
+// run - запустить команду с переменными окружения, в которых ссылки gk:// заменены
+// значениями полей записей. Флаги разбираются только до имени команды.
+func (c *CLI) run(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("run")
+	envFiles := stringList{}
+	flags.Var(&envFiles, "env-file", i18n.T("cli.flag.env_file"))
+	noMasking := flags.Bool("no-masking", false, i18n.T("cli.flag.no_masking"))
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return errUsage
+	}
+	command := flags.Args()
+	if len(command) == 0 {
+		flags.Usage()
+		return errUsage
+	}
+
+	environ := os.Environ()
+	for _, path := range envFiles {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		variables, err := secretref.ReadEnvFile(file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		environ = append(environ, variables...)
+	}
+
+	resolver, err := c.newResolver(ctx)
+	if err != nil {
+		return err
+	}
+	environ, secrets, err := resolver.ResolveEnv(environ)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Env = environ
+	cmd.Stdin = c.stdin
+	cmd.Stdout, cmd.Stderr = c.stdout, c.stderr
+	if !*noMasking {
+		stdout, stderr := secretref.NewMaskWriter(c.stdout, secrets), secretref.NewMaskWriter(c.stderr, secrets)
+		cmd.Stdout, cmd.Stderr = stdout, stderr
+		defer func() {
+			_ = stdout.Flush()
+			_ = stderr.Flush()
+		}()
+	}
+
+	err = cmd.Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return &childExitError{code: exitErr.ExitCode()}
+	}
+
+	return err
+}
+
+// inject - подставить значения полей записей вместо ссылок {{ gk://... }} в шаблоне.
+// Результат выводится в stdout или сохраняется в файл с доступом только для владельца.
+func (c *CLI) inject(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("inject")
+	in := flags.String("in", "-", i18n.T("cli.flag.in"))
+	out := flags.String("out", "-", i18n.T("cli.flag.out"))
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		flags.Usage()
+		return errUsage
+	}
+
+	var template []byte
+	if *in == "-" {
+		template, err = io.ReadAll(c.stdin)
+	} else {
+		template, err = os.ReadFile(*in)
+	}
+	if err != nil {
+		return err
+	}
+
+	resolver, err := c.newResolver(ctx)
+	if err != nil {
+		return err
+	}
+	text, _, err := resolver.Expand(string(template))
+	if err != nil {
+		return err
+	}
+
+	return c.writeSecretFile(*out, func(w io.Writer) error {
+		_, err := io.WriteString(w, text)
+		return err
+	})
+}
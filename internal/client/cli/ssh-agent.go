@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/sshagent"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// errNoSSHKeys - в хранилище нет ключей, которые можно добавить в агент
+var errNoSSHKeys = i18n.NewError("cli.ssh.no_keys")
+
+// sshAgent - запустить SSH агента с ключами хранилища и обслуживать его до прерывания
+func (c *CLI) sshAgent(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("ssh-agent")
+	socketPath := flags.String("socket", c.config.SSHAgent.Socket, i18n.T("cli.flag.socket"))
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+
+	ids := c.config.SSHAgent.Keys
+	if len(positional) > 0 {
+		ids = make([]uint, 0, len(positional))
+		for _, arg := range positional {
+			id, err := parseID(arg)
+			if err != nil {
+				return err
+			}
+			ids = append(ids, id)
+		}
+	}
+	if *socketPath == "" {
+		*socketPath = sshagent.DefaultSocketPath()
+	}
+
+	agent := sshagent.New(nil)
+	defer agent.Clear()
+
+	err = c.withSession(ctx, func() error {
+		dataList, err := c.http.GetList(ctx, models.DataTypeSSHKey)
+		if err != nil {
+			return err
+		}
+
+		for _, skipped := range agent.SetKeys(dataList, ids) {
+			fmt.Fprintln(c.stderr, i18n.T("cli.ssh.skipped", skipped.ID, skipped.Reason))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(agent.Keys()) == 0 {
+		return errNoSSHKeys
+	}
+
+	listener, err := sshagent.Listen(*socketPath)
+	if err != nil {
+		return err
+	}
+	defer listener.Close()
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	served := make(chan error, 1)
+	go func() {
+		served <- agent.Serve(listener)
+	}()
+
+	fmt.Fprintf(c.stdout, "%s=%s; export %s;\n", sshagent.SocketEnv, *socketPath, sshagent.SocketEnv)
+	fmt.Fprintln(c.stderr, i18n.T("cli.ssh.started", len(agent.Keys())))
+
+	select {
+	case <-ctx.Done():
+		return listener.Close()
+	case err = <-served:
+		return err
+	}
+}
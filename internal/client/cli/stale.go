@@ -0,0 +1,69 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/audit"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// staleDefaultDays - срок смены пароля по умолчанию
+const staleDefaultDays = 90
+
+// stale - вывести учетные данные, пароль которых не менялся days дней.
+// С флагом -login выполняется вход, иначе используется сохраненная сессия.
+func (c *CLI) stale(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("stale")
+	login := flags.String("login", "", i18n.T("cli.flag.login"))
+	days := flags.Int("days", staleDefaultDays, i18n.T("cli.flag.days"))
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if *days < 0 || len(positional) > 0 {
+		flags.Usage()
+		return errUsage
+	}
+
+	if *login != "" {
+		if err = c.signIn(ctx, *login); err != nil {
+			return err
+		}
+		if err = c.saveSession(*login); err != nil {
+			return err
+		}
+	}
+
+	var dataList []models.DataInfo
+	err = c.withSession(ctx, func() error {
+		dataList, err = c.http.GetList(ctx, models.DataTypeCredentials)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	summaries := make([]dataSummary, 0, len(dataList))
+	rows := make([][]string, 0, len(dataList))
+	for _, data := range audit.Stale(dataList, *days, now) {
+		value := models.CredentialsValue{}
+		if err = models.DecodeValue(data.Value, &value); err != nil {
+			return err
+		}
+
+		summaries = append(summaries, newDataSummary(data))
+		rows = append(rows, []string{
+			strconv.FormatUint(uint64(data.ID), 10),
+			data.Description,
+			value.Login,
+			data.PasswordChanged().Local().Format(time.DateOnly),
+			strconv.Itoa(int(now.Sub(data.PasswordChanged()).Hours() / 24)),
+		})
+	}
+
+	return c.print(summaries, []string{"ID", i18n.T("column.description"), i18n.T("column.login"), i18n.T("column.password_changed"), i18n.T("column.days")}, rows)
+}
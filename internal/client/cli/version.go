@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/version"
+)
+
+// versions - версии клиента и сервера
+type versions struct {
+	Client version.Info  `json:"client"`
+	Server *version.Info `json:"server,omitempty"`
+}
+
+// version - вывести версию клиента и сервера. Недоступность сервера не считается ошибкой.
+func (c *CLI) version(ctx context.Context, args []string) error {
+	flags := c.newFlagSet("version")
+	positional, err := c.parseFlags(flags, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) > 0 {
+		flags.Usage()
+		return errUsage
+	}
+
+	res := versions{Client: version.Get()}
+	rows := [][]string{versionRow(i18n.T("cli.version.client"), res.Client)}
+
+	res.Server, err = c.http.GetVersion(ctx)
+	switch {
+	case err != nil:
+		fmt.Fprintln(c.stderr, err)
+	case !res.Server.Compatible():
+		fmt.Fprintln(c.stderr, i18n.T("cli.version.incompatible", res.Server.APIVersion, version.APIVersion))
+	}
+	if res.Server != nil {
+		rows = append(rows, versionRow(i18n.T("cli.version.server"), *res.Server))
+	}
+
+	return c.print(res, []string{"", i18n.T("column.version"), i18n.T("column.commit"), i18n.T("column.built"), "API"}, rows)
+}
+
+// versionRow - строка таблицы версий
+func versionRow(name string, info version.Info) []string {
+	return []string{name, info.Version, info.Commit, info.BuildTime, strconv.Itoa(info.APIVersion)}
+}
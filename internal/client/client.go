@@ -2,15 +2,22 @@ package client
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
 	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
 
 	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
 	"github.com/go-playground/validator/v10"
 
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/audit"
 	"github.com/ShukinDmitriy/GophKeeper/internal/client/config"
 	"github.com/ShukinDmitriy/GophKeeper/internal/client/event"
 	"github.com/ShukinDmitriy/GophKeeper/internal/client/http"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/session"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/sshagent"
 	"github.com/ShukinDmitriy/GophKeeper/internal/client/tui"
 	"github.com/ShukinDmitriy/GophKeeper/internal/logger"
 )
@@ -21,7 +28,11 @@ type Client struct {
 	config     *config.Config
 	eventBus   *event.Observable
 	http       http.ClientInterface
+	newStore   session.StoreFactory
+	store      session.StoreInterface
 	tuiService *tui.TUIService
+	sshAgent   *sshagent.Agent
+	login      string
 }
 
 // NewClient - создаёт клиента с заданным конфигом
@@ -30,6 +41,7 @@ func NewClient(
 	config *config.Config,
 	eventBus *event.Observable,
 	http http.ClientInterface,
+	newStore session.StoreFactory,
 	tuiService *tui.TUIService,
 ) *Client {
 	c := &Client{
@@ -37,12 +49,18 @@ func NewClient(
 		config:     config,
 		eventBus:   eventBus,
 		http:       http,
+		newStore:   newStore,
 		tuiService: tuiService,
 	}
 
 	return c
 }
 
+// SetSSHAgent - загружать SSH ключи хранилища в агента после входа
+func (c *Client) SetSSHAgent(sshAgent *sshagent.Agent) {
+	c.sshAgent = sshAgent
+}
+
 // Run - Запускает клиента
 func (c *Client) Run(ctx context.Context) error {
 	c.eventBus.Subscribe(func(e *event.Event) {
@@ -54,14 +72,14 @@ func (c *Client) Run(ctx context.Context) error {
 		case event.ClientEventPressLoginButton:
 			loginFormData, ok := e.Data.(commonRequests.UserLogin)
 			if !ok {
-				c.appLog.Error("Не удалось получить данные формы")
+				c.appLog.Error("error type form data")
 			}
 
 			validate := validator.New(validator.WithRequiredStructEnabled())
 			err := validate.Struct(loginFormData)
 			if err != nil {
 				c.appLog.Error("error login %v", err)
-				c.tuiService.LoginError("Необходимо ввести корректные логин и пароль")
+				c.tuiService.LoginError(i18n.T("auth.invalid_form"))
 				return
 			}
 
@@ -69,20 +87,25 @@ func (c *Client) Run(ctx context.Context) error {
 			if err != nil {
 				c.appLog.Error("error login %v", err)
 				c.tuiService.LoginError(err.Error())
+				return
 			}
+			c.saveSession(loginFormData.Login)
 
 			c.tuiService.DataPage()
+			c.notifyExpiring(ctx)
+			c.refreshUsage(ctx)
+			c.loadSSHKeys(ctx)
 		case event.ClientEventPressRegisterButton:
 			registerFormData, ok := e.Data.(commonRequests.UserRegister)
 			if !ok {
-				c.appLog.Error("Не удалось получить данные формы")
+				c.appLog.Error("error type form data")
 			}
 
 			validate := validator.New(validator.WithRequiredStructEnabled())
 			err := validate.Struct(registerFormData)
 			if err != nil {
 				c.appLog.Error("error register %v", err)
-				c.tuiService.RegisterError("Необходимо ввести корректные логин и пароль")
+				c.tuiService.RegisterError(i18n.T("auth.invalid_form"))
 				return
 			}
 
@@ -90,9 +113,14 @@ func (c *Client) Run(ctx context.Context) error {
 			if err != nil {
 				c.appLog.Error("error register %v", err)
 				c.tuiService.RegisterError(err.Error())
+				return
 			}
+			c.saveSession(registerFormData.Login)
 
 			c.tuiService.DataPage()
+			c.notifyExpiring(ctx)
+			c.refreshUsage(ctx)
+			c.loadSSHKeys(ctx)
 		case event.ClientEventSelectDataType:
 			dataType, ok := e.Data.(models.DataType)
 			if !ok {
@@ -100,14 +128,7 @@ func (c *Client) Run(ctx context.Context) error {
 				return
 			}
 
-			dataList, err := c.http.GetList(ctx, dataType)
-			if err != nil {
-				c.appLog.Error("error get data %v", err)
-				c.tuiService.DataError(err.Error())
-				return
-			}
-
-			c.tuiService.DrawDataList(dataType, dataList)
+			c.drawDataList(ctx, dataType)
 		case event.ClientEventSelectDataRow:
 			data, ok := e.Data.(models.DataInfo)
 			if !ok {
@@ -119,21 +140,31 @@ func (c *Client) Run(ctx context.Context) error {
 		case event.ClientEventPressToCreateFormButton:
 			c.tuiService.DrawCreateForm()
 		case event.ClientEventSelectCreateDataType:
-			dataType, ok := e.Data.(string)
+			dataType, ok := e.Data.(models.DataType)
 			if !ok {
 				c.appLog.Error("error type data %t", e.Data)
 				return
 			}
 
 			switch dataType {
-			case "Учетные данные":
+			case models.DataTypeCredentials:
 				c.tuiService.DrawCreateCredentialsForm()
-			case "Текстовые данные":
+			case models.DataTypeText:
 				c.tuiService.DrawCreateTextForm()
-			case "Бинарные данные":
+			case models.DataTypeBinary:
 				c.tuiService.DrawCreateBinaryForm()
-			case "Данные банковских карт":
+			case models.DataTypeBankCard:
 				c.tuiService.DrawCreateBankForm()
+			case models.DataTypeSSHKey:
+				c.tuiService.DrawCreateSSHKeyForm()
+			case models.DataTypeIdentity:
+				c.tuiService.DrawCreateIdentityForm()
+			case models.DataTypeNote:
+				c.tuiService.DrawCreateNoteForm()
+			case models.DataTypeWiFi:
+				c.tuiService.DrawCreateWiFiForm()
+			case models.DataTypeAPIKey:
+				c.tuiService.DrawCreateAPIKeyForm()
 			}
 		case event.ClientEventCreateData:
 			data, ok := e.Data.(commonRequests.DataModel)
@@ -145,8 +176,10 @@ func (c *Client) Run(ctx context.Context) error {
 			dataInfo, err := c.http.CreateData(ctx, data)
 			if err != nil {
 				c.appLog.Error("error create data %v", err)
+				c.tuiService.DataError(err.Error())
 				return
 			}
+			c.refreshUsage(ctx)
 
 			c.eventBus.Next(&event.Event{
 				Name: event.ClientEventCreatedData,
@@ -167,8 +200,10 @@ func (c *Client) Run(ctx context.Context) error {
 			dataInfo, err := c.http.UpdateData(ctx, data)
 			if err != nil {
 				c.appLog.Error("error update data %v", err)
+				c.tuiService.DataError(err.Error())
 				return
 			}
+			c.refreshUsage(ctx)
 
 			c.eventBus.Next(&event.Event{
 				Name: event.ClientEventUpdatedData,
@@ -189,8 +224,10 @@ func (c *Client) Run(ctx context.Context) error {
 			err := c.http.DeleteData(ctx, data.ID)
 			if err != nil {
 				c.appLog.Error("error delete data %v", err)
+				c.tuiService.DataError(err.Error())
 				return
 			}
+			c.refreshUsage(ctx)
 
 			c.eventBus.Next(&event.Event{
 				Name: event.ClientEventDeletedData,
@@ -201,11 +238,38 @@ func (c *Client) Run(ctx context.Context) error {
 				Name: event.ClientEventSelectDataType,
 				Data: data.Type,
 			})
+		case event.ClientEventDeleteDataBatch:
+			dataList, ok := e.Data.([]models.DataInfo)
+			if !ok || len(dataList) == 0 {
+				c.appLog.Error("error type data %t", e.Data)
+				return
+			}
+
+			c.deleteDataBatch(ctx, dataList)
+		case event.ClientEventCreatedData, event.ClientEventUpdatedData, event.ClientEventDeletedData:
+			data, ok := e.Data.(models.DataInfo)
+			if ok && data.Type == models.DataTypeSSHKey {
+				c.loadSSHKeys(ctx)
+			}
+		case event.ClientEventPressAuditButton:
+			c.runAudit(ctx)
+		case event.ClientEventLock:
+			c.lock()
+		case event.ClientEventOpenSearch:
+			c.openSearch(ctx)
+		case event.ClientEventSelectProfile:
+			profile, ok := e.Data.(string)
+			if !ok {
+				c.appLog.Error("error type data %t", e.Data)
+				return
+			}
+
+			c.switchProfile(ctx, profile)
 		}
 	})
 
-	c.tuiService.LoginPage()
-	// c.tuiService.DataPage()
+	c.tuiService.SetProfiles(c.config.ProfileNames(), c.config.Profile, c.config.Login)
+	c.start(ctx)
 
 	// Должен работать в основном потоке...
 	err := c.tuiService.Run()
@@ -216,8 +280,280 @@ func (c *Client) Run(ctx context.Context) error {
 	return nil
 }
 
+// drawDataList - загрузить и отрисовать записи типа dataType
+func (c *Client) drawDataList(ctx context.Context, dataType models.DataType) {
+	dataList, err := c.http.GetList(ctx, dataType)
+	if err != nil {
+		c.appLog.Error("error get data %v", err)
+		c.tuiService.DataError(err.Error())
+		return
+	}
+
+	c.tuiService.DrawDataList(dataType, dataList)
+}
+
+// deleteDataBatch - удалить несколько записей одним пакетом.
+// Вызывается из обработчика событий, поэтому сам события не публикует: очередь единственного
+// подписчика ограничена, и на большом пакете обработчик ждал бы сам себя.
+func (c *Client) deleteDataBatch(ctx context.Context, dataList []models.DataInfo) {
+	operations := make([]commonRequests.DataBatchOperation, 0, len(dataList))
+	for _, data := range dataList {
+		operations = append(operations, commonRequests.DataBatchOperation{
+			Op: models.DataBatchDelete,
+			ID: data.ID,
+		})
+	}
+
+	results, err := c.http.Batch(ctx, commonRequests.DataBatch{Operations: operations})
+	if err != nil {
+		c.appLog.Error("error delete data batch %v", err)
+		c.tuiService.DataError(err.Error())
+		return
+	}
+
+	failed := 0
+	sshKeysDeleted := false
+	for _, result := range results {
+		if result.Code != "" || result.Index < 0 || result.Index >= len(dataList) {
+			failed++
+			continue
+		}
+
+		if dataList[result.Index].Type == models.DataTypeSSHKey {
+			sshKeysDeleted = true
+		}
+	}
+
+	c.refreshUsage(ctx)
+	if sshKeysDeleted {
+		c.loadSSHKeys(ctx)
+	}
+	c.drawDataList(ctx, dataList[0].Type)
+
+	if failed > 0 {
+		c.tuiService.DataError(i18n.T("data.delete_failed", failed))
+	}
+}
+
+// notifyExpiring - показать пользователю количество истекающих записей
+func (c *Client) notifyExpiring(ctx context.Context) {
+	dataList, err := c.http.GetExpiring(ctx, records.ExpirySoonDays)
+	if err != nil {
+		c.appLog.Error("error get expiring data %v", err)
+		return
+	}
+
+	c.tuiService.ExpiringNotice(dataList)
+}
+
+// start - открыть сохраненную сессию текущего профиля или страницу авторизации
+func (c *Client) start(ctx context.Context) {
+	c.checkVersion(ctx)
+
+	if c.resumeSession(ctx) {
+		c.tuiService.DataPage()
+		c.notifyExpiring(ctx)
+		c.refreshUsage(ctx)
+		c.loadSSHKeys(ctx)
+		return
+	}
+
+	c.tuiService.LoginPage()
+}
+
+// switchProfile - переключиться на другой профиль со своим сервером и сессией
+func (c *Client) switchProfile(ctx context.Context, profile string) {
+	err := c.config.UseProfile(profile)
+	if err == nil {
+		err = c.http.Reset()
+	}
+	if err != nil {
+		c.appLog.Error("error switch profile %v", err)
+		c.tuiService.LoginError(err.Error())
+		return
+	}
+
+	c.store = nil
+	c.login = ""
+	if c.sshAgent != nil {
+		c.sshAgent.Clear()
+	}
+	c.tuiService.SetProfiles(c.config.ProfileNames(), c.config.Profile, c.config.Login)
+	c.start(ctx)
+}
+
+// sessionStore - хранилище сессии текущего профиля
+func (c *Client) sessionStore() session.StoreInterface {
+	if c.store == nil {
+		store, err := c.newStore(c.config.Profile)
+		if err != nil {
+			c.appLog.Error("error create session store %v", err)
+			return nil
+		}
+		c.store = store
+	}
+
+	return c.store
+}
+
+// resumeSession - восстановить сохраненную сессию без ввода пароля.
+// Истекший access токен обновляется, при неудаче сессия удаляется и нужен вход.
+func (c *Client) resumeSession(ctx context.Context) bool {
+	store := c.sessionStore()
+	if store == nil {
+		return false
+	}
+
+	s, err := store.Load()
+	if err != nil {
+		if !errors.Is(err, session.ErrNoSession) {
+			c.appLog.Error("error load session %v", err)
+		}
+		return false
+	}
+	if s.Server != c.config.ServerAddress {
+		return false
+	}
+
+	c.http.SetCookies(s.Cookies)
+	if s.AccessExpired(time.Now()) {
+		err = c.http.Refresh(ctx)
+		if err != nil {
+			c.appLog.Error("error refresh session %v", err)
+			if err = store.Delete(); err != nil {
+				c.appLog.Error("error delete session %v", err)
+			}
+			return false
+		}
+	}
+
+	c.saveSession(s.Login)
+
+	return true
+}
+
+// saveSession - сохранить cookie авторизации, чтобы при следующем запуске не входить заново
+func (c *Client) saveSession(login string) {
+	c.login = login
+	store := c.sessionStore()
+	if store == nil {
+		return
+	}
+
+	err := store.Save(&session.Session{
+		Server:  c.config.ServerAddress,
+		Login:   login,
+		Cookies: c.http.Cookies(),
+	})
+	if err != nil {
+		c.appLog.Error("error save session %v", err)
+	}
+}
+
+// checkVersion - предупредить, если версия API сервера несовместима с клиентом
+func (c *Client) checkVersion(ctx context.Context) {
+	info, err := c.http.GetVersion(ctx)
+	if err != nil {
+		c.appLog.Error("error get server version %v", err)
+		return
+	}
+	if !info.Compatible() {
+		c.appLog.Warn("incompatible server version %s", info)
+		c.tuiService.VersionWarning(*info)
+	}
+}
+
+// refreshUsage - обновить информацию об использовании хранилища
+func (c *Client) refreshUsage(ctx context.Context) {
+	usage, err := c.http.GetUsage(ctx)
+	if err != nil {
+		c.appLog.Error("error get usage %v", err)
+		return
+	}
+
+	c.tuiService.DrawUsage(*usage)
+}
+
+// runAudit - проверить учетные данные и показать отчет
+func (c *Client) runAudit(ctx context.Context) {
+	dataList, err := c.http.GetList(ctx, models.DataTypeCredentials)
+	if err != nil {
+		c.appLog.Error("error get data %v", err)
+		c.tuiService.DataError(err.Error())
+		return
+	}
+
+	report, err := audit.Run(dataList, time.Now(), audit.DefaultOptions())
+	if err != nil {
+		c.appLog.Error("error audit %v", err)
+		c.tuiService.DataError(err.Error())
+		return
+	}
+
+	c.tuiService.DrawAudit(report)
+}
+
+// openSearch - загрузить записи всех типов и открыть поиск
+func (c *Client) openSearch(ctx context.Context) {
+	dataList, err := c.http.GetList(ctx, models.DataTypeUnknown)
+	if err != nil {
+		c.appLog.Error("error get data %v", err)
+		c.tuiService.DataError(err.Error())
+		return
+	}
+
+	c.tuiService.SearchPage(dataList)
+}
+
+// lock - заблокировать хранилище: забыть сессию и ключи агента, для продолжения нужен вход.
+// Сохраненная сессия профиля тоже удаляется, иначе перезапуск клиента обошел бы блокировку.
+func (c *Client) lock() {
+	if c.sshAgent != nil {
+		c.sshAgent.Clear()
+	}
+
+	if store := c.sessionStore(); store != nil {
+		if err := store.Delete(); err != nil {
+			c.appLog.Error("error delete session %v", err)
+		}
+	}
+	if err := c.http.Reset(); err != nil {
+		c.appLog.Error("error reset http client %v", err)
+	}
+
+	login := c.login
+	c.login = ""
+	c.tuiService.Lock(login)
+}
+
+// loadSSHKeys - загрузить выбранные SSH ключи хранилища в агента
+func (c *Client) loadSSHKeys(ctx context.Context) {
+	if c.sshAgent == nil {
+		return
+	}
+
+	dataList, err := c.http.GetList(ctx, models.DataTypeSSHKey)
+	if err != nil {
+		c.appLog.Error("error get ssh keys %v", err)
+		return
+	}
+
+	for _, skipped := range c.sshAgent.SetKeys(dataList, c.config.SSHAgent.Keys) {
+		c.appLog.Warn("ssh key %d is not loaded into agent: %v", skipped.ID, skipped.Reason)
+	}
+}
+
 // Shutdown - остановить приложение
 func (c *Client) Shutdown(_ context.Context) error {
 	c.tuiService.Stop()
+	if c.sshAgent != nil {
+		c.sshAgent.Clear()
+	}
+
+	// Сервер мог обновить токены за время работы
+	if c.login != "" {
+		c.saveSession(c.login)
+	}
+
 	return nil
 }
@@ -1,8 +1,13 @@
 package client_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	nethttp "net/http"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -13,12 +18,17 @@ import (
 	"github.com/ShukinDmitriy/GophKeeper/internal/client/event"
 	"github.com/ShukinDmitriy/GophKeeper/internal/client/http"
 	"github.com/ShukinDmitriy/GophKeeper/internal/client/router"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/session"
 	"github.com/ShukinDmitriy/GophKeeper/internal/client/tui"
 	"github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/version"
 	appLogger "github.com/ShukinDmitriy/GophKeeper/internal/logger"
 	"github.com/ShukinDmitriy/GophKeeper/internal/test-helpers"
+	httpMocks "github.com/ShukinDmitriy/GophKeeper/mocks/internal_/client/http"
 	"github.com/gdamore/tcell/v2"
+	"github.com/labstack/gommon/log"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func createConfig(t *testing.T) *config.Config {
@@ -35,9 +45,9 @@ func createLogger(conf *config.Config) appLogger.Logger {
 }
 
 func createHttpClient(t *testing.T, conf *config.Config, appLog appLogger.Logger) *http.Client {
-	client := http.NewClient(conf, appLog)
-	if client == nil {
-		t.Fatal("failed to create http client")
+	client, err := http.NewClient(conf, appLog)
+	if err != nil {
+		t.Fatal(err)
 	}
 
 	return client
@@ -74,11 +84,16 @@ func createClient(
 	httpClient *http.Client,
 	tuiService *tui.TUIService,
 ) (context.Context, *client.Client) {
+	// Сессия теста не должна попасть в каталог конфигурации пользователя
+	sessionStore := session.NewFileStore(filepath.Join(t.TempDir(), "session"), bytes.Repeat([]byte{1}, 32))
 	tClient := client.NewClient(
 		appLog,
 		conf,
 		eventBus,
 		httpClient,
+		func(string) (session.StoreInterface, error) {
+			return sessionStore, nil
+		},
 		tuiService,
 	)
 	if tClient == nil {
@@ -443,7 +458,7 @@ func clickCreateButton(
 	_:
 		eventBus.Next(&event.Event{
 			Name: event.ClientEventSelectCreateDataType,
-			Data: "Учетные данные",
+			Data: models.DataTypeCredentials,
 		})
 
 	GetDataPage:
@@ -467,7 +482,7 @@ func clickCreateButton(
 	_:
 		eventBus.Next(&event.Event{
 			Name: event.ClientEventSelectCreateDataType,
-			Data: "Текстовые данные",
+			Data: models.DataTypeText,
 		})
 
 	GetDataPage:
@@ -491,7 +506,7 @@ func clickCreateButton(
 	_:
 		eventBus.Next(&event.Event{
 			Name: event.ClientEventSelectCreateDataType,
-			Data: "Бинарные данные",
+			Data: models.DataTypeBinary,
 		})
 
 	GetDataPage:
@@ -515,7 +530,7 @@ func clickCreateButton(
 	_:
 		eventBus.Next(&event.Event{
 			Name: event.ClientEventSelectCreateDataType,
-			Data: "Данные банковских карт",
+			Data: models.DataTypeBankCard,
 		})
 
 	GetDataPage:
@@ -669,6 +684,66 @@ func crudData(
 		}
 	})
 
+	t.Run("test delete data batch", func(t *testing.T) {
+		timeout := time.After(30 * time.Second)
+
+		dataInfo = models.DataInfo{}
+		eventBus.Next(&event.Event{
+			Name: event.ClientEventCreateData,
+			Data: requests.DataModel{
+				Type:        models.DataTypeCredentials,
+				Description: "test batch",
+				Value:       "ewogICJMb2dpbiI6ICJ0ZXN0IiwKICAiUGFzc3dvcmQiOiAidGVzdCIKfQ==",
+			},
+		})
+
+	AwaitBatchDataCreated:
+		for {
+			select {
+			case <-timeout:
+				t.Fatal("timed out waiting")
+			default:
+				if dataInfo.ID != 0 {
+					break AwaitBatchDataCreated
+				}
+				time.Sleep(1 * time.Second)
+			}
+		}
+
+		var deletedID uint
+		// Подписка, чтобы отследить успешное выполнение
+		deleteSubscription := eventBus.Subscribe(func(e *event.Event) {
+			if e.Name != event.ClientEventDeletedData {
+				return
+			}
+
+			if eventDataInfo, ok := e.Data.(models.DataInfo); ok {
+				deletedID = eventDataInfo.ID
+			}
+		})
+
+		eventBus.Next(&event.Event{
+			Name: event.ClientEventDeleteDataBatch,
+			Data: []models.DataInfo{dataInfo},
+		})
+
+	AwaitBatchDataDeleted:
+		for {
+			select {
+			case <-timeout:
+				t.Fatal("timed out waiting")
+			default:
+				if deletedID != 0 {
+					deleteSubscription.Unsubscribe()
+					break AwaitBatchDataDeleted
+				}
+				time.Sleep(1 * time.Second)
+			}
+		}
+
+		assert.Equal(t, dataInfo.ID, deletedID)
+	})
+
 	subscription.Unsubscribe()
 }
 
@@ -727,3 +802,129 @@ func TestClient(t *testing.T) {
 	// Отключаем сервер
 	test_helpers.StopServer(t, httpServer)
 }
+
+func TestDeleteDataBatch(t *testing.T) {
+	conf := &config.Config{ServerAddress: config.DefaultServerAddress, LogLevel: log.OFF}
+	appLog := createLogger(conf)
+	eventBus := createEventBus()
+	tuiService := createTUIService(t, appLog, eventBus, createScreen())
+
+	// Пакет больше очереди подписчика: обработчик не должен публиковать события на каждую запись
+	dataList := make([]models.DataInfo, 25)
+	results := make([]models.DataBatchResult, 0, len(dataList))
+	for i := range dataList {
+		dataList[i] = models.DataInfo{ID: uint(i + 1), Type: models.DataTypeText}
+		results = append(results, models.DataBatchResult{Index: i, Op: models.DataBatchDelete, Status: nethttp.StatusOK})
+	}
+
+	var batched atomic.Bool
+	var redrawOnce sync.Once
+	redrawn := make(chan struct{})
+	httpClient := httpMocks.NewClientInterface(t)
+	httpClient.EXPECT().GetVersion(mock.Anything).Return(&version.Info{APIVersion: version.APIVersion}, nil)
+	httpClient.EXPECT().Batch(mock.Anything, mock.Anything).Return(results, nil).Run(func(context.Context, requests.DataBatch) {
+		batched.Store(true)
+	})
+	httpClient.EXPECT().GetUsage(mock.Anything).Return(&models.UserUsage{}, nil)
+	// Страница данных при открытии тоже запрашивает список, ждём перерисовку после удаления
+	httpClient.EXPECT().GetList(mock.Anything, mock.Anything).Return([]models.DataInfo{}, nil).Run(func(_ context.Context, dataType models.DataType) {
+		if batched.Load() && dataType == models.DataTypeText {
+			redrawOnce.Do(func() { close(redrawn) })
+		}
+	})
+
+	sessionStore := session.NewFileStore(filepath.Join(t.TempDir(), "session"), bytes.Repeat([]byte{1}, 32))
+	tClient := client.NewClient(appLog, conf, eventBus, httpClient, func(string) (session.StoreInterface, error) {
+		return sessionStore, nil
+	}, tuiService)
+
+	ctx := context.Background()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, tClient.Run(ctx))
+	}()
+	// Обработчик событий подписывается в начале Run
+	assert.Eventually(t, func() bool {
+		return tuiService.GetCurrentPage() == router.LoginPage
+	}, 2*time.Second, 10*time.Millisecond)
+	tuiService.DataPage()
+
+	eventBus.Next(&event.Event{
+		Name: event.ClientEventDeleteDataBatch,
+		Data: dataList,
+	})
+
+	select {
+	case <-redrawn:
+	case <-time.After(2 * time.Second):
+		t.Fatal("список не перерисован после удаления пакета")
+	}
+
+	assert.NoError(t, tClient.Shutdown(ctx))
+	<-done
+}
+
+func TestDataErrorShown(t *testing.T) {
+	tests := []struct {
+		name  string
+		event *event.Event
+		err   *http.APIError
+	}{
+		{
+			name:  "create validation",
+			event: &event.Event{Name: event.ClientEventCreateData, Data: requests.DataModel{Type: models.DataTypeText}},
+			err:   &http.APIError{Status: nethttp.StatusUnprocessableEntity, Code: models.ErrorCodeValidation, Fields: map[string]string{"value": "required"}},
+		},
+		{
+			name:  "update not found",
+			event: &event.Event{Name: event.ClientEventUpdateData, Data: models.DataInfo{ID: 1, Type: models.DataTypeText}},
+			err:   &http.APIError{Status: nethttp.StatusNotFound, Code: models.ErrorCodeNotFound},
+		},
+		{
+			name:  "delete forbidden",
+			event: &event.Event{Name: event.ClientEventDeleteData, Data: models.DataInfo{ID: 1, Type: models.DataTypeText}},
+			err:   &http.APIError{Status: nethttp.StatusForbidden, Code: models.ErrorCodeForbidden},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conf := &config.Config{ServerAddress: config.DefaultServerAddress, LogLevel: log.OFF}
+			appLog := createLogger(conf)
+			eventBus := createEventBus()
+			tuiService := createTUIService(t, appLog, eventBus, createScreen())
+
+			httpClient := httpMocks.NewClientInterface(t)
+			httpClient.EXPECT().GetVersion(mock.Anything).Return(&version.Info{APIVersion: version.APIVersion}, nil)
+			httpClient.EXPECT().GetList(mock.Anything, mock.Anything).Return([]models.DataInfo{}, nil).Maybe()
+			httpClient.EXPECT().CreateData(mock.Anything, mock.Anything).Return(nil, tt.err).Maybe()
+			httpClient.EXPECT().UpdateData(mock.Anything, mock.Anything).Return(nil, tt.err).Maybe()
+			httpClient.EXPECT().DeleteData(mock.Anything, mock.Anything).Return(tt.err).Maybe()
+
+			sessionStore := session.NewFileStore(filepath.Join(t.TempDir(), "session"), bytes.Repeat([]byte{1}, 32))
+			tClient := client.NewClient(appLog, conf, eventBus, httpClient, func(string) (session.StoreInterface, error) {
+				return sessionStore, nil
+			}, tuiService)
+
+			ctx := context.Background()
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				assert.NoError(t, tClient.Run(ctx))
+			}()
+			assert.Eventually(t, func() bool {
+				return tuiService.GetCurrentPage() == router.LoginPage
+			}, 2*time.Second, 10*time.Millisecond)
+			tuiService.DataPage()
+
+			// Любая ошибка сервера показывается пользователю, а не только ошибки квоты
+			eventBus.Next(tt.event)
+			assert.Eventually(t, func() bool {
+				return tuiService.GetCurrentPage() == router.ErrorPage
+			}, 2*time.Second, 10*time.Millisecond)
+
+			assert.NoError(t, tClient.Shutdown(ctx))
+			<-done
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package clipboard
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// ClearCommand - скрытая команда клиента, которая очищает буфер обмена после выхода основной команды
+const ClearCommand = "clipboard-clear"
+
+// Sum - SHA-256 текста: по нему проверяется, что буфер не изменился, без хранения самого значения
+func Sum(text string) string {
+	sum := sha256.Sum256([]byte(text))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// ClearIfUnchanged - очистить буфер обмена, если в нем по-прежнему значение с суммой sum.
+// Возвращает true, если буфер был очищен.
+func ClearIfUnchanged(cb ClipboardInterface, sum string) (bool, error) {
+	text, err := cb.Read()
+	if err != nil {
+		return false, err
+	}
+	if Sum(text) != sum {
+		return false, nil
+	}
+
+	return true, cb.Clear()
+}
+
+// Copy - записать текст в буфер обмена и очистить его через timeout в текущем процессе,
+// если пользователь не скопировал ничего другого. Нулевой timeout отключает очистку.
+func Copy(cb ClipboardInterface, text string, timeout time.Duration) (*time.Timer, error) {
+	err := cb.Write(text)
+	if err != nil || timeout <= 0 {
+		return nil, err
+	}
+
+	sum := Sum(text)
+
+	return time.AfterFunc(timeout, func() {
+		_, _ = ClearIfUnchanged(cb, sum)
+	}), nil
+}
+
+// ClearLater - очистить буфер через timeout в отдельном процессе, который переживет текущий.
+// Процессу передается только сумма значения через stdin.
+func (c *Clipboard) ClearLater(text string, timeout time.Duration) error {
+	if c.tool == nil {
+		return ErrUnavailable
+	}
+	if timeout <= 0 {
+		return nil
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("executable: %w", err)
+	}
+
+	cmd := exec.Command(executable, ClearCommand, "-after", timeout.String())
+	detach(cmd)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+	if err = cmd.Start(); err != nil {
+		return fmt.Errorf("start %s: %w", ClearCommand, err)
+	}
+
+	_, err = io.WriteString(stdin, Sum(text)+"\n")
+	if closeErr := stdin.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return cmd.Process.Release()
+}
+
+// WaitAndClear - дождаться timeout и очистить буфер, если в нем значение с суммой из stdin
+func WaitAndClear(cb ClipboardInterface, stdin io.Reader, timeout time.Duration) error {
+	sum, err := bufio.NewReader(stdin).ReadString('\n')
+	if err != nil && sum == "" {
+		return fmt.Errorf("read clipboard sum: %w", err)
+	}
+
+	time.Sleep(timeout)
+
+	_, err = ClearIfUnchanged(cb, strings.TrimSpace(sum))
+
+	return err
+}
@@ -0,0 +1,148 @@
+// Package clipboard содержит работу с системным буфером обмена через утилиты ОС
+package clipboard
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+)
+
+// DisableEnv - переменная окружения, значение none отключает буфер обмена (например, в тестах)
+const DisableEnv = "GOPHKEEPER_CLIPBOARD"
+
+// ErrUnavailable - в системе нет утилит для работы с буфером обмена
+var ErrUnavailable = i18n.NewError("clipboard.unavailable")
+
+// tool - команды утилиты буфера обмена
+type tool struct {
+	write []string
+	read  []string
+	clear []string
+}
+
+// tools - утилиты по окружению в порядке предпочтения
+var tools = []struct {
+	env  string
+	goos string
+	tool tool
+}{
+	{goos: "darwin", tool: tool{write: []string{"pbcopy"}, read: []string{"pbpaste"}}},
+	{goos: "windows", tool: tool{
+		write: []string{"clip"},
+		read:  []string{"powershell", "-NoProfile", "-Command", "Get-Clipboard -Raw"},
+	}},
+	{env: "WAYLAND_DISPLAY", tool: tool{
+		write: []string{"wl-copy"},
+		read:  []string{"wl-paste", "--no-newline"},
+		clear: []string{"wl-copy", "--clear"},
+	}},
+	{env: "DISPLAY", tool: tool{
+		write: []string{"xclip", "-selection", "clipboard", "-in"},
+		read:  []string{"xclip", "-selection", "clipboard", "-out"},
+	}},
+	{env: "DISPLAY", tool: tool{
+		write: []string{"xsel", "--clipboard", "--input"},
+		read:  []string{"xsel", "--clipboard", "--output"},
+		clear: []string{"xsel", "--clipboard", "--clear"},
+	}},
+}
+
+// Clipboard - системный буфер обмена
+type Clipboard struct {
+	tool *tool
+}
+
+// NewClipboard - найти утилиту буфера обмена для текущего окружения. Если ее нет,
+// буфер обмена считается недоступным и все операции возвращают ErrUnavailable.
+func NewClipboard() *Clipboard {
+	return &Clipboard{tool: detect()}
+}
+
+// detect - выбрать первую установленную утилиту, подходящую окружению
+func detect() *tool {
+	if strings.EqualFold(os.Getenv(DisableEnv), "none") {
+		return nil
+	}
+
+	for i := range tools {
+		if tools[i].goos != "" && tools[i].goos != runtime.GOOS {
+			continue
+		}
+		if tools[i].env != "" && (runtime.GOOS == "darwin" || runtime.GOOS == "windows" || os.Getenv(tools[i].env) == "") {
+			continue
+		}
+		if _, err := exec.LookPath(tools[i].tool.write[0]); err != nil {
+			continue
+		}
+		if _, err := exec.LookPath(tools[i].tool.read[0]); err != nil {
+			continue
+		}
+
+		return &tools[i].tool
+	}
+
+	return nil
+}
+
+// Available - можно ли работать с буфером обмена
+func (c *Clipboard) Available() bool {
+	return c.tool != nil
+}
+
+// Write - записать текст в буфер обмена
+func (c *Clipboard) Write(text string) error {
+	if c.tool == nil {
+		return ErrUnavailable
+	}
+
+	// Вывод не перехватывается: xclip остается в фоне, пока владеет буфером
+	cmd := exec.Command(c.tool.write[0], c.tool.write[1:]...)
+	cmd.Stdin = strings.NewReader(text)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s: %w", c.tool.write[0], err)
+	}
+
+	return nil
+}
+
+// Read - прочитать текст из буфера обмена
+func (c *Clipboard) Read() (string, error) {
+	if c.tool == nil {
+		return "", ErrUnavailable
+	}
+
+	var stdout bytes.Buffer
+	cmd := exec.Command(c.tool.read[0], c.tool.read[1:]...)
+	cmd.Stdout = &stdout
+	if err := cmd.Run(); err != nil {
+		// Пустой буфер wl-paste и xclip считают ошибкой
+		if stdout.Len() == 0 {
+			return "", nil
+		}
+		return "", fmt.Errorf("%s: %w", c.tool.read[0], err)
+	}
+
+	return stdout.String(), nil
+}
+
+// Clear - очистить буфер обмена
+func (c *Clipboard) Clear() error {
+	if c.tool == nil {
+		return ErrUnavailable
+	}
+	if c.tool.clear == nil {
+		return c.Write("")
+	}
+
+	cmd := exec.Command(c.tool.clear[0], c.tool.clear[1:]...)
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s: %w", c.tool.clear[0], err)
+	}
+
+	return nil
+}
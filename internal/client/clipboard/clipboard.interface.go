@@ -0,0 +1,10 @@
+package clipboard
+
+import "time"
+
+type ClipboardInterface interface {
+	Write(text string) error
+	Read() (string, error)
+	Clear() error
+	ClearLater(text string, timeout time.Duration) error
+}
@@ -0,0 +1,94 @@
+package clipboard
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClipboardTool - утилита буфера обмена, которая хранит значение в файле рядом со скриптом
+const fakeClipboardTool = `#!/bin/sh
+buffer="$(dirname "$0")/buffer"
+case "$1" in
+in) cat > "$buffer" ;;
+out) [ -f "$buffer" ] && cat "$buffer" ;;
+esac
+`
+
+func newFakeClipboard(t *testing.T) *Clipboard {
+	command := filepath.Join(t.TempDir(), "clipboard")
+	require.NoError(t, os.WriteFile(command, []byte(fakeClipboardTool), 0o700))
+
+	return &Clipboard{tool: &tool{
+		write: []string{command, "in"},
+		read:  []string{command, "out"},
+	}}
+}
+
+func TestClipboard(t *testing.T) {
+	cb := newFakeClipboard(t)
+
+	require.NoError(t, cb.Write("secret"))
+	text, err := cb.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "secret", text)
+
+	// Значение изменилось - буфер не трогаем
+	cleared, err := ClearIfUnchanged(cb, Sum("other"))
+	require.NoError(t, err)
+	assert.False(t, cleared)
+
+	cleared, err = ClearIfUnchanged(cb, Sum("secret"))
+	require.NoError(t, err)
+	assert.True(t, cleared)
+	text, err = cb.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "", text)
+}
+
+func TestCopy(t *testing.T) {
+	cb := newFakeClipboard(t)
+
+	timer, err := Copy(cb, "secret", 10*time.Millisecond)
+	require.NoError(t, err)
+	require.NotNil(t, timer)
+	assert.Eventually(t, func() bool {
+		text, _ := cb.Read()
+		return text == ""
+	}, time.Second, 10*time.Millisecond)
+
+	// Пользователь скопировал другое значение до истечения времени
+	_, err = Copy(cb, "secret", 10*time.Millisecond)
+	require.NoError(t, err)
+	require.NoError(t, cb.Write("mine"))
+	time.Sleep(50 * time.Millisecond)
+	text, err := cb.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "mine", text)
+}
+
+func TestWaitAndClear(t *testing.T) {
+	cb := newFakeClipboard(t)
+	require.NoError(t, cb.Write("secret"))
+
+	require.NoError(t, WaitAndClear(cb, strings.NewReader(Sum("secret")+"\n"), 0))
+	text, err := cb.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "", text)
+}
+
+func TestUnavailable(t *testing.T) {
+	t.Setenv(DisableEnv, "none")
+	cb := NewClipboard()
+
+	assert.False(t, cb.Available())
+	assert.ErrorIs(t, cb.Write("secret"), ErrUnavailable)
+	_, err := cb.Read()
+	assert.ErrorIs(t, err, ErrUnavailable)
+	assert.ErrorIs(t, cb.ClearLater("secret", time.Second), ErrUnavailable)
+}
@@ -0,0 +1,8 @@
+//go:build !unix
+
+package clipboard
+
+import "os/exec"
+
+// detach - на других ОС процесс и так не зависит от терминала
+func detach(_ *exec.Cmd) {}
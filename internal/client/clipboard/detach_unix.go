@@ -0,0 +1,13 @@
+//go:build unix
+
+package clipboard
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// detach - запустить процесс в новой сессии, чтобы он не завершился вместе с терминалом
+func detach(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+}
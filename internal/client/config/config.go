@@ -4,23 +4,76 @@ import (
 	"os"
 	"path"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/labstack/gommon/log"
 
 	"github.com/joho/godotenv"
 )
 
+// DefaultServerAddress - адрес сервера, если он нигде не указан
+const DefaultServerAddress = "http://localhost:8080"
+
+// DefaultClipboardTimeout - через сколько скопированное значение удаляется из буфера обмена
+const DefaultClipboardTimeout = 30 * time.Second
+
+// DefaultLockTimeout - через сколько бездействия консольный интерфейс блокируется
+const DefaultLockTimeout = 5 * time.Minute
+
+// DefaultRevealTimeout - через сколько показанный в форме секрет скрывается снова
+const DefaultRevealTimeout = 10 * time.Second
+
 type Config struct {
 	ServerAddress string  `env:"SERVER_ADDRESS"`
 	LogLevel      log.Lvl `env:"LOG_LEVEL"`
 	LogPath       string  `env:"LOG_PATH"`
 	EnableHTTPS   bool    `env:"ENABLE_HTTPS"`
+
+	// Profile - имя текущего профиля
+	Profile string
+	// Profiles - профили из файла конфигурации
+	Profiles map[string]Profile
+	// CACert - сертификат удостоверяющего центра сервера
+	CACert string
+	// ClientCert, ClientKey - сертификат и ключ клиента для mTLS
+	ClientCert string
+	ClientKey  string
+	// PinSHA256 - ожидаемый SHA-256 открытого ключа (SPKI) сервера в base64
+	PinSHA256 string
+	// Output - формат вывода команд по умолчанию
+	Output string
+	// Login - логин пользователя по умолчанию
+	Login string
+	// ClipboardTimeout - через сколько очищать буфер обмена, 0 - не очищать
+	ClipboardTimeout time.Duration
+	// LockTimeout - через сколько бездействия блокировать консольный интерфейс, 0 - не блокировать
+	LockTimeout time.Duration
+	// RevealTimeout - через сколько скрывать показанный в форме секрет, 0 - не скрывать
+	RevealTimeout time.Duration
+	// BreachFile - локальный список хешей паролей из утечек (файл HASH:COUNT или каталог по префиксам)
+	BreachFile string
+	// SSHAgent - настройки SSH агента консольного интерфейса
+	SSHAgent SSHAgent
+	// Locale - язык сообщений (ru, en), пусто - по LANG
+	Locale string
 }
 
-func NewConfig() (*Config, error) {
-	config := &Config{}
+// SSHAgent - настройки SSH агента с ключами из хранилища
+type SSHAgent struct {
+	// Enabled - запускать агента вместе с консольным интерфейсом
+	Enabled bool `json:"enabled"`
+	// Socket - путь к Unix сокету, по умолчанию sshagent.DefaultSocketPath
+	Socket string `json:"socket,omitempty"`
+	// Keys - идентификаторы записей с ключами, пусто - все SSH ключи
+	Keys []uint `json:"keys,omitempty"`
+	// Confirm - спрашивать подтверждение на каждую подпись
+	Confirm bool `json:"confirm,omitempty"`
+}
 
+// NewConfig - загрузить конфигурацию из client.env в каталоге проекта
+func NewConfig() (*Config, error) {
 	currentDir, _ := os.Getwd()
 	paths := strings.Split(currentDir, "/")
 	projectDirIndex := slices.Index(paths, "GophKeeper")
@@ -32,6 +85,18 @@ func NewConfig() (*Config, error) {
 		return nil, err
 	}
 
+	return newConfigFromEnv(), nil
+}
+
+// newConfigFromEnv - конфигурация из переменных окружения
+func newConfigFromEnv() *Config {
+	config := &Config{
+		ServerAddress:    DefaultServerAddress,
+		ClipboardTimeout: DefaultClipboardTimeout,
+		LockTimeout:      DefaultLockTimeout,
+		RevealTimeout:    DefaultRevealTimeout,
+	}
+
 	serverAddress, exists := os.LookupEnv("SERVER_ADDRESS")
 	if exists {
 		config.ServerAddress = serverAddress
@@ -53,18 +118,87 @@ func NewConfig() (*Config, error) {
 		config.EnableHTTPS = enableHTTPS == "1"
 	}
 
+	for env, value := range map[string]*string{
+		"CA_CERT":     &config.CACert,
+		"CLIENT_CERT": &config.ClientCert,
+		"CLIENT_KEY":  &config.ClientKey,
+		"PIN_SHA256":  &config.PinSHA256,
+		"BREACH_FILE": &config.BreachFile,
+	} {
+		if envValue, exists := os.LookupEnv(env); exists {
+			*value = envValue
+		}
+	}
+
+	clipboardTimeout, exists := os.LookupEnv("CLIPBOARD_TIMEOUT")
+	if exists {
+		if seconds, err := strconv.Atoi(clipboardTimeout); err == nil && seconds >= 0 {
+			config.ClipboardTimeout = time.Duration(seconds) * time.Second
+		}
+	}
+
+	lockTimeout, exists := os.LookupEnv("LOCK_TIMEOUT")
+	if exists {
+		if seconds, err := strconv.Atoi(lockTimeout); err == nil && seconds >= 0 {
+			config.LockTimeout = time.Duration(seconds) * time.Second
+		}
+	}
+
+	revealTimeout, exists := os.LookupEnv("REVEAL_TIMEOUT")
+	if exists {
+		if seconds, err := strconv.Atoi(revealTimeout); err == nil && seconds >= 0 {
+			config.RevealTimeout = time.Duration(seconds) * time.Second
+		}
+	}
+
+	locale, exists := os.LookupEnv("LOCALE")
+	if exists {
+		config.Locale = locale
+	}
+
+	sshAgent, exists := os.LookupEnv("SSH_AGENT")
+	if exists {
+		config.SSHAgent.Enabled = sshAgent == "1"
+	}
+
+	sshAgentSocket, exists := os.LookupEnv("SSH_AGENT_SOCKET")
+	if exists {
+		config.SSHAgent.Socket = sshAgentSocket
+	}
+
+	sshAgentConfirm, exists := os.LookupEnv("SSH_AGENT_CONFIRM")
+	if exists {
+		config.SSHAgent.Confirm = sshAgentConfirm == "1"
+	}
+
+	sshAgentKeys, exists := os.LookupEnv("SSH_AGENT_KEYS")
+	if exists {
+		for _, key := range strings.Split(sshAgentKeys, ",") {
+			if id, err := strconv.ParseUint(strings.TrimSpace(key), 10, 0); err == nil && id > 0 {
+				config.SSHAgent.Keys = append(config.SSHAgent.Keys, uint(id))
+			}
+		}
+	}
+
+	config.LogLevel = parseLogLevel(logLevel)
+
+	return config
+}
+
+// parseLogLevel - уровень логирования по названию
+func parseLogLevel(logLevel string) log.Lvl {
 	switch strings.ToUpper(logLevel) {
 	case "DEBUG":
-		config.LogLevel = log.DEBUG
+		return log.DEBUG
 	case "INFO":
-		config.LogLevel = log.INFO
+		return log.INFO
 	case "WARN":
-		config.LogLevel = log.WARN
+		return log.WARN
 	case "ERROR":
-		config.LogLevel = log.ERROR
+		return log.ERROR
 	case "OFF":
-		config.LogLevel = log.OFF
+		return log.OFF
 	}
 
-	return config, nil
+	return 0
 }
@@ -0,0 +1,223 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+)
+
+// Переменные окружения для выбора файла конфигурации и профиля
+const (
+	FileEnv    = "GOPHKEEPER_CONFIG"
+	ProfileEnv = "GOPHKEEPER_PROFILE"
+)
+
+var (
+	// ErrUnknownProfile - в файле конфигурации нет такого профиля
+	ErrUnknownProfile = i18n.NewError("config.unknown_profile")
+	// ErrNoProfiles - в файле конфигурации нет ни одного профиля
+	ErrNoProfiles = i18n.NewError("config.no_profiles")
+
+	// errProfileArg - после флага --profile нет имени профиля
+	errProfileArg = i18n.NewError("config.profile_arg")
+)
+
+// Profile - настройки подключения к одному серверу
+type Profile struct {
+	Server     string `json:"server"`
+	CACert     string `json:"ca_cert,omitempty"`
+	ClientCert string `json:"client_cert,omitempty"`
+	ClientKey  string `json:"client_key,omitempty"`
+	PinSHA256  string `json:"pin_sha256,omitempty"`
+	Output     string `json:"output,omitempty"`
+	Login      string `json:"login,omitempty"`
+}
+
+// File - файл конфигурации клиента
+type File struct {
+	DefaultProfile string             `json:"default_profile,omitempty"`
+	LogLevel       string             `json:"log_level,omitempty"`
+	LogPath        string             `json:"log_path,omitempty"`
+	Profiles       map[string]Profile `json:"profiles"`
+	// ClipboardTimeout - через сколько секунд очищать буфер обмена, 0 - не очищать
+	ClipboardTimeout *int `json:"clipboard_timeout,omitempty"`
+	// LockTimeout - через сколько секунд бездействия блокировать консольный интерфейс, 0 - не блокировать
+	LockTimeout *int `json:"lock_timeout,omitempty"`
+	// RevealTimeout - через сколько секунд скрывать показанный в форме секрет, 0 - не скрывать
+	RevealTimeout *int `json:"reveal_timeout,omitempty"`
+	// BreachFile - локальный список хешей паролей из утечек
+	BreachFile string `json:"breach_file,omitempty"`
+	// SSHAgent - SSH агент с ключами из хранилища
+	SSHAgent SSHAgent `json:"ssh_agent,omitempty"`
+	// Locale - язык сообщений (ru, en), пусто - по LANG
+	Locale string `json:"locale,omitempty"`
+}
+
+// FilePath - путь к файлу конфигурации: GOPHKEEPER_CONFIG или config.json в каталоге конфигурации пользователя
+func FilePath() (string, error) {
+	if path, ok := os.LookupEnv(FileEnv); ok && path != "" {
+		return path, nil
+	}
+
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("user config dir: %w", err)
+	}
+
+	return filepath.Join(configDir, "gophkeeper", "config.json"), nil
+}
+
+// ReadFile - прочитать файл конфигурации
+func ReadFile(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file := &File{}
+	err = json.Unmarshal(data, file)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if len(file.Profiles) == 0 {
+		return nil, fmt.Errorf("%s: %w", path, ErrNoProfiles)
+	}
+
+	return file, nil
+}
+
+// Load - загрузить конфигурацию с профилем profile. Пустое имя означает профиль из
+// GOPHKEEPER_PROFILE или профиль по умолчанию. Без файла конфигурации используется client.env.
+func Load(profile string) (*Config, error) {
+	if profile == "" {
+		profile = os.Getenv(ProfileEnv)
+	}
+
+	path, err := FilePath()
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		if profile != "" {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownProfile, i18n.T("config.no_file", profile, path))
+		}
+
+		config, err := NewConfig()
+		if errors.Is(err, fs.ErrNotExist) {
+			return newConfigFromEnv(), nil
+		}
+
+		return config, err
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return file.Config(profile)
+}
+
+// Config - конфигурация клиента с профилем profile
+func (f *File) Config(profile string) (*Config, error) {
+	config := &Config{
+		LogLevel:         parseLogLevel(f.LogLevel),
+		LogPath:          f.LogPath,
+		Profiles:         f.Profiles,
+		ClipboardTimeout: DefaultClipboardTimeout,
+		LockTimeout:      DefaultLockTimeout,
+		RevealTimeout:    DefaultRevealTimeout,
+		BreachFile:       f.BreachFile,
+		SSHAgent:         f.SSHAgent,
+		Locale:           f.Locale,
+	}
+	if f.ClipboardTimeout != nil && *f.ClipboardTimeout >= 0 {
+		config.ClipboardTimeout = time.Duration(*f.ClipboardTimeout) * time.Second
+	}
+	if f.LockTimeout != nil && *f.LockTimeout >= 0 {
+		config.LockTimeout = time.Duration(*f.LockTimeout) * time.Second
+	}
+	if f.RevealTimeout != nil && *f.RevealTimeout >= 0 {
+		config.RevealTimeout = time.Duration(*f.RevealTimeout) * time.Second
+	}
+
+	if profile == "" {
+		profile = f.DefaultProfile
+	}
+	if profile == "" {
+		profile = config.ProfileNames()[0]
+	}
+
+	err := config.UseProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+// ProfileNames - имена профилей по алфавиту
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	return names
+}
+
+// UseProfile - переключиться на профиль name
+func (c *Config) UseProfile(name string) error {
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownProfile, i18n.T("config.available", name, strings.Join(c.ProfileNames(), ", ")))
+	}
+
+	c.Profile = name
+	c.ServerAddress = strings.TrimRight(profile.Server, "/")
+	if c.ServerAddress == "" {
+		c.ServerAddress = DefaultServerAddress
+	}
+	c.CACert = profile.CACert
+	c.ClientCert = profile.ClientCert
+	c.ClientKey = profile.ClientKey
+	c.PinSHA256 = profile.PinSHA256
+	c.Output = profile.Output
+	c.Login = profile.Login
+
+	return nil
+}
+
+// ProfileArg - извлечь глобальный флаг --profile, указанный перед командой
+func ProfileArg(args []string) (string, []string, error) {
+	if len(args) == 0 || !strings.HasPrefix(args[0], "-") {
+		return "", args, nil
+	}
+
+	name, value, hasValue := strings.Cut(strings.TrimLeft(args[0], "-"), "=")
+	if name != "profile" {
+		return "", args, nil
+	}
+	if hasValue {
+		return value, args[1:], nil
+	}
+	if len(args) < 2 {
+		return "", nil, errProfileArg
+	}
+
+	return args[1], args[2:], nil
+}
+
+// VersionArg - указан ли глобальный флаг --version (после --profile, если он есть)
+func VersionArg(args []string) bool {
+	return len(args) > 0 && (args[0] == "--version" || args[0] == "-version")
+}
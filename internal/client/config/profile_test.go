@@ -0,0 +1,110 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/config"
+	"github.com/labstack/gommon/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testConfigFile = `{
+  "default_profile": "personal",
+  "log_level": "INFO",
+  "clipboard_timeout": 10,
+  "lock_timeout": 0,
+  "locale": "en",
+  "ssh_agent": {"enabled": true, "keys": [3, 5], "confirm": true},
+  "profiles": {
+    "personal": {"server": "http://localhost:8080/", "login": "alice"},
+    "work": {"server": "https://vault.example.com", "ca_cert": "/etc/ssl/work.crt", "output": "json"}
+  }
+}`
+
+func TestLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(path, []byte(testConfigFile), 0o600))
+	t.Setenv(config.FileEnv, path)
+	t.Setenv(config.ProfileEnv, "")
+
+	conf, err := config.Load("")
+	require.NoError(t, err)
+	assert.Equal(t, "personal", conf.Profile)
+	assert.Equal(t, "http://localhost:8080", conf.ServerAddress)
+	assert.Equal(t, "alice", conf.Login)
+	assert.Equal(t, log.INFO, conf.LogLevel)
+	assert.Equal(t, 10*time.Second, conf.ClipboardTimeout)
+	assert.Zero(t, conf.LockTimeout)
+	assert.Equal(t, config.DefaultRevealTimeout, conf.RevealTimeout)
+	assert.Equal(t, "en", conf.Locale)
+	assert.Equal(t, config.SSHAgent{Enabled: true, Keys: []uint{3, 5}, Confirm: true}, conf.SSHAgent)
+	assert.Equal(t, []string{"personal", "work"}, conf.ProfileNames())
+
+	t.Setenv(config.ProfileEnv, "work")
+	conf, err = config.Load("")
+	require.NoError(t, err)
+	assert.Equal(t, "work", conf.Profile)
+	assert.Equal(t, "/etc/ssl/work.crt", conf.CACert)
+	assert.Equal(t, "json", conf.Output)
+
+	require.NoError(t, conf.UseProfile("personal"))
+	assert.Equal(t, "http://localhost:8080", conf.ServerAddress)
+	assert.Empty(t, conf.CACert)
+
+	_, err = config.Load("unknown")
+	assert.ErrorIs(t, err, config.ErrUnknownProfile)
+}
+
+func TestLoadWithoutFile(t *testing.T) {
+	t.Setenv(config.FileEnv, filepath.Join(t.TempDir(), "config.json"))
+	t.Setenv(config.ProfileEnv, "")
+
+	_, err := config.Load("work")
+	assert.ErrorIs(t, err, config.ErrUnknownProfile)
+}
+
+func TestProfileArg(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        []string
+		wantProfile string
+		wantArgs    []string
+		wantErr     bool
+	}{
+		{name: "no flag", args: []string{"list"}, wantArgs: []string{"list"}},
+		{name: "flag with value", args: []string{"--profile", "work", "list"}, wantProfile: "work", wantArgs: []string{"list"}},
+		{name: "flag with equals", args: []string{"-profile=work"}, wantProfile: "work", wantArgs: []string{}},
+		{name: "other flag", args: []string{"--version"}, wantArgs: []string{"--version"}},
+		{name: "missing value", args: []string{"--profile"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile, args, err := config.ProfileArg(tt.args)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantProfile, profile)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestVersionArg(t *testing.T) {
+	assert.True(t, config.VersionArg([]string{"--version"}))
+	assert.True(t, config.VersionArg([]string{"-version"}))
+	assert.False(t, config.VersionArg([]string{"version"}))
+	assert.False(t, config.VersionArg(nil))
+
+	// Флаг после --profile тоже распознается
+	_, args, err := config.ProfileArg([]string{"--profile", "work", "--version"})
+	assert.NoError(t, err)
+	assert.True(t, config.VersionArg(args))
+}
@@ -15,4 +15,9 @@ const (
 	ClientEventUpdatedData             EventName = "updatedData"
 	ClientEventDeleteData              EventName = "deleteData"
 	ClientEventDeletedData             EventName = "deletedData"
+	ClientEventDeleteDataBatch         EventName = "deleteDataBatch"
+	ClientEventSelectProfile           EventName = "selectProfile"
+	ClientEventPressAuditButton        EventName = "pressAuditButton"
+	ClientEventLock                    EventName = "lock"
+	ClientEventOpenSearch              EventName = "openSearch"
 )
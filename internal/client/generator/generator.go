@@ -0,0 +1,248 @@
+// Package generator содержит генератор паролей и парольных фраз на основе crypto/rand
+package generator
+
+import (
+	"crypto/rand"
+	_ "embed"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"unicode"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+)
+
+// Наборы символов пароля
+const (
+	LowerChars  = "abcdefghijklmnopqrstuvwxyz"
+	UpperChars  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	DigitChars  = "0123456789"
+	SymbolChars = "!#$%&*+-=?@^_~.,:;()[]{}"
+	// AmbiguousChars - символы, которые легко спутать при чтении
+	AmbiguousChars = "Il1O0o|`'\""
+)
+
+// Ограничения параметров генерации
+const (
+	MinLength = 4
+	MaxLength = 256
+	MinWords  = 3
+	MaxWords  = 20
+)
+
+var (
+	// ErrNoCharClasses - не выбран ни один набор символов
+	ErrNoCharClasses = i18n.NewError("generator.no_char_classes")
+	// ErrLength - длина пароля вне допустимого диапазона
+	ErrLength = i18n.NewError("generator.length_range", MinLength, MaxLength)
+	// ErrWords - количество слов вне допустимого диапазона
+	ErrWords = i18n.NewError("generator.words_range", MinWords, MaxWords)
+)
+
+//go:embed wordlist.txt
+var wordlistData string
+
+// Wordlist - встроенный список слов для парольных фраз
+var Wordlist = strings.Fields(wordlistData)
+
+// PasswordOptions - параметры генерации пароля
+type PasswordOptions struct {
+	Length           int
+	Lower            bool
+	Upper            bool
+	Digits           bool
+	Symbols          bool
+	ExcludeAmbiguous bool
+}
+
+// DefaultPasswordOptions - параметры пароля по умолчанию
+func DefaultPasswordOptions() PasswordOptions {
+	return PasswordOptions{
+		Length:           20,
+		Lower:            true,
+		Upper:            true,
+		Digits:           true,
+		Symbols:          true,
+		ExcludeAmbiguous: true,
+	}
+}
+
+// PassphraseOptions - параметры генерации парольной фразы
+type PassphraseOptions struct {
+	Words      int
+	Separator  string
+	Capitalize bool
+	// Number - добавить к случайному слову цифру
+	Number bool
+}
+
+// DefaultPassphraseOptions - параметры парольной фразы по умолчанию
+func DefaultPassphraseOptions() PassphraseOptions {
+	return PassphraseOptions{
+		Words:     6,
+		Separator: "-",
+	}
+}
+
+// Result - сгенерированный секрет и оценка его энтропии в битах
+type Result struct {
+	Value   string  `json:"value"`
+	Entropy float64 `json:"entropy"`
+}
+
+// classes - выбранные наборы символов без неоднозначных символов
+func (opts PasswordOptions) classes() []string {
+	var classes []string
+	for _, class := range []struct {
+		enabled bool
+		chars   string
+	}{
+		{opts.Lower, LowerChars},
+		{opts.Upper, UpperChars},
+		{opts.Digits, DigitChars},
+		{opts.Symbols, SymbolChars},
+	} {
+		if !class.enabled {
+			continue
+		}
+		chars := class.chars
+		if opts.ExcludeAmbiguous {
+			chars = strings.Map(func(r rune) rune {
+				if strings.ContainsRune(AmbiguousChars, r) {
+					return -1
+				}
+				return r
+			}, chars)
+		}
+		classes = append(classes, chars)
+	}
+
+	return classes
+}
+
+// Password - сгенерировать пароль, содержащий хотя бы один символ каждого выбранного набора
+func Password(opts PasswordOptions) (Result, error) {
+	if opts.Length < MinLength || opts.Length > MaxLength {
+		return Result{}, ErrLength
+	}
+	classes := opts.classes()
+	if len(classes) == 0 {
+		return Result{}, ErrNoCharClasses
+	}
+
+	alphabet := []rune(strings.Join(classes, ""))
+	password := make([]rune, 0, opts.Length)
+	for _, class := range classes {
+		r, err := pick([]rune(class))
+		if err != nil {
+			return Result{}, err
+		}
+		password = append(password, r)
+	}
+	for len(password) < opts.Length {
+		r, err := pick(alphabet)
+		if err != nil {
+			return Result{}, err
+		}
+		password = append(password, r)
+	}
+
+	err := shuffle(password)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Result{
+		Value:   string(password),
+		Entropy: float64(opts.Length) * math.Log2(float64(len(alphabet))),
+	}, nil
+}
+
+// Passphrase - сгенерировать парольную фразу из слов встроенного списка (diceware)
+func Passphrase(opts PassphraseOptions) (Result, error) {
+	if opts.Words < MinWords || opts.Words > MaxWords {
+		return Result{}, ErrWords
+	}
+
+	words := make([]string, 0, opts.Words)
+	for i := 0; i < opts.Words; i++ {
+		index, err := randomInt(len(Wordlist))
+		if err != nil {
+			return Result{}, err
+		}
+		word := Wordlist[index]
+		if opts.Capitalize {
+			runes := []rune(word)
+			runes[0] = unicode.ToUpper(runes[0])
+			word = string(runes)
+		}
+		words = append(words, word)
+	}
+
+	entropy := float64(opts.Words) * math.Log2(float64(len(Wordlist)))
+	if opts.Number {
+		index, err := randomInt(len(words))
+		if err != nil {
+			return Result{}, err
+		}
+		digit, err := randomInt(len(DigitChars))
+		if err != nil {
+			return Result{}, err
+		}
+		words[index] += DigitChars[digit : digit+1]
+		entropy += math.Log2(float64(len(words) * len(DigitChars)))
+	}
+
+	return Result{
+		Value:   strings.Join(words, opts.Separator),
+		Entropy: entropy,
+	}, nil
+}
+
+// Strength - словесная оценка стойкости по энтропии
+func Strength(entropy float64) string {
+	switch {
+	case entropy < 40:
+		return i18n.T("strength.weak")
+	case entropy < 60:
+		return i18n.T("strength.medium")
+	case entropy < 80:
+		return i18n.T("strength.strong")
+	}
+
+	return i18n.T("strength.very_strong")
+}
+
+// pick - случайный символ набора
+func pick(chars []rune) (rune, error) {
+	index, err := randomInt(len(chars))
+	if err != nil {
+		return 0, err
+	}
+
+	return chars[index], nil
+}
+
+// shuffle - перемешать символы (Фишер-Йетс), чтобы обязательные символы не стояли в начале
+func shuffle(chars []rune) error {
+	for i := len(chars) - 1; i > 0; i-- {
+		j, err := randomInt(i + 1)
+		if err != nil {
+			return err
+		}
+		chars[i], chars[j] = chars[j], chars[i]
+	}
+
+	return nil
+}
+
+// randomInt - равномерно распределенное случайное число от 0 до n-1
+func randomInt(n int) (int, error) {
+	value, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, fmt.Errorf("random: %w", err)
+	}
+
+	return int(value.Int64()), nil
+}
@@ -0,0 +1,61 @@
+package generator_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/generator"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPassword(t *testing.T) {
+	opts := generator.DefaultPasswordOptions()
+	opts.Length = 32
+
+	for i := 0; i < 50; i++ {
+		res, err := generator.Password(opts)
+		assert.Nil(t, err)
+		assert.Len(t, []rune(res.Value), 32)
+		assert.True(t, strings.ContainsAny(res.Value, generator.LowerChars))
+		assert.True(t, strings.ContainsAny(res.Value, generator.UpperChars))
+		assert.True(t, strings.ContainsAny(res.Value, generator.DigitChars))
+		assert.True(t, strings.ContainsAny(res.Value, generator.SymbolChars))
+		assert.False(t, strings.ContainsAny(res.Value, generator.AmbiguousChars))
+	}
+
+	res, err := generator.Password(generator.PasswordOptions{Length: 10, Digits: true})
+	assert.Nil(t, err)
+	assert.Regexp(t, `^[0-9]{10}$`, res.Value)
+	assert.InDelta(t, 33.2, res.Entropy, 0.1)
+
+	_, err = generator.Password(generator.PasswordOptions{Length: 10})
+	assert.ErrorIs(t, err, generator.ErrNoCharClasses)
+
+	_, err = generator.Password(generator.PasswordOptions{Length: 2, Lower: true})
+	assert.ErrorIs(t, err, generator.ErrLength)
+}
+
+func TestPassphrase(t *testing.T) {
+	res, err := generator.Passphrase(generator.PassphraseOptions{Words: 5, Separator: " "})
+	assert.Nil(t, err)
+
+	words := strings.Split(res.Value, " ")
+	assert.Len(t, words, 5)
+	for _, word := range words {
+		assert.Contains(t, generator.Wordlist, word)
+	}
+	assert.Greater(t, res.Entropy, 50.0)
+
+	res, err = generator.Passphrase(generator.PassphraseOptions{Words: 4, Separator: "-", Capitalize: true, Number: true})
+	assert.Nil(t, err)
+	assert.Regexp(t, `^([A-Z][a-z]+[0-9]?-){3}[A-Z][a-z]+[0-9]?$`, res.Value)
+	assert.Regexp(t, `[0-9]`, res.Value)
+
+	_, err = generator.Passphrase(generator.PassphraseOptions{Words: 1})
+	assert.ErrorIs(t, err, generator.ErrWords)
+}
+
+func TestStrength(t *testing.T) {
+	assert.Equal(t, "слабый", generator.Strength(30))
+	assert.Equal(t, "очень сильный", generator.Strength(128))
+}
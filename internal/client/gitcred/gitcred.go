@@ -0,0 +1,186 @@
+// Package gitcred реализует протокол git credential helper поверх учетных данных хранилища
+package gitcred
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+)
+
+// ErrNoHost - git не передал адрес, по которому можно найти учетные данные
+var ErrNoHost = errors.New("credential has no protocol and host")
+
+// Credential - описание учетных данных в протоколе git credential
+type Credential struct {
+	Protocol string
+	Host     string
+	Path     string
+	Username string
+	Password string
+}
+
+// Read - прочитать атрибуты key=value до пустой строки или конца ввода.
+// Неизвестные атрибуты (capability[], wwwauth[] и другие) пропускаются.
+func Read(r io.Reader) (Credential, error) {
+	cred := Credential{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			break
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return cred, fmt.Errorf("invalid credential line %q", key)
+		}
+		switch key {
+		case "protocol":
+			cred.Protocol = value
+		case "host":
+			cred.Host = value
+		case "path":
+			cred.Path = value
+		case "username":
+			cred.Username = value
+		case "password":
+			cred.Password = value
+		case "url":
+			parsed, err := url.Parse(value)
+			if err != nil {
+				return cred, fmt.Errorf("parse credential url: %w", err)
+			}
+			cred.Protocol, cred.Host, cred.Path = parsed.Scheme, parsed.Host, strings.TrimPrefix(parsed.Path, "/")
+			if parsed.User != nil {
+				cred.Username = parsed.User.Username()
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return cred, err
+	}
+	if cred.Protocol == "" || cred.Host == "" {
+		return cred, ErrNoHost
+	}
+
+	return cred, nil
+}
+
+// Write - вывести логин и пароль для git
+func (cred Credential) Write(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "username=%s\npassword=%s\n", cred.Username, cred.Password)
+	return err
+}
+
+// URL - адрес репозитория или хоста
+func (cred Credential) URL() string {
+	target := cred.Protocol + "://" + cred.Host
+	if cred.Path != "" {
+		target += "/" + cred.Path
+	}
+
+	return target
+}
+
+// Match - найденная запись с учетными данными
+type Match struct {
+	Data  models.DataInfo
+	Value models.CredentialsValue
+}
+
+// urlMatchRank - чем точнее правило сопоставления, тем выше приоритет записи.
+// Правило по домену слишком широкое для выдачи пароля git и не учитывается.
+var urlMatchRank = map[models.URLMatch]int{
+	models.URLMatchExact:      4,
+	models.URLMatchStartsWith: 3,
+	models.URLMatchRegexp:     2,
+	models.URLMatchHost:       1,
+}
+
+// Find - найти учетные данные для адреса по правилам сопоставления URL записей.
+// Подходят правила не шире хоста, протокол правила должен совпадать с протоколом git.
+// Если git передал логин, подходят только записи с ним. Из подходящих выбирается
+// запись с самым точным правилом, затем самая новая.
+func Find(dataList []models.DataInfo, cred Credential) (Match, bool) {
+	target := cred.URL()
+
+	var (
+		best     Match
+		bestRank int
+	)
+	for _, data := range dataList {
+		if data.Type != models.DataTypeCredentials {
+			continue
+		}
+
+		rank := 0
+		for _, dataURL := range data.URLs {
+			if !sameProtocol(dataURL, cred.Protocol) || !dataURL.Matches(target) {
+				continue
+			}
+			if urlMatchRank[dataURL.Match] > rank {
+				rank = urlMatchRank[dataURL.Match]
+			}
+		}
+		if rank == 0 {
+			continue
+		}
+
+		value := models.CredentialsValue{}
+		if err := models.DecodeValue(data.Value, &value); err != nil {
+			continue
+		}
+		if cred.Username != "" && value.Login != cred.Username {
+			continue
+		}
+
+		if rank > bestRank || (rank == bestRank && data.UpdatedAt.After(best.Data.UpdatedAt)) {
+			best, bestRank = Match{Data: data, Value: value}, rank
+		}
+	}
+
+	return best, bestRank > 0
+}
+
+// sameProtocol - проверить, что правило относится к протоколу git.
+// Адрес правила без схемы считается https, как и при сопоставлении URL.
+func sameProtocol(dataURL models.DataURL, protocol string) bool {
+	if dataURL.Match == models.URLMatchRegexp {
+		// Регулярное выражение проверяется по полному адресу вместе со схемой
+		return true
+	}
+
+	scheme, _, found := strings.Cut(dataURL.URL, "://")
+	if !found {
+		scheme = "https"
+	}
+
+	return strings.EqualFold(scheme, protocol)
+}
+
+// NewData - новая запись для учетных данных, которые git попросил сохранить.
+// Адрес сопоставляется по хосту, а если git передал путь - по началу адреса.
+func NewData(cred Credential) (commonRequests.DataModel, error) {
+	value, err := models.EncodeValue(models.CredentialsValue{Login: cred.Username, Password: cred.Password})
+	if err != nil {
+		return commonRequests.DataModel{}, err
+	}
+
+	dataURL := models.DataURL{URL: cred.URL(), Match: models.URLMatchHost}
+	if cred.Path != "" {
+		dataURL.Match = models.URLMatchStartsWith
+	}
+
+	return commonRequests.DataModel{
+		Type:        models.DataTypeCredentials,
+		Description: cred.Host,
+		Value:       value,
+		URLs:        models.DataURLs{dataURL},
+	}, nil
+}
@@ -0,0 +1,117 @@
+package gitcred_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/gitcred"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// credentials - учетные данные с адресом
+func credentials(t *testing.T, id uint, login, password string, dataURL models.DataURL, updatedAt time.Time) models.DataInfo {
+	t.Helper()
+
+	value, err := models.EncodeValue(models.CredentialsValue{Login: login, Password: password})
+	require.NoError(t, err)
+
+	return models.DataInfo{
+		ID:        id,
+		Type:      models.DataTypeCredentials,
+		Value:     value,
+		URLs:      models.DataURLs{dataURL},
+		UpdatedAt: updatedAt,
+	}
+}
+
+func TestRead(t *testing.T) {
+	cred, err := gitcred.Read(strings.NewReader("capability[]=authtype\nprotocol=https\nhost=git.example.com:8443\npath=team/repo.git\nusername=dev\n\nignored=1\n"))
+	require.NoError(t, err)
+	assert.Equal(t, gitcred.Credential{Protocol: "https", Host: "git.example.com:8443", Path: "team/repo.git", Username: "dev"}, cred)
+	assert.Equal(t, "https://git.example.com:8443/team/repo.git", cred.URL())
+
+	cred, err = gitcred.Read(strings.NewReader("url=https://bot@github.com/org/repo\n"))
+	require.NoError(t, err)
+	assert.Equal(t, gitcred.Credential{Protocol: "https", Host: "github.com", Path: "org/repo", Username: "bot"}, cred)
+
+	_, err = gitcred.Read(strings.NewReader("username=dev\n"))
+	assert.ErrorIs(t, err, gitcred.ErrNoHost)
+
+	var buf bytes.Buffer
+	require.NoError(t, gitcred.Credential{Username: "dev", Password: "token"}.Write(&buf))
+	assert.Equal(t, "username=dev\npassword=token\n", buf.String())
+}
+
+func TestFind(t *testing.T) {
+	now := time.Now()
+	dataList := []models.DataInfo{
+		credentials(t, 1, "dev", "domain-token", models.DataURL{URL: "github.com"}, now),
+		credentials(t, 2, "dev", "repo-token", models.DataURL{URL: "https://github.com/org/repo", Match: models.URLMatchStartsWith}, now.Add(-time.Hour)),
+		credentials(t, 3, "bot", "bot-token", models.DataURL{URL: "https://github.com", Match: models.URLMatchHost}, now),
+		credentials(t, 4, "dev", "never", models.DataURL{URL: "https://github.com", Match: models.URLMatchNever}, now),
+	}
+
+	match, ok := gitcred.Find(dataList, gitcred.Credential{Protocol: "https", Host: "github.com", Path: "org/repo.git", Username: "dev"})
+	require.True(t, ok)
+	assert.Equal(t, uint(2), match.Data.ID)
+	assert.Equal(t, "repo-token", match.Value.Password)
+
+	match, ok = gitcred.Find(dataList, gitcred.Credential{Protocol: "https", Host: "github.com", Path: "org/other.git"})
+	require.True(t, ok)
+	assert.Equal(t, uint(3), match.Data.ID)
+
+	_, ok = gitcred.Find(dataList, gitcred.Credential{Protocol: "https", Host: "gitlab.com"})
+	assert.False(t, ok)
+
+	// Правило по домену не подходит даже для самого домена
+	_, ok = gitcred.Find(dataList[:1], gitcred.Credential{Protocol: "https", Host: "github.com"})
+	assert.False(t, ok)
+}
+
+func TestFindProtocol(t *testing.T) {
+	now := time.Now()
+	dataList := []models.DataInfo{
+		credentials(t, 1, "dev", "https-token", models.DataURL{URL: "https://git.example.com", Match: models.URLMatchHost}, now),
+		credentials(t, 2, "dev", "no-scheme-token", models.DataURL{URL: "git.example.com", Match: models.URLMatchHost}, now),
+	}
+
+	_, ok := gitcred.Find(dataList, gitcred.Credential{Protocol: "http", Host: "git.example.com"})
+	assert.False(t, ok)
+
+	match, ok := gitcred.Find(dataList, gitcred.Credential{Protocol: "https", Host: "git.example.com"})
+	require.True(t, ok)
+	assert.Equal(t, "https-token", match.Value.Password)
+}
+
+func TestFindPublicSuffix(t *testing.T) {
+	dataList := []models.DataInfo{
+		credentials(t, 1, "dev", "domain-token", models.DataURL{URL: "https://example.co.uk", Match: models.URLMatchDomain}, time.Now()),
+		credentials(t, 2, "dev", "host-token", models.DataURL{URL: "https://example.co.uk", Match: models.URLMatchHost}, time.Now()),
+	}
+
+	_, ok := gitcred.Find(dataList, gitcred.Credential{Protocol: "https", Host: "attacker.co.uk"})
+	assert.False(t, ok)
+
+	match, ok := gitcred.Find(dataList, gitcred.Credential{Protocol: "https", Host: "example.co.uk"})
+	require.True(t, ok)
+	assert.Equal(t, uint(2), match.Data.ID)
+}
+
+func TestNewData(t *testing.T) {
+	data, err := gitcred.NewData(gitcred.Credential{Protocol: "https", Host: "git.example.com", Username: "dev", Password: "token"})
+	require.NoError(t, err)
+	assert.Equal(t, "git.example.com", data.Description)
+	assert.Equal(t, models.DataURLs{{URL: "https://git.example.com", Match: models.URLMatchHost}}, data.URLs)
+
+	value := models.CredentialsValue{}
+	require.NoError(t, models.DecodeValue(data.Value, &value))
+	assert.Equal(t, models.CredentialsValue{Login: "dev", Password: "token"}, value)
+
+	data, err = gitcred.NewData(gitcred.Credential{Protocol: "https", Host: "git.example.com", Path: "team/repo.git"})
+	require.NoError(t, err)
+	assert.Equal(t, models.DataURLs{{URL: "https://git.example.com/team/repo.git", Match: models.URLMatchStartsWith}}, data.URLs)
+}
@@ -0,0 +1,110 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/go-resty/resty/v2"
+)
+
+// codeErrors - ошибки клиента для кодов ошибок сервера
+var codeErrors = map[models.ErrorCode]error{
+	models.ErrorCodeBadRequest:         ErrBadRequest,
+	models.ErrorCodeValidation:         ErrValidation,
+	models.ErrorCodeUnauthorized:       ErrUserUnauthorized,
+	models.ErrorCodeInvalidCredentials: ErrInvalidAuth,
+	models.ErrorCodeUserExists:         ErrUserExist,
+	models.ErrorCodeForbidden:          ErrForbidden,
+	models.ErrorCodeNotFound:           ErrNotFound,
+	models.ErrorCodeItemTooLarge:       ErrItemTooLarge,
+	models.ErrorCodeQuotaExceeded:      ErrQuotaExceeded,
+	models.ErrorCodeInternal:           ErrServerProblem,
+	models.ErrorCodeBatchRolledBack:    ErrBatchRolledBack,
+}
+
+// APIError - ошибка из ответа сервера. Через errors.Is сравнивается с ошибкой клиента
+// для своего кода, например ErrUserExist.
+type APIError struct {
+	Status    int
+	Code      models.ErrorCode
+	Message   string
+	Fields    map[string]string
+	RequestID string
+}
+
+// decodeError - ошибка из ответа сервера. Если тело не разобрано (например, ответил прокси),
+// код определяется по статусу.
+func decodeError(resp *resty.Response) *APIError {
+	apiErr := &APIError{Status: resp.StatusCode()}
+
+	var body models.ErrorResponse
+	if err := json.Unmarshal(resp.Body(), &body); err == nil && body.Code != "" {
+		apiErr.Code = body.Code
+		apiErr.Message = body.Message
+		apiErr.Fields = body.Fields
+		apiErr.RequestID = body.RequestID
+	} else {
+		apiErr.Code = models.ErrorCodeByStatus(resp.StatusCode())
+	}
+	if apiErr.RequestID == "" {
+		apiErr.RequestID = resp.Header().Get("X-Request-Id")
+	}
+
+	return apiErr
+}
+
+// Error - текст ошибки на языке клиента с полями, не прошедшими проверку, и идентификатором запроса
+func (e *APIError) Error() string {
+	var builder strings.Builder
+	switch {
+	case codeErrors[e.Code] != nil:
+		builder.WriteString(codeErrors[e.Code].Error())
+	case e.Message != "":
+		// Код новее клиента, показывается описание сервера
+		builder.WriteString(e.Message)
+	default:
+		builder.WriteString(ErrServerProblem.Error())
+	}
+
+	if len(e.Fields) > 0 {
+		fields := make([]string, 0, len(e.Fields))
+		for field := range e.Fields {
+			fields = append(fields, field)
+		}
+		slices.Sort(fields)
+
+		for i, field := range fields {
+			if i == 0 {
+				builder.WriteString(": ")
+			} else {
+				builder.WriteString(", ")
+			}
+			builder.WriteString(fmt.Sprintf("%s (%s)", field, ruleText(e.Fields[field])))
+		}
+	}
+
+	if e.RequestID != "" {
+		builder.WriteString(" " + i18n.T("error.request_id", e.RequestID))
+	}
+
+	return builder.String()
+}
+
+// Unwrap - ошибка клиента для кода ошибки, nil для неизвестного кода
+func (e *APIError) Unwrap() error {
+	return codeErrors[e.Code]
+}
+
+// ruleText - описание нарушенного правила проверки поля
+func ruleText(rule string) string {
+	key := "validation." + rule
+	if text := i18n.T(key); text != key {
+		return text
+	}
+
+	return rule
+}
@@ -0,0 +1,82 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/config"
+	httpClient "github.com/ShukinDmitriy/GophKeeper/internal/client/http"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/router"
+	appLogger "github.com/ShukinDmitriy/GophKeeper/internal/logger"
+	"github.com/labstack/gommon/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIError(t *testing.T) {
+	mux := http.NewServeMux()
+	writeError := func(w http.ResponseWriter, status int, body models.ErrorResponse) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(body)
+	}
+	mux.HandleFunc(router.ApiRegisterPath, func(w http.ResponseWriter, _ *http.Request) {
+		writeError(w, http.StatusConflict, models.ErrorResponse{Code: models.ErrorCodeUserExists, Message: "login already exist"})
+	})
+	mux.HandleFunc(router.ApiLoginPath, func(w http.ResponseWriter, _ *http.Request) {
+		writeError(w, http.StatusBadRequest, models.ErrorResponse{
+			Code:      models.ErrorCodeValidation,
+			Message:   "validation failed",
+			Fields:    map[string]string{"password": "required", "login": "min"},
+			RequestID: "req-1",
+		})
+	})
+	mux.HandleFunc(router.ApiUserUsagePath, func(w http.ResponseWriter, _ *http.Request) {
+		http.Error(w, "bad gateway", http.StatusBadGateway)
+	})
+	mux.HandleFunc(router.ApiVersionPath, func(w http.ResponseWriter, _ *http.Request) {
+		writeError(w, http.StatusTeapot, models.ErrorResponse{Code: "brand_new", Message: "newer server"})
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	client, err := httpClient.NewClient(&config.Config{ServerAddress: server.URL}, appLogger.NewLogger(log.OFF, ""))
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	err = client.Register(ctx, commonRequests.UserRegister{Login: "alice", Password: "secret"})
+	assert.ErrorIs(t, err, httpClient.ErrUserExist)
+	var apiErr *httpClient.APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusConflict, apiErr.Status)
+	assert.Equal(t, "login already exist", apiErr.Message)
+
+	err = client.Login(ctx, commonRequests.UserLogin{Login: "al"})
+	assert.ErrorIs(t, err, httpClient.ErrValidation)
+	assert.EqualError(t, err, "Не удалось авторизоваться: проверьте поля: "+
+		"login (слишком короткое или маленькое значение), password (обязательное поле) (запрос req-1)")
+
+	// Ответ не от сервера GophKeeper: код по статусу
+	_, err = client.GetUsage(ctx)
+	assert.ErrorIs(t, err, httpClient.ErrServerProblem)
+
+	// Код новее клиента: описание сервера
+	_, err = client.GetVersion(ctx)
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "newer server", apiErr.Error())
+	assert.Nil(t, errors.Unwrap(apiErr))
+
+	i18n.SetLocale(i18n.English)
+	t.Cleanup(func() {
+		i18n.SetLocale(i18n.DefaultLocale)
+	})
+	err = client.Register(ctx, commonRequests.UserRegister{Login: "alice", Password: "secret"})
+	assert.EqualError(t, err, "Sign up failed: user already exists")
+}
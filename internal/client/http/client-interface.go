@@ -2,16 +2,27 @@ package http
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
 	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/version"
 )
 
 type ClientInterface interface {
+	GetVersion(ctx context.Context) (*version.Info, error)
 	Login(ctx context.Context, data commonRequests.UserLogin) error
 	Register(ctx context.Context, data commonRequests.UserRegister) error
+	Refresh(ctx context.Context) error
+	GetUsage(ctx context.Context) (*models.UserUsage, error)
+	GetData(ctx context.Context, id uint) (*models.DataInfo, error)
 	GetList(ctx context.Context, dataType models.DataType) ([]models.DataInfo, error)
+	GetExpiring(ctx context.Context, days int) ([]models.DataInfo, error)
 	CreateData(ctx context.Context, data commonRequests.DataModel) (*models.DataInfo, error)
 	UpdateData(ctx context.Context, data models.DataInfo) (*models.DataInfo, error)
 	DeleteData(ctx context.Context, id uint) error
+	Batch(ctx context.Context, data commonRequests.DataBatch) ([]models.DataBatchResult, error)
+	Cookies() []*http.Cookie
+	SetCookies(cookies []*http.Cookie)
+	Reset() error
 }
@@ -2,30 +2,40 @@ package http
 
 import (
 	"context"
-	"crypto/x509"
 	"encoding/json"
-	"errors"
 	"fmt"
-	"log"
 	"net/http"
-	"os"
+	"net/http/cookiejar"
+	"net/url"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
 	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
 
 	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
 	"github.com/ShukinDmitriy/GophKeeper/internal/common/router"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/version"
 
 	"github.com/ShukinDmitriy/GophKeeper/internal/client/config"
 	"github.com/ShukinDmitriy/GophKeeper/internal/logger"
 	"github.com/go-resty/resty/v2"
 )
 
+// Ошибки сервера соответствуют кодам models.ErrorCode, текст переводится на язык клиента
 var (
-	ErrInvalidAuth      = errors.New(`неправильные имя пользователя и пароль`)
-	ErrUserExist        = errors.New(`пользователь существует`)
-	ErrUserUnauthorized = errors.New(`пользователь не авторизован`)
-	ErrServerProblem    = errors.New(`попробуйте позже`)
+	ErrInvalidAuth      = i18n.CodeError(models.ErrorCodeInvalidCredentials)
+	ErrUserExist        = i18n.CodeError(models.ErrorCodeUserExists)
+	ErrUserUnauthorized = i18n.CodeError(models.ErrorCodeUnauthorized)
+	ErrServerProblem    = i18n.CodeError(models.ErrorCodeInternal)
+	ErrBatchRolledBack  = i18n.CodeError(models.ErrorCodeBatchRolledBack)
+	ErrItemTooLarge     = i18n.CodeError(models.ErrorCodeItemTooLarge)
+	ErrQuotaExceeded    = i18n.CodeError(models.ErrorCodeQuotaExceeded)
+	ErrNotFound         = i18n.CodeError(models.ErrorCodeNotFound)
+	ErrBadRequest       = i18n.CodeError(models.ErrorCodeBadRequest)
+	ErrValidation       = i18n.CodeError(models.ErrorCodeValidation)
+	ErrForbidden        = i18n.CodeError(models.ErrorCodeForbidden)
 )
 
 // Client - http client
@@ -33,33 +43,99 @@ type Client struct {
 	config *config.Config
 	client *resty.Client
 	appLog logger.Logger
+
+	cookiesMutex sync.Mutex
+	cookies      map[string]*http.Cookie
 }
 
 // NewClient - Создаёт клиента для подключения к серверу по http/HTTPS
 func NewClient(
 	c *config.Config,
 	appLog logger.Logger,
-) *Client {
+) (*Client, error) {
 	r := resty.New()
-	if c.EnableHTTPS {
-		appLog.Info("Use TLS")
-		certPath := "ssl/localhost.crt"
-		caCert, err := os.ReadFile(certPath)
-		if err != nil {
-			log.Fatal(err)
-		}
-		caCertPool := x509.NewCertPool()
-		ok := caCertPool.AppendCertsFromPEM(caCert)
-		if !ok {
-			appLog.Error("Can't add cert to certpool")
+	hc := &Client{
+		config:  c,
+		client:  r,
+		appLog:  appLog,
+		cookies: make(map[string]*http.Cookie),
+	}
+
+	if err := hc.configureTLS(); err != nil {
+		return nil, err
+	}
+
+	// Сервер обновляет токены в cookie, запоминаем последние для сохранения сессии
+	r.OnAfterResponse(func(_ *resty.Client, resp *resty.Response) error {
+		hc.storeCookies(resp.Cookies())
+		return nil
+	})
+
+	return hc, nil
+}
+
+// Reset - забыть авторизацию и заново применить настройки подключения, например после смены профиля
+func (hc *Client) Reset() error {
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return err
+	}
+	hc.client.SetCookieJar(jar)
+	hc.client.Cookies = nil
+
+	hc.cookiesMutex.Lock()
+	hc.cookies = make(map[string]*http.Cookie)
+	hc.cookiesMutex.Unlock()
+
+	return hc.configureTLS()
+}
+
+// storeCookies - запомнить cookie из ответа сервера
+func (hc *Client) storeCookies(cookies []*http.Cookie) {
+	hc.cookiesMutex.Lock()
+	defer hc.cookiesMutex.Unlock()
+
+	for _, cookie := range cookies {
+		hc.cookies[cookie.Name] = cookie
+	}
+}
+
+// Cookies - действующие cookie авторизации
+func (hc *Client) Cookies() []*http.Cookie {
+	hc.cookiesMutex.Lock()
+	defer hc.cookiesMutex.Unlock()
+
+	now := time.Now()
+	cookies := make([]*http.Cookie, 0, len(hc.cookies))
+	for _, cookie := range hc.cookies {
+		if !cookie.Expires.IsZero() && cookie.Expires.Before(now) {
+			continue
 		}
-		r = r.SetRootCertificate(certPath)
+		cookies = append(cookies, cookie)
 	}
-	return &Client{
-		config: c,
-		client: r,
-		appLog: appLog,
+
+	return cookies
+}
+
+// SetCookies - восстановить cookie авторизации, например из сохраненной сессии.
+// Истекшие cookie не отправляются, при истекшем access-token сервер обновит его по refresh-token.
+func (hc *Client) SetCookies(cookies []*http.Cookie) {
+	hc.cookiesMutex.Lock()
+	hc.cookies = make(map[string]*http.Cookie, len(cookies))
+	hc.cookiesMutex.Unlock()
+	hc.storeCookies(cookies)
+
+	serverURL, err := url.Parse(hc.config.ServerAddress)
+	if err != nil {
+		hc.appLog.Error(fmt.Sprintf("parse server address: %v", err))
+		return
+	}
+
+	jar := hc.client.GetClient().Jar
+	if jar == nil {
+		return
 	}
+	jar.SetCookies(serverURL, hc.Cookies())
 }
 
 // Login - авторизация пользователя
@@ -69,22 +145,32 @@ func (hc *Client) Login(ctx context.Context, data commonRequests.UserLogin) erro
 		SetBody(data).
 		Post(fmt.Sprintf("%s%s", hc.config.ServerAddress, router.ApiLoginPath))
 	if err != nil {
-		return fmt.Errorf("Не удалось выполнить запрос: %w", err)
+		return fmt.Errorf("%s: %w", i18n.T("http.request_failed"), err)
 	}
 	if resp.StatusCode() != http.StatusOK {
-		switch resp.StatusCode() {
-		case http.StatusBadRequest:
-			return fmt.Errorf("Не удалось авторизоваться: %v", data)
-		case http.StatusUnauthorized:
-			return fmt.Errorf("Не удалось авторизоваться: %w", ErrInvalidAuth)
-		case http.StatusInternalServerError:
-			return fmt.Errorf("Не удалось авторизоваться %w", ErrServerProblem)
-		}
+		return fmt.Errorf("%s: %w", i18n.T("http.login_failed"), decodeError(resp))
 	}
 
 	cookies := resp.Cookies()
 	hc.client.SetCookies(cookies)
-	hc.appLog.Debug(fmt.Sprintf("Auth on server, cookies=%v", cookies))
+	hc.appLog.Debug(fmt.Sprintf("Auth on server, cookies=%d", len(cookies)))
+
+	return nil
+}
+
+// Refresh - обновить токены по refresh токену из cookie
+func (hc *Client) Refresh(ctx context.Context) error {
+	resp, err := hc.client.R().
+		SetContext(ctx).
+		Post(fmt.Sprintf("%s%s", hc.config.ServerAddress, router.ApiRefreshPath))
+	if err != nil {
+		return fmt.Errorf("%s: %w", i18n.T("http.request_failed"), err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("%s: %w", i18n.T("http.refresh_failed"), decodeError(resp))
+	}
+
+	hc.appLog.Debug("Session refreshed")
 
 	return nil
 }
@@ -96,17 +182,10 @@ func (hc *Client) Register(ctx context.Context, data commonRequests.UserRegister
 		SetBody(data).
 		Post(fmt.Sprintf("%s%s", hc.config.ServerAddress, router.ApiRegisterPath))
 	if err != nil {
-		return fmt.Errorf("Не удалось выполнить запрос: %w", err)
+		return fmt.Errorf("%s: %w", i18n.T("http.request_failed"), err)
 	}
 	if resp.StatusCode() != http.StatusOK {
-		switch resp.StatusCode() {
-		case http.StatusBadRequest:
-			return fmt.Errorf("Не удалось зарегистрироваться: %v", data)
-		case http.StatusConflict:
-			return fmt.Errorf("Не удалось зарегистрироваться: %w", ErrUserExist)
-		case http.StatusInternalServerError:
-			return fmt.Errorf("Не удалось зарегистрироваться %w", ErrServerProblem)
-		}
+		return fmt.Errorf("%s: %w", i18n.T("http.register_failed"), decodeError(resp))
 	}
 
 	cookies := resp.Cookies()
@@ -116,6 +195,48 @@ func (hc *Client) Register(ctx context.Context, data commonRequests.UserRegister
 	return nil
 }
 
+// GetVersion - получить версию сервера и API
+func (hc *Client) GetVersion(ctx context.Context) (*version.Info, error) {
+	resp, err := hc.client.R().
+		SetContext(ctx).
+		Get(fmt.Sprintf("%s%s", hc.config.ServerAddress, router.ApiVersionPath))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.request_failed"), err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.version_failed"), decodeError(resp))
+	}
+
+	info := &version.Info{}
+	err = json.Unmarshal(resp.Body(), info)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.parse_failed"), err)
+	}
+
+	return info, nil
+}
+
+// GetUsage - получить использование хранилища и лимиты пользователя
+func (hc *Client) GetUsage(ctx context.Context) (*models.UserUsage, error) {
+	resp, err := hc.client.R().
+		SetContext(ctx).
+		Get(fmt.Sprintf("%s%s", hc.config.ServerAddress, router.ApiUserUsagePath))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.request_failed"), err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.usage_failed"), decodeError(resp))
+	}
+
+	usage := &models.UserUsage{}
+	err = json.Unmarshal(resp.Body(), usage)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.parse_failed"), err)
+	}
+
+	return usage, nil
+}
+
 // GetList - получить список данных по типу
 func (hc *Client) GetList(ctx context.Context, dataType models.DataType) ([]models.DataInfo, error) {
 	var dataList []models.DataInfo
@@ -124,29 +245,70 @@ func (hc *Client) GetList(ctx context.Context, dataType models.DataType) ([]mode
 		SetContext(ctx).
 		Get(fmt.Sprintf("%s%s?type=%v", hc.config.ServerAddress, router.ApiDataListPath, dataType))
 	if err != nil {
-		return dataList, fmt.Errorf("Не удалось выполнить запрос: %w", err)
+		return dataList, fmt.Errorf("%s: %w", i18n.T("http.request_failed"), err)
 	}
 	if resp.StatusCode() != http.StatusOK {
-		switch resp.StatusCode() {
-		case http.StatusBadRequest:
-			return dataList, fmt.Errorf("Не удалось получить данные: %v", dataType)
-		case http.StatusUnauthorized:
-			return dataList, fmt.Errorf("Не удалось получить данные: %w", ErrUserUnauthorized)
-		case http.StatusInternalServerError:
-			return dataList, fmt.Errorf("Не удалось получить данные %w", ErrServerProblem)
-		}
+		return dataList, fmt.Errorf("%s: %w", i18n.T("http.list_failed"), decodeError(resp))
 	}
 
 	err = json.Unmarshal(resp.Body(), &dataList)
 	if err != nil {
-		return dataList, fmt.Errorf("Не удалось разобрать ответ: %w", err)
+		return dataList, fmt.Errorf("%s: %w", i18n.T("http.parse_failed"), err)
 	}
 
-	hc.appLog.Debug(fmt.Sprintf("Data %v successfully getting:", dataList))
+	hc.appLog.Debug(fmt.Sprintf("Data successfully getting: %d records", len(dataList)))
 
 	return dataList, nil
 }
 
+// GetExpiring - получить записи, которые истекли или истекут в ближайшие days дней
+func (hc *Client) GetExpiring(ctx context.Context, days int) ([]models.DataInfo, error) {
+	var dataList []models.DataInfo
+
+	resp, err := hc.client.R().
+		SetContext(ctx).
+		Get(fmt.Sprintf("%s%s?days=%d", hc.config.ServerAddress, router.ApiDataExpiringPath, days))
+	if err != nil {
+		return dataList, fmt.Errorf("%s: %w", i18n.T("http.request_failed"), err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return dataList, fmt.Errorf("%s: %w", i18n.T("http.list_failed"), decodeError(resp))
+	}
+
+	err = json.Unmarshal(resp.Body(), &dataList)
+	if err != nil {
+		return dataList, fmt.Errorf("%s: %w", i18n.T("http.parse_failed"), err)
+	}
+
+	hc.appLog.Debug(fmt.Sprintf("Expiring data successfully getting: %d records", len(dataList)))
+
+	return dataList, nil
+}
+
+// GetData - получить запись по идентификатору
+func (hc *Client) GetData(ctx context.Context, id uint) (*models.DataInfo, error) {
+	url := fmt.Sprintf("%s%s", hc.config.ServerAddress, router.ApiDataReadPath)
+	url = strings.Replace(url, ":id", fmt.Sprintf("%d", id), 1)
+
+	resp, err := hc.client.R().
+		SetContext(ctx).
+		Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.request_failed"), err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.get_failed"), decodeError(resp))
+	}
+
+	resData := &models.DataInfo{}
+	err = json.Unmarshal(resp.Body(), resData)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.parse_failed"), err)
+	}
+
+	return resData, nil
+}
+
 // CreateData - создать новую запись
 func (hc *Client) CreateData(ctx context.Context, data commonRequests.DataModel) (*models.DataInfo, error) {
 	resp, err := hc.client.R().
@@ -154,26 +316,19 @@ func (hc *Client) CreateData(ctx context.Context, data commonRequests.DataModel)
 		SetBody(data).
 		Post(fmt.Sprintf("%s%s", hc.config.ServerAddress, router.ApiDataCreatePath))
 	if err != nil {
-		return nil, fmt.Errorf("Не удалось выполнить запрос: %w", err)
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.request_failed"), err)
 	}
 	if resp.StatusCode() != http.StatusCreated {
-		switch resp.StatusCode() {
-		case http.StatusBadRequest:
-			return nil, fmt.Errorf("Не удалось создать запись: %v", data)
-		case http.StatusUnauthorized:
-			return nil, fmt.Errorf("Не удалось создать запись: %w", ErrUserUnauthorized)
-		case http.StatusInternalServerError:
-			return nil, fmt.Errorf("Не удалось создать запись %w", ErrServerProblem)
-		}
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.create_failed"), decodeError(resp))
 	}
 
 	resData := &models.DataInfo{}
 	err = json.Unmarshal(resp.Body(), &resData)
 	if err != nil {
-		return resData, fmt.Errorf("Не удалось разобрать ответ: %w", err)
+		return resData, fmt.Errorf("%s: %w", i18n.T("http.parse_failed"), err)
 	}
 
-	hc.appLog.Debug(fmt.Sprintf("Создана запись: %v", data))
+	hc.appLog.Debug(fmt.Sprintf("Data successfully created: %d", resData.ID))
 
 	return resData, nil
 }
@@ -188,30 +343,55 @@ func (hc *Client) UpdateData(ctx context.Context, data models.DataInfo) (*models
 		SetBody(data).
 		Put(url)
 	if err != nil {
-		return nil, fmt.Errorf("Не удалось выполнить запрос: %w", err)
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.request_failed"), err)
 	}
 	if resp.StatusCode() != http.StatusOK {
-		switch resp.StatusCode() {
-		case http.StatusBadRequest:
-			return nil, fmt.Errorf("Не удалось изменить запись: %v", data)
-		case http.StatusUnauthorized:
-			return nil, fmt.Errorf("Не удалось изменить запись: %w", ErrUserUnauthorized)
-		case http.StatusInternalServerError:
-			return nil, fmt.Errorf("Не удалось изменить запись %w", ErrServerProblem)
-		}
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.update_failed"), decodeError(resp))
 	}
 
 	resData := &models.DataInfo{}
 	err = json.Unmarshal(resp.Body(), &resData)
 	if err != nil {
-		return resData, fmt.Errorf("Не удалось разобрать ответ: %w", err)
+		return resData, fmt.Errorf("%s: %w", i18n.T("http.parse_failed"), err)
 	}
 
-	hc.appLog.Debug(fmt.Sprintf("Изменена запись: %v", data))
+	hc.appLog.Debug(fmt.Sprintf("Data successfully updated: %d", data.ID))
 
 	return resData, nil
 }
 
+// Batch - выполнить пакет операций с данными.
+// Результат возвращается и при частичной ошибке, и при откате атомарного пакета (ErrBatchRolledBack).
+func (hc *Client) Batch(ctx context.Context, data commonRequests.DataBatch) ([]models.DataBatchResult, error) {
+	var results []models.DataBatchResult
+
+	resp, err := hc.client.R().
+		SetContext(ctx).
+		SetBody(data).
+		Post(fmt.Sprintf("%s%s", hc.config.ServerAddress, router.ApiDataBatchPath))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.request_failed"), err)
+	}
+	switch resp.StatusCode() {
+	case http.StatusOK, http.StatusMultiStatus, http.StatusUnprocessableEntity:
+	default:
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.batch_failed"), decodeError(resp))
+	}
+
+	err = json.Unmarshal(resp.Body(), &results)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", i18n.T("http.parse_failed"), err)
+	}
+
+	hc.appLog.Debug(fmt.Sprintf("Batch successfully executed: %d operations", len(results)))
+
+	if resp.StatusCode() == http.StatusUnprocessableEntity {
+		return results, ErrBatchRolledBack
+	}
+
+	return results, nil
+}
+
 // DeleteData - удалить данные
 func (hc *Client) DeleteData(ctx context.Context, id uint) error {
 	url := fmt.Sprintf("%s%s", hc.config.ServerAddress, router.ApiDataDeletePath)
@@ -221,20 +401,13 @@ func (hc *Client) DeleteData(ctx context.Context, id uint) error {
 		SetContext(ctx).
 		Delete(url)
 	if err != nil {
-		return fmt.Errorf("Не удалось выполнить запрос: %w", err)
+		return fmt.Errorf("%s: %w", i18n.T("http.request_failed"), err)
 	}
 	if resp.StatusCode() != http.StatusAccepted {
-		switch resp.StatusCode() {
-		case http.StatusBadRequest:
-			return fmt.Errorf("Не удалось удалить запись: %v", id)
-		case http.StatusUnauthorized:
-			return fmt.Errorf("Не удалось удалить запись: %w", ErrUserUnauthorized)
-		case http.StatusInternalServerError:
-			return fmt.Errorf("Не удалось удалить запись %w", ErrServerProblem)
-		}
+		return fmt.Errorf("%s: %w", i18n.T("http.delete_failed"), decodeError(resp))
 	}
 
-	hc.appLog.Debug(fmt.Sprintf("Удалена запись: %v", id))
+	hc.appLog.Debug(fmt.Sprintf("Data successfully deleted: %v", id))
 
 	return nil
 }
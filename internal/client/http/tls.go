@@ -0,0 +1,95 @@
+package http
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+)
+
+var (
+	// ErrPinMismatch - открытый ключ сервера не совпадает с закрепленным в профиле
+	ErrPinMismatch = i18n.NewError("http.pin_mismatch")
+	// ErrTLSOverHTTP - в профиле заданы закрепление ключа или сертификат клиента, а адрес сервера http://,
+	// соединение было бы без TLS и эти настройки молча не применялись бы
+	ErrTLSOverHTTP = i18n.NewError("http.tls_over_http")
+)
+
+// devCACert - сертификат разработки, который используется при ENABLE_HTTPS без CA профиля
+const devCACert = "ssl/localhost.crt"
+
+// configureTLS - настроить TLS: CA сервера, сертификат клиента и закрепление ключа сервера
+func (hc *Client) configureTLS() error {
+	tlsOnly := hc.config.PinSHA256 != "" || hc.config.ClientCert != "" || hc.config.ClientKey != ""
+	if tlsOnly && strings.HasPrefix(strings.ToLower(hc.config.ServerAddress), "http://") {
+		return ErrTLSOverHTTP
+	}
+
+	tlsConfig := &tls.Config{}
+
+	caCert := hc.config.CACert
+	if caCert == "" && hc.config.EnableHTTPS {
+		caCert = devCACert
+	}
+	if caCert != "" {
+		hc.appLog.Info("Use TLS")
+		pem, err := os.ReadFile(caCert)
+		if err != nil {
+			return fmt.Errorf("read CA certificate: %w", err)
+		}
+		rootCAs := x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates in %s", caCert)
+		}
+		tlsConfig.RootCAs = rootCAs
+	}
+
+	if hc.config.ClientCert != "" || hc.config.ClientKey != "" {
+		cert, err := tls.LoadX509KeyPair(hc.config.ClientCert, hc.config.ClientKey)
+		if err != nil {
+			return fmt.Errorf("load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	if hc.config.PinSHA256 != "" {
+		pin := strings.TrimPrefix(hc.config.PinSHA256, "sha256/")
+		if _, err := base64.StdEncoding.DecodeString(pin); err != nil {
+			return fmt.Errorf("parse pin_sha256: %w", err)
+		}
+		// Закрепление дополняет обычную проверку цепочки сертификатов
+		tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+			return verifyPin(state, pin)
+		}
+	}
+
+	hc.client.SetTLSClientConfig(tlsConfig)
+
+	return nil
+}
+
+// verifyPin - проверить, что SHA-256 открытого ключа сервера совпадает с закрепленным
+func verifyPin(state tls.ConnectionState, pin string) error {
+	if len(state.PeerCertificates) == 0 {
+		return ErrPinMismatch
+	}
+
+	got := SPKIPin(state.PeerCertificates[0])
+	if got != pin {
+		return fmt.Errorf("%w: %s", ErrPinMismatch, i18n.T("http.pin_expected", pin, got))
+	}
+
+	return nil
+}
+
+// SPKIPin - SHA-256 открытого ключа сертификата в base64, значение для pin_sha256
+func SPKIPin(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,114 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/config"
+	httpClient "github.com/ShukinDmitriy/GophKeeper/internal/client/http"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/version"
+	appLogger "github.com/ShukinDmitriy/GophKeeper/internal/logger"
+	"github.com/labstack/gommon/log"
+)
+
+func newTLSServer(t *testing.T) (*httptest.Server, string) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(version.Get())
+	}))
+	t.Cleanup(server.Close)
+
+	caCert := filepath.Join(t.TempDir(), "ca.crt")
+	block := &pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}
+	if err := os.WriteFile(caCert, pem.EncodeToMemory(block), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	return server, caCert
+}
+
+func TestPinSHA256(t *testing.T) {
+	server, caCert := newTLSServer(t)
+	appLog := appLogger.NewLogger(log.OFF, "")
+
+	tests := []struct {
+		name    string
+		pin     string
+		wantErr error
+	}{
+		{name: "без закрепления", pin: ""},
+		{name: "совпадающий ключ", pin: httpClient.SPKIPin(server.Certificate())},
+		{name: "префикс sha256/", pin: "sha256/" + httpClient.SPKIPin(server.Certificate())},
+		{name: "чужой ключ", pin: "AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA=", wantErr: httpClient.ErrPinMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := httpClient.NewClient(&config.Config{
+				ServerAddress: server.URL,
+				CACert:        caCert,
+				PinSHA256:     tt.pin,
+			}, appLog)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = client.GetVersion(context.Background())
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestNewClientTLSErrors(t *testing.T) {
+	appLog := appLogger.NewLogger(log.OFF, "")
+
+	tests := []struct {
+		name string
+		conf config.Config
+	}{
+		{name: "нет файла CA", conf: config.Config{CACert: filepath.Join(t.TempDir(), "missing.crt")}},
+		{name: "нет ключа клиента", conf: config.Config{ClientCert: filepath.Join(t.TempDir(), "client.crt")}},
+		{name: "неверный pin", conf: config.Config{PinSHA256: "not base64!"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := httpClient.NewClient(&tt.conf, appLog); err == nil {
+				t.Fatal("expected error")
+			}
+		})
+	}
+}
+
+func TestNewClientTLSOverHTTP(t *testing.T) {
+	appLog := appLogger.NewLogger(log.OFF, "")
+
+	tests := []struct {
+		name string
+		conf config.Config
+	}{
+		{name: "pin", conf: config.Config{ServerAddress: "http://localhost:8080", PinSHA256: "AAAA"}},
+		{name: "сертификат клиента", conf: config.Config{ServerAddress: "HTTP://localhost:8080", ClientCert: "client.crt", ClientKey: "client.key"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := httpClient.NewClient(&tt.conf, appLog)
+			if !errors.Is(err, httpClient.ErrTLSOverHTTP) {
+				t.Fatalf("expected %v, got %v", httpClient.ErrTLSOverHTTP, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,395 @@
+package i18n
+
+// en - сообщения на английском языке
+var en = map[string]string{
+	"error.title":            "Error",
+	"button.ok":              "Got it",
+	"data.title":             "Details",
+	"data.title_secret":      "Details (Ctrl+R - show or hide secret)",
+	"field.id":               "ID",
+	"field.type":             "Type",
+	"field.description":      "Description",
+	"button.update":          "Update",
+	"button.delete":          "Delete",
+	"field.login":            "Login",
+	"field.password":         "Password",
+	"field.text":             "Text",
+	"field.file":             "File",
+	"field.preview":          "Preview",
+	"binary.unreadable":      "cannot read content",
+	"field.data":             "Data",
+	"button.save_to":         "Save to…",
+	"button.load_file":       "Load file…",
+	"field.card_number":      "Card number",
+	"field.card_date":        "Expiry date",
+	"field.card_secure":      "Security code",
+	"ssh.fingerprint_failed": "cannot compute",
+	"field.fingerprint":      "Fingerprint",
+	"field.private_key":      "Private key",
+	"field.public_key":       "Public key",
+	"field.passphrase":       "Key passphrase",
+	"field.document_kind":    "Document type",
+	"field.number":           "Number",
+	"field.full_name":        "Full name",
+	"field.issued_by":        "Issued by",
+	"field.issue_date":       "Issue date",
+	"field.valid_until":      "Valid until",
+	"field.ssid":             "Network (SSID)",
+	"field.security":         "Security",
+	"field.wifi_uri":         "Connection string",
+	"field.provider":         "Service",
+	"field.key_id":           "Key ID",
+	"field.secret":           "Secret",
+	"field.endpoint":         "API endpoint",
+	"button.login":           "Sign in",
+	"button.to_register":     "Go to sign up",
+	"button.quit":            "Quit",
+	"button.register":        "Sign up",
+	"button.to_login":        "Go to sign in",
+	"data.types":             "Data types",
+	"button.create":          "Create",
+	"button.audit":           "Audit",
+	"data.storage":           "Storage",
+	"data.records":           "Records",
+	"create.type":            "Select data type: ",
+	"create.title":           "New record",
+	"field.rotate_at":        "Rotate by",
+	"field.expires_at":       "Expires",
+	"button.save":            "Save",
+	"binary.none":            "not selected",
+	"button.choose_file":     "Choose file…",
+
+	"type.credentials":          "Credentials",
+	"type.text":                 "Text",
+	"type.binary":               "Binary data",
+	"type.card":                 "Bank cards",
+	"type.ssh":                  "SSH keys",
+	"type.identity":             "Identity documents",
+	"type.note":                 "Notes",
+	"type.wifi":                 "Wi-Fi",
+	"type.apikey":               "API keys",
+	"auth.invalid_form":         "Enter a valid login and password",
+	"data.delete_failed":        "Failed to delete records: %d",
+	"error.bad_request":         "bad request",
+	"error.validation_failed":   "check the fields",
+	"error.unauthorized":        "user is not authorized",
+	"error.invalid_credentials": "invalid user name or password",
+	"error.user_exists":         "user already exists",
+	"error.forbidden":           "access denied",
+	"error.not_found":           "record not found",
+	"error.item_too_large":      "record is too large",
+	"error.quota_exceeded":      "storage quota exceeded",
+	"error.internal":            "try again later",
+	"error.batch_rolled_back":   "batch of operations rolled back",
+	"error.request_id":          "(request %s)",
+	"http.request_failed":       "Request failed",
+	"http.parse_failed":         "Failed to parse response",
+	"http.login_failed":         "Sign in failed",
+	"http.refresh_failed":       "Failed to refresh session",
+	"http.register_failed":      "Sign up failed",
+	"http.version_failed":       "Failed to get server version",
+	"http.usage_failed":         "Failed to get quota",
+	"http.list_failed":          "Failed to get records",
+	"http.get_failed":           "Failed to get record",
+	"http.create_failed":        "Failed to create record",
+	"http.update_failed":        "Failed to update record",
+	"http.batch_failed":         "Failed to run batch",
+	"http.tls_over_http":        "pin_sha256 and client_cert require an https:// server address",
+	"http.delete_failed":        "Failed to delete record",
+
+	"validation.required":        "required",
+	"validation.required_unless": "required",
+	"validation.min":             "value is too short or too small",
+	"validation.max":             "value is too long or too large",
+	"validation.alphanum":        "letters and digits only",
+	"validation.oneof":           "unsupported value",
+
+	"audit.title":      "Security audit (Esc - back)",
+	"audit.summary":    "Credentials checked: %d\nNo issues: [%s::b]%d%%[-::-]\n",
+	"audit.weak":       "Weak passwords (%d)",
+	"strength.entropy": "%.0f bits, %s",
+	"audit.reused":     "Reused passwords (%d)",
+	"audit.group":      "Group %d:",
+	"audit.old":        "Not changed for a long time (%d)",
+	"audit.days":       "%d days",
+	"button.back":      "Back",
+	"button.cancel":    "Cancel",
+	"button.add":       "Add",
+
+	"binary.unnamed":           "unnamed",
+	"binary.preview_too_large": "file is larger than %s, no preview",
+	"field.breach":             "Breaches",
+	"breach.found":             "password found in breaches %d times, change it",
+	"clipboard.no_field":       "record has no field %s",
+	"clipboard.no_otp":         "no one-time code",
+	"clipboard.otp":            "code",
+	"clipboard.copied_for":     "%s copied for %s",
+	"clipboard.copied":         "%s copied",
+	"search.title":             "Found",
+	"date.placeholder":         "YYYY-MM-DD",
+	"data.expired":             "(expired)",
+	"data.expiring":            "(expiring: %d)",
+	"data.records_selected":    "Records (selected: %d, Delete - delete)",
+	"data.delete_selected":     "Delete selected records (%d)?",
+
+	"field_type.text":       "Text",
+	"field_type.hidden":     "Hidden",
+	"field_type.url":        "URL",
+	"field_type.date":       "Date",
+	"field_type.boolean":    "Flag",
+	"url_match.domain":      "Domain",
+	"url_match.host":        "Host",
+	"url_match.starts_with": "Starts with",
+	"url_match.exact":       "Exact match",
+	"url_match.regexp":      "Regular expression",
+	"url_match.never":       "Never",
+	"field.updated_at":      "Updated",
+	"field.url_match":       "Match",
+	"field.name":            "Name",
+	"field.value":           "Value",
+	"field.field":           "Field",
+	"field.new_title":       "New field",
+	"field.remove_title":    "Remove field",
+	"field.new_url_title":   "New URL",
+	"button.add_field":      "Add field",
+	"button.remove_field":   "Remove field",
+	"button.add_url":        "Add URL",
+
+	"generator.title":             "Password generator",
+	"generator.kind":              "Kind",
+	"generator.password":          "Password",
+	"generator.passphrase":        "Passphrase",
+	"generator.length":            "Password length",
+	"generator.lower":             "Lowercase a-z",
+	"generator.upper":             "Uppercase A-Z",
+	"generator.digits":            "Digits 0-9",
+	"generator.symbols":           "Symbols",
+	"generator.exclude_ambiguous": "No look-alikes (l1O0)",
+	"generator.words":             "Words in phrase",
+	"generator.separator":         "Separator",
+	"generator.capitalize":        "Capitalize",
+	"generator.number":            "Add a digit",
+	"generator.result":            "Result",
+	"generator.entropy":           "Entropy",
+	"button.generate":             "Generate",
+	"button.use":                  "Use",
+	"button.refresh":              "Refresh",
+
+	"size.bytes":           "%d B",
+	"size.scaled":          "%.1f %cB",
+	"size.prefixes":        "KMGT",
+	"usage.of":             "%s of %s",
+	"usage.summary":        "Records: %s\nSize: %s",
+	"version.incompatible": "(server %s: API v%d, client supports v%d)",
+	"field.profile":        "Profile",
+	"ssh.confirm_sign":     "Allow signing with SSH key?\n%s\n%s",
+	"button.allow":         "Allow",
+	"button.deny":          "Deny",
+	"search.found":         "Found: %d",
+	"search.hint":          "Enter - open, e - edit, c/u/o - copy, d - delete, j/k - down/up, / - query, ? - keys, Esc - back",
+	"data.delete_one":      "Delete record %q?",
+	"picker.file_name":     "File name ",
+	"picker.overwrite":     "File %s already exists. Replace it?",
+	"picker.open_title":    "Choose a file up to %s (Enter - select, Backspace - up, Esc - cancel)",
+	"picker.save_title":    "Save to… (Enter - open directory or choose name)",
+	"picker.saved":         "saved to %s",
+	"button.replace":       "Replace",
+
+	"help.title":           "Key bindings (Esc - close)",
+	"help.everywhere":      "Everywhere",
+	"help.lock":            "lock the vault",
+	"help.help":            "this help",
+	"help.search":          "search all records",
+	"help.lists":           "Lists",
+	"help.down_up":         "down, up",
+	"help.home_end":        "to the top, to the bottom",
+	"help.next_column":     "to the adjacent column",
+	"help.open":            "open record",
+	"help.copy_main":       "copy the main field",
+	"help.copy_login":      "copy login",
+	"help.copy_otp":        "copy one-time code",
+	"help.key_space":       "Space",
+	"help.select":          "select record",
+	"help.delete_selected": "delete selected records",
+	"help.form":            "Record form",
+	"help.reveal":          "show or hide secret",
+	"help.next_field":      "next field",
+	"help.search_section":  "Search",
+	"help.to_results":      "to results",
+	"help.edit":            "edit record",
+	"help.delete":          "delete record",
+	"help.edit_query":      "edit query",
+	"help.back":            "back",
+	"help.file_picker":     "File picker",
+	"help.picker_open":     "open directory or select file",
+	"help.picker_up":       "to the parent directory",
+	"help.cancel":          "cancel",
+
+	"strength.weak":             "weak",
+	"strength.medium":           "medium",
+	"strength.strong":           "strong",
+	"strength.very_strong":      "very strong",
+	"generator.no_char_classes": "no character set selected",
+	"generator.length_range":    "password length must be between %d and %d",
+	"generator.words_range":     "word count must be between %d and %d",
+	"pattern.common":            "common password",
+	"pattern.dictionary":        "dictionary word",
+	"pattern.repeat":            "repeated characters",
+	"pattern.sequence":          "sequence",
+	"pattern.keyboard":          "keyboard row",
+
+	"breach.unknown_format":  "unknown hash list format: expected HASH:COUNT lines",
+	"breach.not_sorted":      "lines are not sorted by hash",
+	"clipboard.unavailable":  "clipboard unavailable: install wl-clipboard, xclip or xsel",
+	"config.unknown_profile": "profile not found",
+	"config.no_profiles":     "configuration file has no profiles",
+	"config.no_file":         "%s (no file %s)",
+	"config.available":       "%s, available: %s",
+	"config.profile_arg":     "flag --profile requires a profile name",
+	"session.not_found":      "session not found, please log in",
+
+	"http.pin_mismatch": "server key does not match the pinned one",
+	"http.pin_expected": "expected %s, got %s",
+
+	"import_format.gophkeeper": "GophKeeper backup",
+	"import_format.keepass":    "KeePass (KDBX 4)",
+	"import_format.bitwarden":  "Bitwarden (unencrypted JSON)",
+	"import_format.1password":  "1Password (1PUX or CSV)",
+	"import_format.csv":        "Browser CSV (Chrome, Firefox)",
+	"import.unsupported_type":  "unsupported item type %d",
+	"import.line":              "line %d",
+	"import.no_credentials":    "no login and password",
+	"import.empty_entry":       "empty entry",
+
+	"column.problem":          "Problem",
+	"column.description":      "Description",
+	"column.login":            "Login",
+	"column.details":          "Details",
+	"column.type":             "Type",
+	"column.updated":          "Updated",
+	"column.expires":          "Expires",
+	"column.status":           "Status",
+	"column.folder":           "Folder",
+	"column.fields":           "Fields",
+	"column.breaches":         "Breaches",
+	"column.password_changed": "Password changed",
+	"column.days":             "Days",
+	"column.version":          "Version",
+	"column.commit":           "Commit",
+	"column.built":            "Built",
+	"cli.flag.min_entropy":    "passwords with lower entropy (bits) are weak",
+	"cli.flag.days":           "how many days a password may stay unchanged",
+	"cli.flag.json":           "print the report as JSON (same as -o json)",
+	"cli.audit.summary":       "Credentials checked: %d, no issues: %d%%",
+	"cli.audit.weak":          "weak",
+	"cli.audit.reused":        "reused",
+	"cli.audit.group":         "group %d of %d records",
+	"cli.audit.old":           "old",
+	"cli.audit.age":           "unchanged for %d days",
+
+	"cli.flag.login":         "user login",
+	"cli.password_for":       "Password for %s: ",
+	"cli.breach.no_file":     "no hash list: use the -file flag, breach_file in the configuration or BREACH_FILE",
+	"cli.flag.breach_file":   "HIBP hash list: a HASH:COUNT file or a directory of per-prefix files",
+	"cli.flag.breach_index":  "build the file index and exit",
+	"cli.breach.index_saved": "index saved to %s",
+	"cli.breach.no_index":    "no index, run breach -index -file %s to speed up lookups",
+	"cli.breach.summary":     "passwords checked: %d, found in breaches: %d (%s)",
+
+	"cli.empty_password":     "password is empty",
+	"cli.usage_error":        "invalid command arguments",
+	"cli.unknown_command":    "unknown command %q",
+	"cli.usage":              "Usage: gophkeeper [--profile name] [tui | <command> [flags]]",
+	"cli.usage_commands":     "Without a command the terminal UI (tui) starts. Commands:",
+	"cli.flag.output":        "output format: table or json",
+	"cli.unknown_output":     "unknown output format %q",
+	"cli.session_server":     "session belongs to server %s",
+	"command.login":          "log in and save the session",
+	"command.logout":         "end the session",
+	"command.list":           "list records [-type credentials]",
+	"command.get":            "show record <id> [-field password] [-otp] [-copy]",
+	"command.add":            "create a record -type credentials -set login=user -set password=-",
+	"command.edit":           "edit record <id> -set password=-",
+	"command.rm":             "delete records <id> [<id>...]",
+	"command.stale":          "credentials whose password has not changed for a long time [-days 90]",
+	"command.version":        "client and server version",
+	"command.audit":          "check passwords: weak, reused, old [-json]",
+	"command.breach":         "find passwords in a local breach list [-file pwned.txt] [-index]",
+	"command.generate":       "generate a password [-length 20] [-no-symbols] or a passphrase [-words 6]",
+	"command.export":         "export all records to an encrypted backup <file> [-format json|csv]",
+	"command.run":            "run a command with gk://<id>/<field> references in its environment [-env-file .env] -- <command>",
+	"command.inject":         "substitute {{ gk://... }} references in a template [-in .env.tpl] [-out .env]",
+	"command.import":         "import records from a backup, KeePass, Bitwarden, 1Password, CSV <file> [-format keepass] [-dry-run]",
+	"command.git-credential": "git credential helper: get, store, erase",
+	"command.ssh-agent":      "SSH agent with vault keys [-socket path] [<id>...]",
+
+	"cli.copied":           "%s copied to clipboard",
+	"cli.copied_no_clear":  "%s copied, but clipboard clearing is not scheduled",
+	"cli.copied_for":       "%s copied to clipboard and will be cleared in %s",
+	"cli.flag.clear_after": "when to clear the clipboard",
+
+	"cli.invalid_id":        "invalid record id %q",
+	"cli.invalid_pair":      "expected name=value, got %q",
+	"cli.flag.list_type":    "record type: %s",
+	"cli.flag.add_type":     "record type: %s",
+	"cli.flag.field":        "print only the field value",
+	"cli.flag.reveal":       "show secret fields",
+	"cli.flag.otp":          "print the current one-time code from the otp field",
+	"cli.flag.copy":         "copy a field (the main one by default, e.g. password) to the clipboard",
+	"cli.otp":               "one-time code",
+	"cli.flag.description":  "record description",
+	"cli.flag.set":          "value field name=value, value - is read from the terminal",
+	"cli.flag.custom_field": "custom field name=value",
+	"cli.flag.url":          "website address for credentials",
+	"cli.deleted":           "deleted",
+	"cli.not_found":         "not found",
+	"cli.not_found_count":   "records not found: %d",
+	"cli.unknown_type":      "unknown record type %q, available: %s",
+
+	"cli.export.canceled":    "unencrypted export canceled",
+	"cli.password_mismatch":  "passwords do not match",
+	"cli.flag.export_format": "format: backup (encrypted archive), json or csv (unencrypted)",
+	"cli.flag.yes":           "do not ask to confirm an unencrypted export",
+	"cli.export.confirm":     "Records will be saved to %s unencrypted. Continue? [y/N]: ",
+	"cli.unknown_format":     "unknown format %q",
+	"cli.export.done":        "records exported: %d",
+	"cli.export.password":    "Backup password: ",
+	"cli.export.repeat":      "Repeat password: ",
+	"cli.flag.length":        "password length",
+	"cli.flag.no_lower":      "no lowercase letters",
+	"cli.flag.no_upper":      "no uppercase letters",
+	"cli.flag.no_digits":     "no digits",
+	"cli.flag.no_symbols":    "no symbols",
+	"cli.flag.ambiguous":     "allow look-alike characters (l, 1, O, 0...)",
+	"cli.flag.words":         "generate a passphrase with the given number of words",
+	"cli.flag.separator":     "passphrase word separator",
+	"cli.flag.capitalize":    "capitalize passphrase words",
+	"cli.flag.number":        "add a digit to one of the passphrase words",
+	"cli.flag.generate_copy": "copy to the clipboard instead of printing",
+	"cli.entropy":            "entropy ≈ %.0f bits (%s)",
+	"cli.password":           "password",
+
+	"cli.flag.import_format":    "export format: %s (detected from the file by default)",
+	"cli.flag.dry_run":          "show records without creating them",
+	"cli.import.unknown_format": "unknown format %q, available: %s",
+	"cli.import.detect_failed":  "format not recognized, specify -format (%s)",
+	"cli.import.password":       "Export file password: ",
+	"cli.import.skipped":        "skipped record %q: %s",
+	"cli.import.preview":        "format %s: records to create: %d, skipped: %d",
+	"cli.import.empty":          "no records to import",
+	"cli.import.summary":        "records created: %d, already on the server: %d",
+	"cli.import.failed":         "failed to create records: %d",
+
+	"cli.run.exit_code":        "command exited with code %d",
+	"cli.flag.no_masking":      "do not mask secret values in the command output",
+	"cli.flag.env_file":        ".env file with gk:// references, may be repeated",
+	"cli.flag.in":              "template file, - for stdin",
+	"cli.flag.out":             "output file (created with mode 0600), - for stdout",
+	"cli.flag.socket":          "agent Unix socket path",
+	"cli.ssh.skipped":          "key %d skipped: %v",
+	"cli.ssh.no_keys":          "no SSH keys for the agent",
+	"cli.ssh.started":          "agent started, keys: %d",
+	"cli.version.client":       "client",
+	"cli.version.server":       "server",
+	"cli.version.incompatible": "server API version v%d is incompatible with the client (v%d)",
+}
@@ -0,0 +1,395 @@
+package i18n
+
+// ru - сообщения на русском языке
+var ru = map[string]string{
+	"error.title":            "Ошибка",
+	"button.ok":              "Понятно",
+	"data.title":             "Подробно",
+	"data.title_secret":      "Подробно (Ctrl+R - показать или скрыть секрет)",
+	"field.id":               "Идентификатор",
+	"field.type":             "Тип",
+	"field.description":      "Описание",
+	"button.update":          "Изменить",
+	"button.delete":          "Удалить",
+	"field.login":            "Логин",
+	"field.password":         "Пароль",
+	"field.text":             "Текст",
+	"field.file":             "Файл",
+	"field.preview":          "Просмотр",
+	"binary.unreadable":      "не удалось прочитать содержимое",
+	"field.data":             "Данные",
+	"button.save_to":         "Сохранить в…",
+	"button.load_file":       "Загрузить файл…",
+	"field.card_number":      "Номер карты",
+	"field.card_date":        "Срок действия",
+	"field.card_secure":      "Секретный код",
+	"ssh.fingerprint_failed": "не удалось вычислить",
+	"field.fingerprint":      "Отпечаток",
+	"field.private_key":      "Закрытый ключ",
+	"field.public_key":       "Открытый ключ",
+	"field.passphrase":       "Пароль ключа",
+	"field.document_kind":    "Вид документа",
+	"field.number":           "Номер",
+	"field.full_name":        "ФИО",
+	"field.issued_by":        "Кем выдан",
+	"field.issue_date":       "Дата выдачи",
+	"field.valid_until":      "Действителен до",
+	"field.ssid":             "Сеть (SSID)",
+	"field.security":         "Защита",
+	"field.wifi_uri":         "Строка подключения",
+	"field.provider":         "Сервис",
+	"field.key_id":           "Идентификатор ключа",
+	"field.secret":           "Секрет",
+	"field.endpoint":         "Адрес API",
+	"button.login":           "Авторизоваться",
+	"button.to_register":     "Перейти к регистрации",
+	"button.quit":            "Закончить",
+	"button.register":        "Зарегистрироваться",
+	"button.to_login":        "Перейти к авторизации",
+	"data.types":             "Типы данных",
+	"button.create":          "Создать",
+	"button.audit":           "Аудит",
+	"data.storage":           "Хранилище",
+	"data.records":           "Записи",
+	"create.type":            "Выберите тип данных: ",
+	"create.title":           "Создание записи",
+	"field.rotate_at":        "Сменить до",
+	"field.expires_at":       "Истекает",
+	"button.save":            "Сохранить",
+	"binary.none":            "не выбран",
+	"button.choose_file":     "Выбрать файл…",
+
+	"type.credentials":          "Учетные данные",
+	"type.text":                 "Текстовые данные",
+	"type.binary":               "Бинарные данные",
+	"type.card":                 "Данные банковских карт",
+	"type.ssh":                  "SSH ключи",
+	"type.identity":             "Документы",
+	"type.note":                 "Заметки",
+	"type.wifi":                 "Wi-Fi",
+	"type.apikey":               "API ключи",
+	"auth.invalid_form":         "Необходимо ввести корректные логин и пароль",
+	"data.delete_failed":        "Не удалось удалить записей: %d",
+	"error.bad_request":         "некорректный запрос",
+	"error.validation_failed":   "проверьте поля",
+	"error.unauthorized":        "пользователь не авторизован",
+	"error.invalid_credentials": "неправильные имя пользователя и пароль",
+	"error.user_exists":         "пользователь существует",
+	"error.forbidden":           "доступ запрещен",
+	"error.not_found":           "запись не найдена",
+	"error.item_too_large":      "запись слишком большая",
+	"error.quota_exceeded":      "превышена квота хранилища",
+	"error.internal":            "попробуйте позже",
+	"error.batch_rolled_back":   "пакет операций отменен",
+	"error.request_id":          "(запрос %s)",
+	"http.request_failed":       "Не удалось выполнить запрос",
+	"http.parse_failed":         "Не удалось разобрать ответ",
+	"http.login_failed":         "Не удалось авторизоваться",
+	"http.refresh_failed":       "Не удалось обновить сессию",
+	"http.register_failed":      "Не удалось зарегистрироваться",
+	"http.version_failed":       "Не удалось получить версию сервера",
+	"http.usage_failed":         "Не удалось получить квоту",
+	"http.list_failed":          "Не удалось получить данные",
+	"http.get_failed":           "Не удалось получить запись",
+	"http.create_failed":        "Не удалось создать запись",
+	"http.update_failed":        "Не удалось изменить запись",
+	"http.batch_failed":         "Не удалось выполнить пакет",
+	"http.delete_failed":        "Не удалось удалить запись",
+	"http.tls_over_http":        "pin_sha256 и client_cert работают только с адресом сервера https://",
+
+	"validation.required":        "обязательное поле",
+	"validation.required_unless": "обязательное поле",
+	"validation.min":             "слишком короткое или маленькое значение",
+	"validation.max":             "слишком длинное или большое значение",
+	"validation.alphanum":        "только буквы и цифры",
+	"validation.oneof":           "недопустимое значение",
+
+	"audit.title":      "Аудит безопасности (Esc - назад)",
+	"audit.summary":    "Проверено учетных данных: %d\nБез замечаний: [%s::b]%d%%[-::-]\n",
+	"audit.weak":       "Слабые пароли (%d)",
+	"strength.entropy": "%.0f бит, %s",
+	"audit.reused":     "Повторяющиеся пароли (%d)",
+	"audit.group":      "Группа %d:",
+	"audit.old":        "Давно не менялись (%d)",
+	"audit.days":       "%d дн.",
+	"button.back":      "Назад",
+	"button.cancel":    "Отмена",
+	"button.add":       "Добавить",
+
+	"binary.unnamed":           "без имени",
+	"binary.preview_too_large": "файл больше %s, просмотр недоступен",
+	"field.breach":             "Утечки",
+	"breach.found":             "пароль найден в утечках %d раз, смените его",
+	"clipboard.no_field":       "в записи нет поля %s",
+	"clipboard.no_otp":         "нет одноразового кода",
+	"clipboard.otp":            "код",
+	"clipboard.copied_for":     "%s скопировано на %s",
+	"clipboard.copied":         "%s скопировано",
+	"search.title":             "Найдено",
+	"date.placeholder":         "ГГГГ-ММ-ДД",
+	"data.expired":             "(истекла)",
+	"data.expiring":            "(истекает: %d)",
+	"data.records_selected":    "Записи (выбрано: %d, Delete - удалить)",
+	"data.delete_selected":     "Удалить выбранные записи (%d)?",
+
+	"field_type.text":       "Текст",
+	"field_type.hidden":     "Скрытое",
+	"field_type.url":        "URL",
+	"field_type.date":       "Дата",
+	"field_type.boolean":    "Флаг",
+	"url_match.domain":      "Домен",
+	"url_match.host":        "Хост",
+	"url_match.starts_with": "Начинается с",
+	"url_match.exact":       "Точное совпадение",
+	"url_match.regexp":      "Регулярное выражение",
+	"url_match.never":       "Никогда",
+	"field.updated_at":      "Изменено",
+	"field.url_match":       "Сравнение",
+	"field.name":            "Название",
+	"field.value":           "Значение",
+	"field.field":           "Поле",
+	"field.new_title":       "Новое поле",
+	"field.remove_title":    "Удаление поля",
+	"field.new_url_title":   "Новый URL",
+	"button.add_field":      "Добавить поле",
+	"button.remove_field":   "Удалить поле",
+	"button.add_url":        "Добавить URL",
+
+	"generator.title":             "Генератор паролей",
+	"generator.kind":              "Вид",
+	"generator.password":          "Пароль",
+	"generator.passphrase":        "Парольная фраза",
+	"generator.length":            "Длина пароля",
+	"generator.lower":             "Строчные a-z",
+	"generator.upper":             "Заглавные A-Z",
+	"generator.digits":            "Цифры 0-9",
+	"generator.symbols":           "Спецсимволы",
+	"generator.exclude_ambiguous": "Без похожих (l1O0)",
+	"generator.words":             "Слов во фразе",
+	"generator.separator":         "Разделитель",
+	"generator.capitalize":        "С заглавной буквы",
+	"generator.number":            "Добавить цифру",
+	"generator.result":            "Результат",
+	"generator.entropy":           "Энтропия",
+	"button.generate":             "Сгенерировать",
+	"button.use":                  "Использовать",
+	"button.refresh":              "Обновить",
+
+	"size.bytes":           "%d Б",
+	"size.scaled":          "%.1f %cБ",
+	"size.prefixes":        "КМГТ",
+	"usage.of":             "%s из %s",
+	"usage.summary":        "Записей: %s\nОбъем: %s",
+	"version.incompatible": "(сервер %s: API v%d, клиент поддерживает v%d)",
+	"field.profile":        "Профиль",
+	"ssh.confirm_sign":     "Разрешить подпись SSH ключом?\n%s\n%s",
+	"button.allow":         "Разрешить",
+	"button.deny":          "Отклонить",
+	"search.found":         "Найдено: %d",
+	"search.hint":          "Enter - открыть, e - изменить, c/u/o - копировать, d - удалить, j/k - вниз/вверх, / - запрос, ? - клавиши, Esc - назад",
+	"data.delete_one":      "Удалить запись %q?",
+	"picker.file_name":     "Имя файла ",
+	"picker.overwrite":     "Файл %s уже существует. Заменить?",
+	"picker.open_title":    "Выбор файла до %s (Enter - выбрать, Backspace - выше, Esc - отмена)",
+	"picker.save_title":    "Сохранить в… (Enter - открыть каталог или выбрать имя)",
+	"picker.saved":         "сохранено в %s",
+	"button.replace":       "Заменить",
+
+	"help.title":           "Горячие клавиши (Esc - закрыть)",
+	"help.everywhere":      "Везде",
+	"help.lock":            "заблокировать хранилище",
+	"help.help":            "эта справка",
+	"help.search":          "поиск по всем записям",
+	"help.lists":           "Списки",
+	"help.down_up":         "вниз, вверх",
+	"help.home_end":        "в начало, в конец",
+	"help.next_column":     "к соседней колонке",
+	"help.open":            "открыть запись",
+	"help.copy_main":       "копировать основное поле",
+	"help.copy_login":      "копировать логин",
+	"help.copy_otp":        "копировать одноразовый код",
+	"help.key_space":       "Пробел",
+	"help.select":          "отметить запись",
+	"help.delete_selected": "удалить отмеченные записи",
+	"help.form":            "Форма записи",
+	"help.reveal":          "показать или скрыть секрет",
+	"help.next_field":      "следующее поле",
+	"help.search_section":  "Поиск",
+	"help.to_results":      "к результатам",
+	"help.edit":            "изменить запись",
+	"help.delete":          "удалить запись",
+	"help.edit_query":      "изменить запрос",
+	"help.back":            "назад",
+	"help.file_picker":     "Выбор файла",
+	"help.picker_open":     "открыть каталог или выбрать файл",
+	"help.picker_up":       "в родительский каталог",
+	"help.cancel":          "отмена",
+
+	"strength.weak":             "слабый",
+	"strength.medium":           "средний",
+	"strength.strong":           "сильный",
+	"strength.very_strong":      "очень сильный",
+	"generator.no_char_classes": "не выбран ни один набор символов",
+	"generator.length_range":    "длина пароля должна быть от %d до %d",
+	"generator.words_range":     "количество слов должно быть от %d до %d",
+	"pattern.common":            "распространенный пароль",
+	"pattern.dictionary":        "словарное слово",
+	"pattern.repeat":            "повтор символов",
+	"pattern.sequence":          "последовательность",
+	"pattern.keyboard":          "ряд клавиатуры",
+
+	"breach.unknown_format":  "неизвестный формат списка хешей: ожидаются строки HASH:COUNT",
+	"breach.not_sorted":      "строки не отсортированы по хешу",
+	"clipboard.unavailable":  "буфер обмена недоступен: установите wl-clipboard, xclip или xsel",
+	"config.unknown_profile": "профиль не найден",
+	"config.no_profiles":     "в файле конфигурации нет профилей",
+	"config.no_file":         "%s (нет файла %s)",
+	"config.available":       "%s, доступны: %s",
+	"config.profile_arg":     "флаг --profile требует имя профиля",
+	"session.not_found":      "сессия не найдена, выполните вход",
+
+	"http.pin_mismatch": "ключ сервера не совпадает с закрепленным",
+	"http.pin_expected": "ожидался %s, получен %s",
+
+	"import_format.gophkeeper": "Резервная копия GophKeeper",
+	"import_format.keepass":    "KeePass (KDBX 4)",
+	"import_format.bitwarden":  "Bitwarden (JSON без шифрования)",
+	"import_format.1password":  "1Password (1PUX или CSV)",
+	"import_format.csv":        "CSV браузера (Chrome, Firefox)",
+	"import.unsupported_type":  "неподдерживаемый тип записи %d",
+	"import.line":              "строка %d",
+	"import.no_credentials":    "нет логина и пароля",
+	"import.empty_entry":       "пустая запись",
+
+	"column.problem":          "Проблема",
+	"column.description":      "Описание",
+	"column.login":            "Логин",
+	"column.details":          "Подробности",
+	"column.type":             "Тип",
+	"column.updated":          "Изменено",
+	"column.expires":          "Истекает",
+	"column.status":           "Статус",
+	"column.folder":           "Папка",
+	"column.fields":           "Полей",
+	"column.breaches":         "Утечек",
+	"column.password_changed": "Пароль изменен",
+	"column.days":             "Дней",
+	"column.version":          "Версия",
+	"column.commit":           "Коммит",
+	"column.built":            "Собран",
+	"cli.flag.min_entropy":    "пароли с меньшей энтропией (бит) считаются слабыми",
+	"cli.flag.days":           "сколько дней пароль может не меняться",
+	"cli.flag.json":           "вывести отчет в формате JSON (то же, что -o json)",
+	"cli.audit.summary":       "Проверено учетных данных: %d, без замечаний: %d%%",
+	"cli.audit.weak":          "слабый",
+	"cli.audit.reused":        "повтор",
+	"cli.audit.group":         "группа %d из %d записей",
+	"cli.audit.old":           "старый",
+	"cli.audit.age":           "не менялся %d дн.",
+
+	"cli.flag.login":         "логин пользователя",
+	"cli.password_for":       "Пароль для %s: ",
+	"cli.breach.no_file":     "не указан список хешей: флаг -file, breach_file в конфигурации или BREACH_FILE",
+	"cli.flag.breach_file":   "список хешей HIBP: файл HASH:COUNT или каталог с файлами по префиксам",
+	"cli.flag.breach_index":  "построить индекс файла и выйти",
+	"cli.breach.index_saved": "индекс сохранен в %s",
+	"cli.breach.no_index":    "индекс не построен, для ускорения выполните breach -index -file %s",
+	"cli.breach.summary":     "проверено паролей: %d, найдено в утечках: %d (%s)",
+
+	"cli.empty_password":     "пароль не указан",
+	"cli.usage_error":        "неверные аргументы команды",
+	"cli.unknown_command":    "неизвестная команда %q",
+	"cli.usage":              "Использование: gophkeeper [--profile имя] [tui | <команда> [флаги]]",
+	"cli.usage_commands":     "Без команды запускается консольный интерфейс (tui). Команды:",
+	"cli.flag.output":        "формат вывода: table или json",
+	"cli.unknown_output":     "неизвестный формат вывода %q",
+	"cli.session_server":     "сессия открыта на сервере %s",
+	"command.login":          "войти и сохранить сессию",
+	"command.logout":         "завершить сессию",
+	"command.list":           "список записей [-type credentials]",
+	"command.get":            "показать запись <id> [-field password] [-otp] [-copy]",
+	"command.add":            "создать запись -type credentials -set login=user -set password=-",
+	"command.edit":           "изменить запись <id> -set password=-",
+	"command.rm":             "удалить записи <id> [<id>...]",
+	"command.stale":          "учетные данные, пароль которых давно не менялся [-days 90]",
+	"command.version":        "версия клиента и сервера",
+	"command.audit":          "проверить пароли: слабые, повторяющиеся, старые [-json]",
+	"command.breach":         "найти пароли в локальном списке утечек [-file pwned.txt] [-index]",
+	"command.generate":       "сгенерировать пароль [-length 20] [-no-symbols] или фразу [-words 6]",
+	"command.export":         "выгрузить все записи в зашифрованную резервную копию <file> [-format json|csv]",
+	"command.run":            "запустить команду со ссылками gk://<id>/<поле> в окружении [-env-file .env] -- <команда>",
+	"command.inject":         "подставить значения ссылок {{ gk://... }} в шаблон [-in .env.tpl] [-out .env]",
+	"command.import":         "импортировать записи из резервной копии, KeePass, Bitwarden, 1Password, CSV <file> [-format keepass] [-dry-run]",
+	"command.git-credential": "git credential helper: get, store, erase",
+	"command.ssh-agent":      "SSH агент с ключами хранилища [-socket path] [<id>...]",
+
+	"cli.copied":           "%s скопировано в буфер обмена",
+	"cli.copied_no_clear":  "%s скопировано, но очистка буфера не запланирована",
+	"cli.copied_for":       "%s скопировано в буфер обмена и будет удалено через %s",
+	"cli.flag.clear_after": "через сколько очистить буфер",
+
+	"cli.invalid_id":        "неверный идентификатор записи %q",
+	"cli.invalid_pair":      "ожидается name=value, получено %q",
+	"cli.flag.list_type":    "тип записей: %s",
+	"cli.flag.add_type":     "тип записи: %s",
+	"cli.flag.field":        "вывести только значение поля",
+	"cli.flag.reveal":       "показать секретные поля",
+	"cli.flag.otp":          "вывести текущий одноразовый код из поля otp",
+	"cli.flag.copy":         "скопировать поле (по умолчанию основное, например пароль) в буфер обмена",
+	"cli.otp":               "одноразовый код",
+	"cli.flag.description":  "описание записи",
+	"cli.flag.set":          "поле значения name=value, значение - запрашивается с терминала",
+	"cli.flag.custom_field": "пользовательское поле name=value",
+	"cli.flag.url":          "адрес сайта для учетных данных",
+	"cli.deleted":           "удалена",
+	"cli.not_found":         "не найдена",
+	"cli.not_found_count":   "не найдено записей: %d",
+	"cli.unknown_type":      "неизвестный тип записи %q, доступны: %s",
+
+	"cli.export.canceled":    "выгрузка без шифрования отменена",
+	"cli.password_mismatch":  "пароли не совпадают",
+	"cli.flag.export_format": "формат: backup (зашифрованный архив), json или csv (без шифрования)",
+	"cli.flag.yes":           "не спрашивать подтверждение выгрузки без шифрования",
+	"cli.export.confirm":     "Записи будут сохранены в %s без шифрования. Продолжить? [y/N]: ",
+	"cli.unknown_format":     "неизвестный формат %q",
+	"cli.export.done":        "выгружено записей: %d",
+	"cli.export.password":    "Пароль резервной копии: ",
+	"cli.export.repeat":      "Повторите пароль: ",
+	"cli.flag.length":        "длина пароля",
+	"cli.flag.no_lower":      "без строчных букв",
+	"cli.flag.no_upper":      "без заглавных букв",
+	"cli.flag.no_digits":     "без цифр",
+	"cli.flag.no_symbols":    "без спецсимволов",
+	"cli.flag.ambiguous":     "разрешить похожие символы (l, 1, O, 0...)",
+	"cli.flag.words":         "сгенерировать парольную фразу из указанного количества слов",
+	"cli.flag.separator":     "разделитель слов фразы",
+	"cli.flag.capitalize":    "слова фразы с заглавной буквы",
+	"cli.flag.number":        "добавить цифру к одному из слов фразы",
+	"cli.flag.generate_copy": "скопировать в буфер обмена вместо вывода",
+	"cli.entropy":            "энтропия ≈ %.0f бит (%s)",
+	"cli.password":           "пароль",
+
+	"cli.flag.import_format":    "формат экспорта: %s (по умолчанию определяется по файлу)",
+	"cli.flag.dry_run":          "показать записи без создания",
+	"cli.import.unknown_format": "неизвестный формат %q, доступны: %s",
+	"cli.import.detect_failed":  "формат не распознан, укажите -format (%s)",
+	"cli.import.password":       "Пароль файла экспорта: ",
+	"cli.import.skipped":        "пропущена запись %q: %s",
+	"cli.import.preview":        "формат %s: будет создано записей: %d, пропущено: %d",
+	"cli.import.empty":          "нет записей для импорта",
+	"cli.import.summary":        "создано записей: %d, уже были на сервере: %d",
+	"cli.import.failed":         "не удалось создать записей: %d",
+
+	"cli.run.exit_code":        "команда завершилась с кодом %d",
+	"cli.flag.no_masking":      "не скрывать значения секретов в выводе команды",
+	"cli.flag.env_file":        "файл переменных .env со ссылками gk://, можно указать несколько раз",
+	"cli.flag.in":              "файл шаблона, - для stdin",
+	"cli.flag.out":             "файл результата (создается с правами 0600), - для stdout",
+	"cli.flag.socket":          "путь к Unix сокету агента",
+	"cli.ssh.skipped":          "ключ %d пропущен: %v",
+	"cli.ssh.no_keys":          "нет SSH ключей для агента",
+	"cli.ssh.started":          "агент запущен, ключей: %d",
+	"cli.version.client":       "клиент",
+	"cli.version.server":       "сервер",
+	"cli.version.incompatible": "версия API сервера v%d несовместима с клиентом (v%d)",
+}
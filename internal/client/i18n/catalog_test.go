@@ -0,0 +1,71 @@
+package i18n
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCatalogKeys(t *testing.T) {
+	for locale, catalog := range catalogs {
+		assert.Len(t, catalog, len(ru), locale)
+		for key := range ru {
+			assert.Contains(t, catalog, key, locale)
+		}
+	}
+}
+
+// dataVars - переменные с данными на русском языке, а не с сообщениями: словари для оценки паролей
+var dataVars = map[string]bool{
+	"commonPasswords": true,
+	"keyboardRows":    true,
+}
+
+// TestNoCyrillicLiterals - все сообщения клиента должны быть в каталогах, комментарии не проверяются
+func TestNoCyrillicLiterals(t *testing.T) {
+	for _, root := range []string{"..", filepath.Join("..", "..", "..", "cmd", "client")} {
+		err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return err
+			}
+			name := entry.Name()
+			if !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || strings.HasPrefix(name, "catalog-") {
+				return nil
+			}
+
+			fset := token.NewFileSet()
+			file, err := parser.ParseFile(fset, path, nil, 0)
+			if err != nil {
+				return err
+			}
+			ast.Inspect(file, func(node ast.Node) bool {
+				switch node := node.(type) {
+				case *ast.ValueSpec:
+					for _, ident := range node.Names {
+						if dataVars[ident.Name] {
+							return false
+						}
+					}
+				case *ast.BasicLit:
+					if node.Kind == token.STRING && strings.ContainsFunc(node.Value, func(r rune) bool {
+						return unicode.Is(unicode.Cyrillic, r)
+					}) {
+						t.Errorf("%s: строка не из каталога: %s", fset.Position(node.Pos()), node.Value)
+					}
+				}
+				return true
+			})
+
+			return nil
+		})
+		require.NoError(t, err)
+	}
+}
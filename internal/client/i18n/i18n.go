@@ -0,0 +1,116 @@
+// Package i18n содержит каталоги сообщений клиента и выбор языка
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// Locale - язык сообщений
+type Locale string
+
+const (
+	Russian Locale = "ru"
+	English Locale = "en"
+)
+
+// DefaultLocale - язык, если он не задан в конфигурации и окружении
+const DefaultLocale = Russian
+
+// catalogs - сообщения по языкам, ключ - идентификатор сообщения
+var catalogs = map[Locale]map[string]string{
+	Russian: ru,
+	English: en,
+}
+
+var current atomic.Value
+
+// SetLocale - выбрать язык сообщений
+func SetLocale(locale Locale) {
+	current.Store(locale)
+}
+
+// CurrentLocale - выбранный язык сообщений
+func CurrentLocale() Locale {
+	if locale, ok := current.Load().(Locale); ok {
+		return locale
+	}
+
+	return DefaultLocale
+}
+
+// ParseLocale - язык по значению вида "en", "en_US.UTF-8" или "ru-RU"
+func ParseLocale(value string) (Locale, bool) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	language, _, _ := strings.Cut(value, ".")
+	language, _, _ = strings.Cut(language, "_")
+	language, _, _ = strings.Cut(language, "-")
+
+	locale := Locale(language)
+	if _, ok := catalogs[locale]; !ok {
+		return DefaultLocale, false
+	}
+
+	return locale, true
+}
+
+// Detect - язык из конфигурации, а если он не задан - из LC_ALL, LC_MESSAGES или LANG
+func Detect(configured string) Locale {
+	for _, value := range []string{configured, os.Getenv("LC_ALL"), os.Getenv("LC_MESSAGES"), os.Getenv("LANG")} {
+		if value == "" {
+			continue
+		}
+		if locale, ok := ParseLocale(value); ok {
+			return locale
+		}
+	}
+
+	return DefaultLocale
+}
+
+// T - сообщение key на выбранном языке. С аргументами сообщение является форматом fmt.
+// Если перевода нет, используется русский текст, а если нет и его - сам ключ.
+func T(key string, args ...any) string {
+	message, ok := catalogs[CurrentLocale()][key]
+	if !ok {
+		message, ok = catalogs[DefaultLocale][key]
+	}
+	if !ok {
+		message = key
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(message, args...)
+	}
+
+	return message
+}
+
+// Error - ошибка, текст которой переводится на язык, выбранный в момент вывода
+type Error struct {
+	key  string
+	args []any
+}
+
+// NewError - ошибка с сообщением key, args подставляются в сообщение как в T
+func NewError(key string, args ...any) *Error {
+	return &Error{key: key, args: args}
+}
+
+// CodeError - ошибка с текстом для кода ошибки API
+func CodeError(code models.ErrorCode) *Error {
+	return NewError(CodeKey(code))
+}
+
+// CodeKey - ключ сообщения для кода ошибки API
+func CodeKey(code models.ErrorCode) string {
+	return "error." + string(code)
+}
+
+// Error - текст ошибки
+func (e *Error) Error() string {
+	return T(e.key, e.args...)
+}
@@ -0,0 +1,110 @@
+package i18n_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLocale(t *testing.T) {
+	for value, want := range map[string]i18n.Locale{
+		"en":          i18n.English,
+		"en_US.UTF-8": i18n.English,
+		"EN-gb":       i18n.English,
+		"ru_RU.UTF-8": i18n.Russian,
+	} {
+		locale, ok := i18n.ParseLocale(value)
+		assert.True(t, ok, value)
+		assert.Equal(t, want, locale, value)
+	}
+
+	for _, value := range []string{"", "C", "POSIX", "de_DE.UTF-8"} {
+		locale, ok := i18n.ParseLocale(value)
+		assert.False(t, ok, value)
+		assert.Equal(t, i18n.DefaultLocale, locale, value)
+	}
+}
+
+func TestDetect(t *testing.T) {
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_MESSAGES", "C")
+	t.Setenv("LANG", "en_US.UTF-8")
+
+	assert.Equal(t, i18n.English, i18n.Detect(""))
+	assert.Equal(t, i18n.Russian, i18n.Detect("ru"))
+
+	t.Setenv("LC_ALL", "ru_RU.UTF-8")
+	assert.Equal(t, i18n.Russian, i18n.Detect(""))
+
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LANG", "")
+	assert.Equal(t, i18n.DefaultLocale, i18n.Detect(""))
+}
+
+func TestT(t *testing.T) {
+	t.Cleanup(func() {
+		i18n.SetLocale(i18n.DefaultLocale)
+	})
+
+	i18n.SetLocale(i18n.English)
+	assert.Equal(t, "Login", i18n.T("field.login"))
+	assert.Equal(t, "Failed to delete records: 2", i18n.T("data.delete_failed", 2))
+	assert.Equal(t, "unknown.key", i18n.T("unknown.key"))
+
+	i18n.SetLocale(i18n.Russian)
+	assert.Equal(t, "Логин", i18n.T("field.login"))
+}
+
+func TestCodeError(t *testing.T) {
+	t.Cleanup(func() {
+		i18n.SetLocale(i18n.DefaultLocale)
+	})
+
+	errUserExists := i18n.CodeError(models.ErrorCodeUserExists)
+	wrapped := fmt.Errorf("register: %w", errUserExists)
+	assert.True(t, errors.Is(wrapped, errUserExists))
+	assert.Equal(t, "пользователь существует", errUserExists.Error())
+
+	// Текст ошибки переводится при выводе, а не при создании
+	i18n.SetLocale(i18n.English)
+	assert.Equal(t, "user already exists", errUserExists.Error())
+}
+
+func TestNewErrorArgs(t *testing.T) {
+	t.Cleanup(func() {
+		i18n.SetLocale(i18n.DefaultLocale)
+	})
+
+	err := i18n.NewError("data.delete_failed", 2)
+	assert.Equal(t, "Не удалось удалить записей: 2", err.Error())
+
+	i18n.SetLocale(i18n.English)
+	assert.Equal(t, "Failed to delete records: 2", err.Error())
+}
+
+func TestCatalogs(t *testing.T) {
+	codes := []models.ErrorCode{
+		models.ErrorCodeBadRequest,
+		models.ErrorCodeValidation,
+		models.ErrorCodeUnauthorized,
+		models.ErrorCodeInvalidCredentials,
+		models.ErrorCodeUserExists,
+		models.ErrorCodeForbidden,
+		models.ErrorCodeNotFound,
+		models.ErrorCodeItemTooLarge,
+		models.ErrorCodeQuotaExceeded,
+		models.ErrorCodeInternal,
+	}
+
+	for _, locale := range []i18n.Locale{i18n.Russian, i18n.English} {
+		i18n.SetLocale(locale)
+		for _, code := range codes {
+			assert.NotEqual(t, i18n.CodeKey(code), i18n.T(i18n.CodeKey(code)), "%s: %s", locale, code)
+		}
+	}
+	i18n.SetLocale(i18n.DefaultLocale)
+}
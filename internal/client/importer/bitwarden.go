@@ -0,0 +1,249 @@
+package importer
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// ErrEncryptedExport - экспорт Bitwarden зашифрован, нужен экспорт в JSON без шифрования
+var ErrEncryptedExport = errors.New("encrypted bitwarden export is not supported")
+
+// Типы записей Bitwarden
+const (
+	bitwardenLogin      = 1
+	bitwardenSecureNote = 2
+	bitwardenCard       = 3
+	bitwardenIdentity   = 4
+	bitwardenSSHKey     = 5
+)
+
+// Типы пользовательских полей Bitwarden
+const (
+	bitwardenFieldText    = 0
+	bitwardenFieldHidden  = 1
+	bitwardenFieldBoolean = 2
+)
+
+type bitwardenExport struct {
+	Encrypted bool `json:"encrypted"`
+	Folders   []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"folders"`
+	Items []bitwardenItem `json:"items"`
+}
+
+type bitwardenItem struct {
+	Type     int    `json:"type"`
+	Name     string `json:"name"`
+	Notes    string `json:"notes"`
+	FolderID string `json:"folderId"`
+	Fields   []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+		Type  int    `json:"type"`
+	} `json:"fields"`
+	Login *struct {
+		URIs []struct {
+			URI   string `json:"uri"`
+			Match *int   `json:"match"`
+		} `json:"uris"`
+		Username string `json:"username"`
+		Password string `json:"password"`
+		TOTP     string `json:"totp"`
+	} `json:"login"`
+	Card *struct {
+		CardholderName string `json:"cardholderName"`
+		Brand          string `json:"brand"`
+		Number         string `json:"number"`
+		ExpMonth       string `json:"expMonth"`
+		ExpYear        string `json:"expYear"`
+		Code           string `json:"code"`
+	} `json:"card"`
+	Identity *struct {
+		Title          string `json:"title"`
+		FirstName      string `json:"firstName"`
+		MiddleName     string `json:"middleName"`
+		LastName       string `json:"lastName"`
+		Email          string `json:"email"`
+		Phone          string `json:"phone"`
+		Company        string `json:"company"`
+		Username       string `json:"username"`
+		SSN            string `json:"ssn"`
+		PassportNumber string `json:"passportNumber"`
+		LicenseNumber  string `json:"licenseNumber"`
+		Address1       string `json:"address1"`
+		Address2       string `json:"address2"`
+		Address3       string `json:"address3"`
+		City           string `json:"city"`
+		State          string `json:"state"`
+		PostalCode     string `json:"postalCode"`
+		Country        string `json:"country"`
+	} `json:"identity"`
+	SSHKey *struct {
+		PrivateKey string `json:"privateKey"`
+		PublicKey  string `json:"publicKey"`
+	} `json:"sshKey"`
+}
+
+// parseBitwarden - разобрать экспорт Bitwarden в JSON
+func parseBitwarden(content []byte, _ Options) (*Result, error) {
+	var export bitwardenExport
+	if err := json.Unmarshal(content, &export); err != nil {
+		return nil, err
+	}
+	if export.Encrypted {
+		return nil, ErrEncryptedExport
+	}
+
+	folders := make(map[string]string, len(export.Folders))
+	for _, folder := range export.Folders {
+		folders[folder.ID] = folder.Name
+	}
+
+	result := &Result{}
+	for _, bwItem := range export.Items {
+		item, ok, err := bitwardenToItem(bwItem)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			result.Skipped = append(result.Skipped, Skipped{
+				Name:   bwItem.Name,
+				Reason: i18n.T("import.unsupported_type", bwItem.Type),
+			})
+			continue
+		}
+
+		for _, field := range bwItem.Fields {
+			switch field.Type {
+			case bitwardenFieldText:
+				item.addField(field.Name, field.Value, models.FieldTypeText)
+			case bitwardenFieldHidden:
+				item.addField(field.Name, field.Value, models.FieldTypeHidden)
+			case bitwardenFieldBoolean:
+				checked, _ := strconv.ParseBool(field.Value)
+				item.addField(field.Name, strconv.FormatBool(checked), models.FieldTypeBoolean)
+			}
+		}
+		if item.Data.Type != models.DataTypeNote {
+			item.addField(FieldNotes, bwItem.Notes, models.FieldTypeText)
+		}
+		item.setFolder(folders[bwItem.FolderID])
+
+		result.Items = append(result.Items, item)
+	}
+
+	return result, nil
+}
+
+// bitwardenToItem - преобразовать запись Bitwarden в запись нужного типа
+func bitwardenToItem(bwItem bitwardenItem) (Item, bool, error) {
+	var (
+		item Item
+		err  error
+	)
+
+	switch {
+	case bwItem.Type == bitwardenLogin && bwItem.Login != nil:
+		login := bwItem.Login
+		item, err = newItem(models.DataTypeCredentials, bwItem.Name, &models.CredentialsValue{
+			Login:    login.Username,
+			Password: login.Password,
+		})
+		if err != nil {
+			return item, false, err
+		}
+		// Правила сопоставления Bitwarden совпадают с models.URLMatch
+		for _, uri := range login.URIs {
+			match := models.URLMatchDomain
+			if uri.Match != nil && *uri.Match >= int(models.URLMatchDomain) && *uri.Match <= int(models.URLMatchNever) {
+				match = models.URLMatch(*uri.Match)
+			}
+			item.addURL(uri.URI, match)
+		}
+		item.addField(FieldOTP, login.TOTP, models.FieldTypeHidden)
+	case bwItem.Type == bitwardenSecureNote:
+		item, err = newItem(models.DataTypeNote, bwItem.Name, &models.NoteValue{Markdown: bwItem.Notes})
+	case bwItem.Type == bitwardenCard && bwItem.Card != nil:
+		card := bwItem.Card
+		item, err = newItem(models.DataTypeBankCard, bwItem.Name, &models.BankCardValue{
+			Number: card.Number,
+			Date:   cardDate(card.ExpMonth, card.ExpYear),
+			Secure: card.Code,
+		})
+		item.addField("cardholder", card.CardholderName, models.FieldTypeText)
+		item.addField("brand", card.Brand, models.FieldTypeText)
+	case bwItem.Type == bitwardenIdentity && bwItem.Identity != nil:
+		identity := bwItem.Identity
+		value := &models.IdentityValue{
+			FullName: joinNonEmpty(" ", identity.FirstName, identity.MiddleName, identity.LastName),
+		}
+		switch {
+		case identity.PassportNumber != "":
+			value.Kind, value.Number = "passport", identity.PassportNumber
+		case identity.LicenseNumber != "":
+			value.Kind, value.Number = "license", identity.LicenseNumber
+		case identity.SSN != "":
+			value.Kind, value.Number = "ssn", identity.SSN
+		}
+		item, err = newItem(models.DataTypeIdentity, bwItem.Name, value)
+		item.addField("title", identity.Title, models.FieldTypeText)
+		item.addField("email", identity.Email, models.FieldTypeText)
+		item.addField("phone", identity.Phone, models.FieldTypeText)
+		item.addField("company", identity.Company, models.FieldTypeText)
+		item.addField("username", identity.Username, models.FieldTypeText)
+		if value.Kind != "ssn" {
+			item.addField("ssn", identity.SSN, models.FieldTypeHidden)
+		}
+		if value.Kind == "passport" {
+			item.addField("license", identity.LicenseNumber, models.FieldTypeText)
+		}
+		item.addField("address", joinNonEmpty(", ",
+			identity.Address1, identity.Address2, identity.Address3,
+			identity.City, identity.State, identity.PostalCode, identity.Country,
+		), models.FieldTypeText)
+	case bwItem.Type == bitwardenSSHKey && bwItem.SSHKey != nil:
+		item, err = newItem(models.DataTypeSSHKey, bwItem.Name, &models.SSHKeyValue{
+			PrivateKey: bwItem.SSHKey.PrivateKey,
+			PublicKey:  bwItem.SSHKey.PublicKey,
+		})
+	default:
+		return item, false, nil
+	}
+
+	return item, err == nil, err
+}
+
+// cardDate - срок действия карты в формате MM/YY
+func cardDate(month, year string) string {
+	month, year = strings.TrimSpace(month), strings.TrimSpace(year)
+	if month == "" && year == "" {
+		return ""
+	}
+	if len(month) == 1 {
+		month = "0" + month
+	}
+	if len(year) == 4 {
+		year = year[2:]
+	}
+
+	return month + "/" + year
+}
+
+// joinNonEmpty - склеить непустые строки
+func joinNonEmpty(sep string, parts ...string) string {
+	res := make([]string, 0, len(parts))
+	for _, part := range parts {
+		if part = strings.TrimSpace(part); part != "" {
+			res = append(res, part)
+		}
+	}
+
+	return strings.Join(res, sep)
+}
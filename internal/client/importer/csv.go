@@ -0,0 +1,102 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// errNoCSVColumns - в заголовке CSV нет ни одной известной колонки
+var errNoCSVColumns = errors.New("csv header has no known columns")
+
+// csvColumns - названия колонок CSV разных программ (в нижнем регистре).
+// Chrome: name,url,username,password,note
+// Firefox: url,username,password,httpRealm,formActionOrigin,guid,timeCreated,timeLastUsed,timePasswordChanged
+// 1Password: Title,Url,Username,Password,OTPAuth,Favorite,Archived,Tags,Notes
+var csvColumns = map[string][]string{
+	"title":    {"name", "title"},
+	"url":      {"url", "website", "login_uri"},
+	"username": {"username", "login_username", "user name", "login"},
+	"password": {"password", "login_password"},
+	"notes":    {"note", "notes", "extra"},
+	"otp":      {"otpauth", "totp", "login_totp", "one-time password"},
+	"folder":   {"folder", "group", "grouping"},
+	"tags":     {"tags"},
+}
+
+// parseCSV - разобрать CSV с паролями браузера или 1Password
+func parseCSV(content []byte, _ Options) (*Result, error) {
+	reader := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(content, []byte("\xEF\xBB\xBF"))))
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		for column, aliases := range csvColumns {
+			for _, alias := range aliases {
+				if _, ok := columns[column]; !ok && name == alias {
+					columns[column] = i
+				}
+			}
+		}
+	}
+	if len(columns) == 0 {
+		return nil, errNoCSVColumns
+	}
+
+	result := &Result{}
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv: %w", err)
+		}
+
+		get := func(column string) string {
+			index, ok := columns[column]
+			if !ok || index >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[index])
+		}
+
+		title := get("title")
+		if get("username") == "" && get("password") == "" {
+			if title == "" {
+				title = i18n.T("import.line", line)
+			}
+			result.Skipped = append(result.Skipped, Skipped{Name: title, Reason: i18n.T("import.no_credentials")})
+			continue
+		}
+
+		item, err := newItem(models.DataTypeCredentials, title, &models.CredentialsValue{
+			Login:    get("username"),
+			Password: get("password"),
+		})
+		if err != nil {
+			return nil, err
+		}
+		item.addURL(get("url"), models.URLMatchDomain)
+		item.addField(FieldOTP, get("otp"), models.FieldTypeHidden)
+		item.addField(FieldTags, get("tags"), models.FieldTypeText)
+		item.addField(FieldNotes, get("notes"), models.FieldTypeText)
+		item.setFolder(get("folder"))
+
+		result.Items = append(result.Items, item)
+	}
+
+	return result, nil
+}
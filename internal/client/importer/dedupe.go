@@ -0,0 +1,75 @@
+package importer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+)
+
+// Fingerprint - отпечаток содержимого записи. Идентификатор и время изменения назначает
+// сервер, поэтому они не учитываются.
+func Fingerprint(data commonRequests.DataModel) string {
+	unix := func(date *time.Time) int64 {
+		if date == nil {
+			return 0
+		}
+		return date.Unix()
+	}
+	if len(data.Fields) == 0 {
+		data.Fields = nil
+	}
+	if len(data.URLs) == 0 {
+		data.URLs = nil
+	}
+
+	content, _ := json.Marshal(struct {
+		Type        models.DataType
+		Description string
+		Value       string
+		Fields      models.DataFields
+		URLs        models.DataURLs
+		ExpiresAt   int64
+		RotateAt    int64
+	}{
+		Type:        data.Type,
+		Description: data.Description,
+		Value:       data.Value,
+		Fields:      data.Fields,
+		URLs:        data.URLs,
+		ExpiresAt:   unix(data.ExpiresAt),
+		RotateAt:    unix(data.RotateAt),
+	})
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Dedupe - убрать записи, которые уже есть на сервере, и вернуть количество убранных.
+// Благодаря этому повторный импорт того же файла ничего не создает.
+func Dedupe(items []Item, existing []models.DataInfo) ([]Item, int) {
+	known := make(map[string]bool, len(existing))
+	for _, data := range existing {
+		known[Fingerprint(commonRequests.DataModel{
+			Type:        data.Type,
+			Description: data.Description,
+			Value:       data.Value,
+			Fields:      data.Fields,
+			URLs:        data.URLs,
+			ExpiresAt:   data.ExpiresAt,
+			RotateAt:    data.RotateAt,
+		})] = true
+	}
+
+	fresh := make([]Item, 0, len(items))
+	for _, item := range items {
+		if !known[Fingerprint(item.Data)] {
+			fresh = append(fresh, item)
+		}
+	}
+
+	return fresh, len(items) - len(fresh)
+}
@@ -0,0 +1,35 @@
+package importer
+
+import (
+	"bytes"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/backup"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+)
+
+// parseBackup - разобрать резервную копию GophKeeper. Записи переносятся без изменений.
+func parseBackup(content []byte, options Options) (*Result, error) {
+	archive, err := backup.Read(bytes.NewReader(content), options.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{Items: make([]Item, 0, len(archive.Records))}
+	for _, data := range archive.Records {
+		result.Items = append(result.Items, Item{
+			Folder: records.Folder(data),
+			Data: commonRequests.DataModel{
+				Type:        data.Type,
+				Description: data.Description,
+				Value:       data.Value,
+				Fields:      data.Fields,
+				URLs:        data.URLs,
+				ExpiresAt:   data.ExpiresAt,
+				RotateAt:    data.RotateAt,
+			},
+		})
+	}
+
+	return result, nil
+}
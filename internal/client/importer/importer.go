@@ -0,0 +1,211 @@
+// Package importer переносит записи из других менеджеров паролей
+package importer
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/backup"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+)
+
+var (
+	// ErrUnknownFormat - формат экспорта не поддерживается или не распознан
+	ErrUnknownFormat = errors.New("unknown import format")
+	// ErrPasswordRequired - файл экспорта защищен паролем
+	ErrPasswordRequired = errors.New("password is required")
+)
+
+// Имена пользовательских полей, в которые переносятся данные без отдельного поля в записи
+const (
+	FieldFolder = records.FolderField
+	FieldNotes  = "notes"
+	FieldOTP    = "otp"
+	FieldTags   = "tags"
+)
+
+// FormatBackup - имя формата резервной копии GophKeeper
+const FormatBackup = "gophkeeper"
+
+// Item - запись, которая будет создана при импорте
+type Item struct {
+	Data   commonRequests.DataModel
+	Folder string
+}
+
+// Skipped - запись экспорта, которую не удалось перенести
+type Skipped struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+}
+
+// Result - результат разбора файла экспорта
+type Result struct {
+	Items   []Item
+	Skipped []Skipped
+}
+
+// Options - параметры разбора
+type Options struct {
+	// Password - пароль зашифрованного экспорта (база KeePass, резервная копия)
+	Password string
+}
+
+// Format - поддерживаемый формат экспорта
+type Format struct {
+	Name string
+	// NeedPassword - файл зашифрован и для разбора нужен пароль
+	NeedPassword bool
+	parse        func(content []byte, options Options) (*Result, error)
+}
+
+// Formats - поддерживаемые форматы экспорта
+var Formats = []Format{
+	{Name: FormatBackup, NeedPassword: true, parse: parseBackup},
+	{Name: "keepass", NeedPassword: true, parse: parseKeePass},
+	{Name: "bitwarden", parse: parseBitwarden},
+	{Name: "1password", parse: parseOnePassword},
+	{Name: "csv", parse: parseCSV},
+}
+
+// Title - название формата на выбранном языке
+func (f Format) Title() string {
+	return i18n.T("import_format." + f.Name)
+}
+
+// ByName - найти формат по имени
+func ByName(name string) (Format, bool) {
+	for _, format := range Formats {
+		if strings.EqualFold(format.Name, name) {
+			return format, true
+		}
+	}
+
+	return Format{}, false
+}
+
+// Names - имена поддерживаемых форматов через запятую
+func Names() string {
+	names := make([]string, 0, len(Formats))
+	for _, format := range Formats {
+		names = append(names, format.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// Detect - определить формат по имени файла и началу содержимого
+func Detect(path string, head []byte) (Format, error) {
+	name := ""
+	switch {
+	case bytes.HasPrefix(head, backup.Magic):
+		name = FormatBackup
+	case bytes.HasPrefix(head, []byte{0x03, 0xD9, 0xA2, 0x9A}):
+		name = "keepass"
+	case bytes.HasPrefix(head, []byte("PK\x03\x04")):
+		name = "1password"
+	case bytes.HasPrefix(bytes.TrimSpace(head), []byte("{")):
+		name = "bitwarden"
+	case strings.EqualFold(filepath.Ext(path), ".csv"):
+		name = "csv"
+	}
+
+	format, ok := ByName(name)
+	if !ok {
+		return Format{}, ErrUnknownFormat
+	}
+
+	return format, nil
+}
+
+// Parse - разобрать файл экспорта
+func (f Format) Parse(r io.Reader, options Options) (*Result, error) {
+	if f.NeedPassword && options.Password == "" {
+		return nil, ErrPasswordRequired
+	}
+
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := f.parse(content, options)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s export: %w", f.Name, err)
+	}
+
+	return result, nil
+}
+
+// newItem - подготовить запись с закодированным значением
+func newItem(dataType models.DataType, description string, value interface{}) (Item, error) {
+	encoded, err := models.EncodeValue(value)
+	if err != nil {
+		return Item{}, err
+	}
+
+	return Item{
+		Data: commonRequests.DataModel{
+			Type:        dataType,
+			Description: strings.TrimSpace(description),
+			Value:       encoded,
+		},
+	}, nil
+}
+
+// addField - добавить пользовательское поле, пустые значения пропускаются
+func (item *Item) addField(name, value string, fieldType models.FieldType) {
+	name = strings.TrimSpace(name)
+	if name == "" || value == "" {
+		return
+	}
+
+	item.Data.Fields = append(item.Data.Fields, models.DataField{
+		Name:  name,
+		Type:  fieldType,
+		Value: value,
+	})
+}
+
+// addURL - добавить адрес записи, повторы пропускаются
+func (item *Item) addURL(rawURL string, match models.URLMatch) {
+	rawURL = strings.TrimSpace(rawURL)
+	if rawURL == "" {
+		return
+	}
+	for _, dataURL := range item.Data.URLs {
+		if dataURL.URL == rawURL {
+			return
+		}
+	}
+
+	item.Data.URLs = append(item.Data.URLs, models.DataURL{URL: rawURL, Match: match})
+	if item.Data.Description == "" {
+		item.Data.Description = urlTitle(rawURL)
+	}
+}
+
+// setFolder - запомнить папку записи. Отдельного поля для папок нет, поэтому
+// она сохраняется в пользовательское поле folder.
+func (item *Item) setFolder(folder string) {
+	item.Folder = strings.TrimSpace(folder)
+	item.addField(FieldFolder, item.Folder, models.FieldTypeText)
+}
+
+// urlTitle - название записи по адресу, если в экспорте его нет
+func urlTitle(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Host == "" {
+		return rawURL
+	}
+
+	return parsed.Hostname()
+}
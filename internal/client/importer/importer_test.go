@@ -0,0 +1,272 @@
+package importer_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/backup"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/importer"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+	httpMocks "github.com/ShukinDmitriy/GophKeeper/mocks/internal_/client/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// parseFile - разобрать файл из testdata
+func parseFile(t *testing.T, formatName, name string, options importer.Options) *importer.Result {
+	t.Helper()
+
+	file, err := os.Open(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	defer file.Close()
+
+	format, ok := importer.ByName(formatName)
+	require.True(t, ok)
+	result, err := format.Parse(file, options)
+	require.NoError(t, err)
+
+	return result
+}
+
+// decode - раскодировать значение записи
+func decode[T any](t *testing.T, item importer.Item) T {
+	t.Helper()
+
+	var value T
+	require.NoError(t, models.DecodeValue(item.Data.Value, &value))
+
+	return value
+}
+
+// field - значение пользовательского поля записи
+func field(item importer.Item, name string) models.DataField {
+	for _, dataField := range item.Data.Fields {
+		if dataField.Name == name {
+			return dataField
+		}
+	}
+
+	return models.DataField{}
+}
+
+func TestParseCSV(t *testing.T) {
+	result := parseFile(t, "csv", "chrome.csv", importer.Options{})
+	require.Len(t, result.Items, 2)
+	assert.Equal(t, []importer.Skipped{{Name: "empty", Reason: "нет логина и пароля"}}, result.Skipped)
+
+	mail := result.Items[0]
+	assert.Equal(t, models.DataTypeCredentials, mail.Data.Type)
+	assert.Equal(t, "mail.example.com", mail.Data.Description)
+	assert.Equal(t, models.CredentialsValue{Login: "user@example.com", Password: "secret1"}, decode[models.CredentialsValue](t, mail))
+	assert.Equal(t, models.DataURLs{{URL: "https://mail.example.com/login", Match: models.URLMatchDomain}}, mail.Data.URLs)
+	assert.Equal(t, "primary mailbox", field(mail, importer.FieldNotes).Value)
+
+	// Без названия запись называется по хосту
+	assert.Equal(t, "shop.example.org", result.Items[1].Data.Description)
+
+	result = parseFile(t, "csv", "firefox.csv", importer.Options{})
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "forum.example.net", result.Items[0].Data.Description)
+	assert.Equal(t, models.CredentialsValue{Login: "reader", Password: "secret3"}, decode[models.CredentialsValue](t, result.Items[0]))
+
+	result = parseFile(t, "1password", "1password.csv", importer.Options{})
+	require.Len(t, result.Items, 1)
+	git := result.Items[0]
+	assert.Equal(t, "Git", git.Data.Description)
+	assert.Equal(t, models.DataField{Name: importer.FieldOTP, Type: models.FieldTypeHidden, Value: "otpauth://totp/Git?secret=JBSWY3DPEHPK3PXP"}, field(git, importer.FieldOTP))
+	assert.Equal(t, "work", field(git, importer.FieldTags).Value)
+}
+
+func TestParseBitwarden(t *testing.T) {
+	result := parseFile(t, "bitwarden", "bitwarden.json", importer.Options{})
+	require.Len(t, result.Items, 5)
+	require.Len(t, result.Skipped, 1)
+	assert.Equal(t, "Future type", result.Skipped[0].Name)
+
+	vpn := result.Items[0]
+	assert.Equal(t, models.DataTypeCredentials, vpn.Data.Type)
+	assert.Equal(t, "Work", vpn.Folder)
+	assert.Equal(t, models.CredentialsValue{Login: "dev", Password: "secret5"}, decode[models.CredentialsValue](t, vpn))
+	assert.Equal(t, models.DataURLs{
+		{URL: "https://vpn.example.com", Match: models.URLMatchHost},
+		{URL: "vpn.example.com", Match: models.URLMatchDomain},
+	}, vpn.Data.URLs)
+	assert.Equal(t, models.DataFields{
+		{Name: importer.FieldOTP, Type: models.FieldTypeHidden, Value: "JBSWY3DPEHPK3PXP"},
+		{Name: "pin", Type: models.FieldTypeHidden, Value: "1234"},
+		{Name: "shared", Type: models.FieldTypeBoolean, Value: "true"},
+		{Name: importer.FieldNotes, Type: models.FieldTypeText, Value: "office"},
+		{Name: importer.FieldFolder, Type: models.FieldTypeText, Value: "Work"},
+	}, vpn.Data.Fields)
+
+	note := result.Items[1]
+	assert.Equal(t, models.DataTypeNote, note.Data.Type)
+	assert.Equal(t, "1111 2222", decode[models.NoteValue](t, note).Markdown)
+	assert.Empty(t, note.Data.Fields)
+
+	card := result.Items[2]
+	assert.Equal(t, models.BankCardValue{Number: "4111111111111111", Date: "03/31", Secure: "123"}, decode[models.BankCardValue](t, card))
+	assert.Equal(t, "IVAN IVANOV", field(card, "cardholder").Value)
+
+	identity := decode[models.IdentityValue](t, result.Items[3])
+	assert.Equal(t, models.IdentityValue{Kind: "passport", Number: "4510123456", FullName: "Ivan Ivanov"}, identity)
+	assert.Equal(t, "ivan@example.com", field(result.Items[3], "email").Value)
+
+	assert.Equal(t, models.SSHKeyValue{PrivateKey: "PRIVATE", PublicKey: "ssh-ed25519 AAAA"}, decode[models.SSHKeyValue](t, result.Items[4]))
+
+	format, _ := importer.ByName("bitwarden")
+	_, err := format.Parse(bytes.NewReader([]byte(`{"encrypted": true}`)), importer.Options{})
+	assert.ErrorIs(t, err, importer.ErrEncryptedExport)
+}
+
+func TestParseOnePassword(t *testing.T) {
+	exportData, err := os.ReadFile(filepath.Join("testdata", "1pux", "export.data"))
+	require.NoError(t, err)
+
+	var archive bytes.Buffer
+	writer := zip.NewWriter(&archive)
+	file, err := writer.Create("export.data")
+	require.NoError(t, err)
+	_, err = file.Write(exportData)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	format, _ := importer.ByName("1password")
+	result, err := format.Parse(&archive, importer.Options{})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 3)
+
+	login := result.Items[0]
+	assert.Equal(t, "GitHub", login.Data.Description)
+	assert.Equal(t, "Personal", login.Folder)
+	assert.Equal(t, models.CredentialsValue{Login: "dev", Password: "secret6"}, decode[models.CredentialsValue](t, login))
+	assert.Equal(t, models.DataURLs{
+		{URL: "https://github.com", Match: models.URLMatchDomain},
+		{URL: "https://gist.github.com", Match: models.URLMatchDomain},
+	}, login.Data.URLs)
+	assert.Equal(t, "otpauth://totp/Git?secret=JBSWY3DPEHPK3PXP", field(login, importer.FieldOTP).Value)
+	assert.Equal(t, models.DataField{Name: "recovery", Type: models.FieldTypeHidden, Value: "abcd"}, field(login, "recovery"))
+	assert.Equal(t, "main account", field(login, importer.FieldNotes).Value)
+	assert.Equal(t, "dev", field(login, importer.FieldTags).Value)
+
+	card := result.Items[1]
+	assert.Equal(t, models.DataTypeBankCard, card.Data.Type)
+	assert.Equal(t, models.BankCardValue{Number: "5500000000000004", Date: "12/29", Secure: "321"}, decode[models.BankCardValue](t, card))
+	assert.Equal(t, "IVAN IVANOV", field(card, "cardholder name").Value)
+
+	wifi := result.Items[2]
+	assert.Equal(t, models.WiFiValue{SSID: "home", Password: "wifi-secret", Security: "WPA"}, decode[models.WiFiValue](t, wifi))
+}
+
+func TestParseKeePass(t *testing.T) {
+	format, _ := importer.ByName("keepass")
+	_, err := format.Parse(bytes.NewReader(nil), importer.Options{})
+	assert.ErrorIs(t, err, importer.ErrPasswordRequired)
+
+	result := parseFile(t, "keepass", "keepass.kdbx", importer.Options{Password: "master"})
+	require.Len(t, result.Items, 2)
+
+	mail := result.Items[0]
+	assert.Equal(t, "Mail", mail.Data.Description)
+	assert.Equal(t, models.CredentialsValue{Login: "user@example.com", Password: "mail-secret"}, decode[models.CredentialsValue](t, mail))
+	assert.Equal(t, models.DataURLs{{URL: "https://mail.example.com", Match: models.URLMatchDomain}}, mail.Data.URLs)
+	assert.Equal(t, "primary mailbox", field(mail, importer.FieldNotes).Value)
+	require.NotNil(t, mail.Data.ExpiresAt)
+	assert.Equal(t, time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC), *mail.Data.ExpiresAt)
+
+	vpn := result.Items[1]
+	assert.Equal(t, "Work", vpn.Folder)
+	assert.Equal(t, models.DataField{Name: "PIN", Type: models.FieldTypeHidden, Value: "1234"}, field(vpn, "PIN"))
+	assert.Equal(t, "work;vpn", field(vpn, importer.FieldTags).Value)
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		path string
+		head []byte
+		want string
+	}{
+		{path: "db.kdbx", head: []byte{0x03, 0xD9, 0xA2, 0x9A, 0x67, 0xFB}, want: "keepass"},
+		{path: "export.1pux", head: []byte("PK\x03\x04"), want: "1password"},
+		{path: "bitwarden.json", head: []byte("\n{\"encrypted\""), want: "bitwarden"},
+		{path: "passwords.CSV", head: []byte("name,url"), want: "csv"},
+	}
+	for _, tt := range tests {
+		format, err := importer.Detect(tt.path, tt.head)
+		require.NoError(t, err)
+		assert.Equal(t, tt.want, format.Name)
+	}
+
+	_, err := importer.Detect("notes.txt", []byte("hello"))
+	assert.ErrorIs(t, err, importer.ErrUnknownFormat)
+}
+
+func TestUpload(t *testing.T) {
+	items := make([]importer.Item, commonRequests.DataBatchMaxOperations+1)
+	for i := range items {
+		items[i].Data.Type = models.DataTypeText
+	}
+
+	httpClient := httpMocks.NewClientInterface(t)
+	httpClient.EXPECT().Batch(mock.Anything, mock.MatchedBy(func(batch commonRequests.DataBatch) bool {
+		return len(batch.Operations) == commonRequests.DataBatchMaxOperations
+	})).Return([]models.DataBatchResult{{Index: 0, Op: models.DataBatchCreate, ID: 1, Status: http.StatusCreated}}, nil).Once()
+	httpClient.EXPECT().Batch(mock.Anything, mock.MatchedBy(func(batch commonRequests.DataBatch) bool {
+		return len(batch.Operations) == 1 && batch.Operations[0].Op == models.DataBatchCreate
+	})).Return([]models.DataBatchResult{{Index: 0, Op: models.DataBatchCreate, ID: 2, Status: http.StatusCreated}}, nil).Once()
+
+	results, err := importer.Upload(context.Background(), httpClient, items)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, commonRequests.DataBatchMaxOperations, results[1].Index)
+}
+
+func TestParseBackupAndDedupe(t *testing.T) {
+	value, err := models.EncodeValue(models.NoteValue{Markdown: "# plan"})
+	require.NoError(t, err)
+	expiresAt := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	data := models.DataInfo{
+		ID:          3,
+		Type:        models.DataTypeNote,
+		Description: "plan",
+		Value:       value,
+		Fields:      models.DataFields{{Name: importer.FieldFolder, Value: "Work"}},
+		ExpiresAt:   &expiresAt,
+		UpdatedAt:   time.Now(),
+	}
+
+	var archive bytes.Buffer
+	require.NoError(t, backup.Write(&archive, backup.Archive{Records: []models.DataInfo{data}}, "backup-secret"))
+
+	format, err := importer.Detect("vault.gkb", archive.Bytes()[:16])
+	require.NoError(t, err)
+	assert.Equal(t, importer.FormatBackup, format.Name)
+
+	result, err := format.Parse(bytes.NewReader(archive.Bytes()), importer.Options{Password: "backup-secret"})
+	require.NoError(t, err)
+	require.Len(t, result.Items, 1)
+	assert.Equal(t, "Work", result.Items[0].Folder)
+	assert.Equal(t, value, result.Items[0].Data.Value)
+
+	// На сервере у записи другой идентификатор и время изменения, но содержимое то же
+	existing := data
+	existing.ID = 10
+	existingExpiresAt := expiresAt.Local()
+	existing.ExpiresAt = &existingExpiresAt
+	fresh, duplicates := importer.Dedupe(result.Items, []models.DataInfo{existing})
+	assert.Empty(t, fresh)
+	assert.Equal(t, 1, duplicates)
+
+	existing.Description = "old plan"
+	fresh, duplicates = importer.Dedupe(result.Items, []models.DataInfo{existing})
+	assert.Len(t, fresh, 1)
+	assert.Equal(t, 0, duplicates)
+}
@@ -0,0 +1,266 @@
+package kdbx
+
+import (
+	"encoding/binary"
+	"hash"
+
+	"golang.org/x/crypto/blake2b"
+)
+
+// Варианты Argon2. golang.org/x/crypto/argon2 не экспортирует Argon2d, который KeePass
+// использует по умолчанию, поэтому алгоритм (RFC 9106) реализован здесь.
+const (
+	argon2d  = 0
+	argon2id = 2
+)
+
+const (
+	argon2Version     = 0x13
+	argon2BlockLength = 128
+	argon2SyncPoints  = 4
+)
+
+type argon2Block [argon2BlockLength]uint64
+
+// argon2Key - вычислить ключ Argon2d или Argon2id. memory задается в КиБ.
+func argon2Key(mode int, password, salt, secret, data []byte, time, memory, threads, keyLen uint32) []byte {
+	h0 := argon2InitHash(mode, password, salt, secret, data, time, memory, threads, keyLen)
+
+	memory = memory / (argon2SyncPoints * threads) * (argon2SyncPoints * threads)
+	if memory < 2*argon2SyncPoints*threads {
+		memory = 2 * argon2SyncPoints * threads
+	}
+	lanes := memory / threads
+	segments := lanes / argon2SyncPoints
+
+	blocks := make([]argon2Block, memory)
+	var buf [1024]byte
+	for lane := uint32(0); lane < threads; lane++ {
+		binary.LittleEndian.PutUint32(h0[blake2b.Size+4:], lane)
+		for i := uint32(0); i < 2; i++ {
+			binary.LittleEndian.PutUint32(h0[blake2b.Size:], i)
+			argon2Hash(buf[:], h0[:])
+			for j := range blocks[lane*lanes+i] {
+				blocks[lane*lanes+i][j] = binary.LittleEndian.Uint64(buf[j*8:])
+			}
+		}
+	}
+
+	for pass := uint32(0); pass < time; pass++ {
+		for slice := uint32(0); slice < argon2SyncPoints; slice++ {
+			for lane := uint32(0); lane < threads; lane++ {
+				argon2Segment(blocks, mode, pass, slice, lane, time, memory, threads, lanes, segments)
+			}
+		}
+	}
+
+	last := &blocks[memory-1]
+	for lane := uint32(0); lane < threads-1; lane++ {
+		for i, v := range blocks[lane*lanes+lanes-1] {
+			last[i] ^= v
+		}
+	}
+	for i, v := range last {
+		binary.LittleEndian.PutUint64(buf[i*8:], v)
+	}
+	key := make([]byte, keyLen)
+	argon2Hash(key, buf[:])
+
+	return key
+}
+
+// argon2InitHash - H0 с местом под номер блока и полосы
+func argon2InitHash(mode int, password, salt, secret, data []byte, time, memory, threads, keyLen uint32) [blake2b.Size + 8]byte {
+	var h0 [blake2b.Size + 8]byte
+
+	b2, _ := blake2b.New512(nil)
+	for _, value := range []uint32{threads, keyLen, memory, time, argon2Version, uint32(mode)} {
+		writeUint32(b2, value)
+	}
+	for _, value := range [][]byte{password, salt, secret, data} {
+		writeUint32(b2, uint32(len(value)))
+		b2.Write(value)
+	}
+	b2.Sum(h0[:0])
+
+	return h0
+}
+
+// argon2Segment - заполнить сегмент полосы
+func argon2Segment(blocks []argon2Block, mode int, pass, slice, lane, time, memory, threads, lanes, segments uint32) {
+	// Argon2id в первой половине первого прохода выбирает блоки независимо от данных
+	independent := mode == argon2id && pass == 0 && slice < argon2SyncPoints/2
+
+	var addresses, input, zero argon2Block
+	if independent {
+		input[0] = uint64(pass)
+		input[1] = uint64(lane)
+		input[2] = uint64(slice)
+		input[3] = uint64(memory)
+		input[4] = uint64(time)
+		input[5] = uint64(mode)
+	}
+
+	index := uint32(0)
+	if pass == 0 && slice == 0 {
+		index = 2
+		if independent {
+			input[6]++
+			argon2Compress(&addresses, &input, &zero)
+			argon2Compress(&addresses, &addresses, &zero)
+		}
+	}
+
+	offset := lane*lanes + slice*segments + index
+	for ; index < segments; index, offset = index+1, offset+1 {
+		prev := offset - 1
+		if index == 0 && slice == 0 {
+			prev += lanes
+		}
+
+		var random uint64
+		if independent {
+			if index%argon2BlockLength == 0 {
+				input[6]++
+				argon2Compress(&addresses, &input, &zero)
+				argon2Compress(&addresses, &addresses, &zero)
+			}
+			random = addresses[index%argon2BlockLength]
+		} else {
+			random = blocks[prev][0]
+		}
+
+		ref := argon2RefIndex(random, lanes, segments, threads, pass, slice, lane, index)
+		var next argon2Block
+		argon2Compress(&next, &blocks[prev], &blocks[ref])
+		// Начиная с версии 1.3 новые блоки последующих проходов складываются со старыми
+		for i := range next {
+			blocks[offset][i] ^= next[i]
+		}
+	}
+}
+
+// argon2RefIndex - номер блока, на который ссылается текущий
+func argon2RefIndex(random uint64, lanes, segments, threads, pass, slice, lane, index uint32) uint32 {
+	refLane := uint32(random>>32) % threads
+	if pass == 0 && slice == 0 {
+		refLane = lane
+	}
+
+	area, start := 3*segments, ((slice+1)%argon2SyncPoints)*segments
+	if lane == refLane {
+		area += index
+	}
+	if pass == 0 {
+		area, start = slice*segments, 0
+		if slice == 0 || lane == refLane {
+			area += index
+		}
+	}
+	if index == 0 || lane == refLane {
+		area--
+	}
+
+	p := random & 0xFFFFFFFF
+	p = (p * p) >> 32
+	p = (p * uint64(area)) >> 32
+
+	return refLane*lanes + uint32((uint64(start)+uint64(area)-(p+1))%uint64(lanes))
+}
+
+// argon2Compress - функция сжатия G: out = P(x ^ y) ^ x ^ y
+func argon2Compress(out, x, y *argon2Block) {
+	var r argon2Block
+	for i := range r {
+		r[i] = x[i] ^ y[i]
+	}
+	t := r
+
+	for i := 0; i < argon2BlockLength; i += 16 {
+		blamka(&t, [16]int{i, i + 1, i + 2, i + 3, i + 4, i + 5, i + 6, i + 7, i + 8, i + 9, i + 10, i + 11, i + 12, i + 13, i + 14, i + 15})
+	}
+	for i := 0; i < argon2BlockLength/8; i += 2 {
+		blamka(&t, [16]int{i, i + 1, 16 + i, 16 + i + 1, 32 + i, 32 + i + 1, 48 + i, 48 + i + 1, 64 + i, 64 + i + 1, 80 + i, 80 + i + 1, 96 + i, 96 + i + 1, 112 + i, 112 + i + 1})
+	}
+
+	for i := range out {
+		out[i] = r[i] ^ t[i]
+	}
+}
+
+// blamka - раунд BLAKE2b с умножением (BlaMka) над 16 словами блока
+func blamka(t *argon2Block, idx [16]int) {
+	var v [16]uint64
+	for i, j := range idx {
+		v[i] = t[j]
+	}
+
+	for _, q := range [8][4]int{
+		{0, 4, 8, 12}, {1, 5, 9, 13}, {2, 6, 10, 14}, {3, 7, 11, 15},
+		{0, 5, 10, 15}, {1, 6, 11, 12}, {2, 7, 8, 13}, {3, 4, 9, 14},
+	} {
+		a, b, c, d := v[q[0]], v[q[1]], v[q[2]], v[q[3]]
+		a += b + 2*uint64(uint32(a))*uint64(uint32(b))
+		d ^= a
+		d = d>>32 | d<<32
+		c += d + 2*uint64(uint32(c))*uint64(uint32(d))
+		b ^= c
+		b = b>>24 | b<<40
+		a += b + 2*uint64(uint32(a))*uint64(uint32(b))
+		d ^= a
+		d = d>>16 | d<<48
+		c += d + 2*uint64(uint32(c))*uint64(uint32(d))
+		b ^= c
+		b = b>>63 | b<<1
+		v[q[0]], v[q[1]], v[q[2]], v[q[3]] = a, b, c, d
+	}
+
+	for i, j := range idx {
+		t[j] = v[i]
+	}
+}
+
+// argon2Hash - хеш-функция переменной длины H'
+func argon2Hash(out []byte, in []byte) {
+	var b2 hash.Hash
+	if len(out) < blake2b.Size {
+		b2, _ = blake2b.New(len(out), nil)
+	} else {
+		b2, _ = blake2b.New512(nil)
+	}
+	writeUint32(b2, uint32(len(out)))
+	b2.Write(in)
+	if len(out) <= blake2b.Size {
+		b2.Sum(out[:0])
+		return
+	}
+
+	outLen := len(out)
+	var buffer [blake2b.Size]byte
+	b2.Sum(buffer[:0])
+	copy(out, buffer[:32])
+	out = out[32:]
+	for len(out) > blake2b.Size {
+		b2.Reset()
+		b2.Write(buffer[:])
+		b2.Sum(buffer[:0])
+		copy(out, buffer[:32])
+		out = out[32:]
+	}
+
+	if outLen%blake2b.Size > 0 {
+		r := (outLen+31)/32 - 2
+		b2, _ = blake2b.New(outLen-32*r, nil)
+	} else {
+		b2.Reset()
+	}
+	b2.Write(buffer[:])
+	b2.Sum(out[:0])
+}
+
+// writeUint32 - записать число в хеш в little-endian
+func writeUint32(h hash.Hash, value uint32) {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], value)
+	h.Write(buf[:])
+}
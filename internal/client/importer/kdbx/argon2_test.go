@@ -0,0 +1,40 @@
+package kdbx
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/argon2"
+)
+
+func TestArgon2d(t *testing.T) {
+	// Тестовый вектор RFC 9106, раздел 5.1
+	key := argon2Key(
+		argon2d,
+		bytes.Repeat([]byte{0x01}, 32),
+		bytes.Repeat([]byte{0x02}, 16),
+		bytes.Repeat([]byte{0x03}, 8),
+		bytes.Repeat([]byte{0x04}, 12),
+		3, 32, 4, 32,
+	)
+
+	assert.Equal(t, "512b391b6f1162975371d30919734294f868e3be3984f3c1a13a4db9fabe4acb", hex.EncodeToString(key))
+}
+
+func TestArgon2id(t *testing.T) {
+	tests := []struct {
+		time, memory, threads, keyLen uint32
+	}{
+		{time: 1, memory: 64, threads: 1, keyLen: 32},
+		{time: 2, memory: 256, threads: 2, keyLen: 32},
+		{time: 3, memory: 1024, threads: 4, keyLen: 64},
+		{time: 1, memory: 32, threads: 1, keyLen: 100},
+	}
+	for _, tt := range tests {
+		want := argon2.IDKey([]byte("password"), []byte("somesalt"), tt.time, tt.memory, uint8(tt.threads), tt.keyLen)
+		got := argon2Key(argon2id, []byte("password"), []byte("somesalt"), nil, nil, tt.time, tt.memory, tt.threads, tt.keyLen)
+		assert.Equal(t, want, got, "%+v", tt)
+	}
+}
@@ -0,0 +1,432 @@
+// Package kdbx читает базы KeePass в формате KDBX 4
+package kdbx
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/chacha20"
+)
+
+var (
+	// ErrSignature - файл не является базой KeePass
+	ErrSignature = errors.New("not a kdbx file")
+	// ErrVersion - поддерживается только KDBX 4
+	ErrVersion = errors.New("unsupported kdbx version")
+	// ErrCredentials - неверный пароль или база повреждена
+	ErrCredentials = errors.New("invalid password or corrupted header")
+	// ErrCorrupted - нарушена целостность базы
+	ErrCorrupted = errors.New("kdbx file is corrupted")
+	// ErrUnsupported - база использует неподдерживаемый алгоритм
+	ErrUnsupported = errors.New("unsupported kdbx algorithm")
+)
+
+const (
+	signature1   = 0x9AA2D903
+	signature2   = 0xB54BFB67
+	majorVersion = 4
+)
+
+// Ограничения параметров KDF: база с большими значениями заставила бы клиент
+// считать ключ часами или выделить память больше доступной
+const (
+	maxAESRounds         = 1 << 30
+	maxArgon2Memory      = 1 << 30 // байт
+	maxArgon2Iterations  = 1 << 10
+	maxArgon2Parallelism = 1 << 8
+)
+
+// Поля внешнего заголовка
+const (
+	headerEnd         = 0
+	headerCipherID    = 2
+	headerCompression = 3
+	headerMasterSeed  = 4
+	headerIV          = 7
+	headerKDF         = 11
+)
+
+// Поля внутреннего заголовка
+const (
+	innerHeaderEnd      = 0
+	innerHeaderStreamID = 1
+	innerHeaderKey      = 2
+)
+
+// Идентификаторы алгоритмов
+var (
+	cipherAES256   = []byte{0x31, 0xc1, 0xf2, 0xe6, 0xbf, 0x71, 0x43, 0x50, 0xbe, 0x58, 0x05, 0x21, 0x6a, 0xfc, 0x5a, 0xff}
+	cipherChaCha20 = []byte{0xd6, 0x03, 0x8a, 0x2b, 0x8b, 0x6f, 0x4c, 0xb5, 0xa5, 0x24, 0x33, 0x9a, 0x31, 0xdb, 0xb5, 0x9a}
+	kdfAES         = []byte{0xc9, 0xd9, 0xf3, 0x9a, 0x62, 0x8a, 0x44, 0x60, 0xbf, 0x74, 0x0d, 0x08, 0xc1, 0x8a, 0x4f, 0xea}
+	kdfArgon2d     = []byte{0xef, 0x63, 0x6d, 0xdf, 0x8c, 0x29, 0x44, 0x4b, 0x91, 0xf7, 0xa9, 0xa4, 0x03, 0xe3, 0x0c, 0x0a}
+	kdfArgon2id    = []byte{0x9e, 0x29, 0x8b, 0x19, 0x56, 0xdb, 0x47, 0x73, 0xb2, 0x3d, 0xfc, 0x3e, 0xc6, 0xf0, 0xa1, 0xe6}
+)
+
+// innerStreamChaCha20 - шифр защищенных значений внутри XML
+const innerStreamChaCha20 = 3
+
+// header - внешний заголовок базы
+type header struct {
+	cipherID   []byte
+	compressed bool
+	masterSeed []byte
+	iv         []byte
+	kdf        map[string][]byte
+}
+
+// Read - прочитать базу KDBX 4, защищенную паролем
+func Read(r io.Reader, password string) (*Database, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(content) < 12 ||
+		binary.LittleEndian.Uint32(content[0:]) != signature1 ||
+		binary.LittleEndian.Uint32(content[4:]) != signature2 {
+		return nil, ErrSignature
+	}
+	if version := binary.LittleEndian.Uint32(content[8:]); version>>16 != majorVersion {
+		return nil, fmt.Errorf("%w: %d.%d", ErrVersion, version>>16, version&0xFFFF)
+	}
+
+	hdr, headerLen, err := readHeader(content)
+	if err != nil {
+		return nil, err
+	}
+	if len(content) < headerLen+64 {
+		return nil, ErrCorrupted
+	}
+	headerData := content[:headerLen]
+	headerHash := sha256.Sum256(headerData)
+	if !hmac.Equal(headerHash[:], content[headerLen:headerLen+32]) {
+		return nil, ErrCorrupted
+	}
+
+	transformed, err := transformKey(compositeKey(password), hdr.kdf)
+	if err != nil {
+		return nil, err
+	}
+	encryptionKey := sha256.Sum256(concat(hdr.masterSeed, transformed))
+	hmacKey := sha512.Sum512(concat(hdr.masterSeed, transformed, []byte{1}))
+
+	if !hmac.Equal(blockHMAC(hmacKey[:], ^uint64(0), headerData), content[headerLen+32:headerLen+64]) {
+		return nil, ErrCredentials
+	}
+
+	payload, err := readBlocks(content[headerLen+64:], hmacKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err = decrypt(hdr, encryptionKey[:], payload)
+	if err != nil {
+		return nil, err
+	}
+
+	if hdr.compressed {
+		reader, err := gzip.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
+		}
+		if payload, err = io.ReadAll(reader); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
+		}
+	}
+
+	stream, document, err := readInnerHeader(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseXML(document, stream)
+}
+
+// readHeader - прочитать внешний заголовок, вернуть его длину вместе с сигнатурой
+func readHeader(content []byte) (*header, int, error) {
+	hdr := &header{}
+	offset := 12
+	for {
+		if len(content) < offset+5 {
+			return nil, 0, ErrCorrupted
+		}
+		id := content[offset]
+		size := int(binary.LittleEndian.Uint32(content[offset+1:]))
+		offset += 5
+		if size < 0 || len(content) < offset+size {
+			return nil, 0, ErrCorrupted
+		}
+		data := content[offset : offset+size]
+		offset += size
+
+		switch id {
+		case headerEnd:
+			if hdr.cipherID == nil || hdr.masterSeed == nil || hdr.kdf == nil {
+				return nil, 0, ErrCorrupted
+			}
+			return hdr, offset, nil
+		case headerCipherID:
+			hdr.cipherID = data
+		case headerCompression:
+			hdr.compressed = len(data) == 4 && binary.LittleEndian.Uint32(data) == 1
+		case headerMasterSeed:
+			hdr.masterSeed = data
+		case headerIV:
+			hdr.iv = data
+		case headerKDF:
+			kdf, err := readVariantDictionary(data)
+			if err != nil {
+				return nil, 0, err
+			}
+			hdr.kdf = kdf
+		}
+	}
+}
+
+// readVariantDictionary - разобрать словарь параметров KDF. Значения остаются в сыром виде.
+func readVariantDictionary(data []byte) (map[string][]byte, error) {
+	if len(data) < 2 || data[1] != 1 {
+		return nil, ErrCorrupted
+	}
+
+	dict := make(map[string][]byte)
+	offset := 2
+	for {
+		if len(data) < offset+1 {
+			return nil, ErrCorrupted
+		}
+		if data[offset] == 0 {
+			return dict, nil
+		}
+		offset++
+
+		var fields [2][]byte
+		for i := range fields {
+			if len(data) < offset+4 {
+				return nil, ErrCorrupted
+			}
+			size := int(binary.LittleEndian.Uint32(data[offset:]))
+			offset += 4
+			if size < 0 || len(data) < offset+size {
+				return nil, ErrCorrupted
+			}
+			fields[i] = data[offset : offset+size]
+			offset += size
+		}
+		dict[string(fields[0])] = fields[1]
+	}
+}
+
+// compositeKey - составной ключ из пароля
+func compositeKey(password string) []byte {
+	passwordHash := sha256.Sum256([]byte(password))
+	key := sha256.Sum256(passwordHash[:])
+
+	return key[:]
+}
+
+// transformKey - преобразовать составной ключ функцией KDF из заголовка
+func transformKey(key []byte, kdf map[string][]byte) ([]byte, error) {
+	uuid := kdf["$UUID"]
+	switch {
+	case bytes.Equal(uuid, kdfAES):
+		seed, rounds := kdf["S"], kdf["R"]
+		if len(seed) != 32 || len(rounds) != 8 {
+			return nil, ErrCorrupted
+		}
+		roundsCount := binary.LittleEndian.Uint64(rounds)
+		if roundsCount > maxAESRounds {
+			return nil, fmt.Errorf("%w: aes-kdf rounds %d > %d", ErrUnsupported, roundsCount, maxAESRounds)
+		}
+		block, err := aes.NewCipher(seed)
+		if err != nil {
+			return nil, err
+		}
+
+		transformed := append([]byte(nil), key...)
+		for i := roundsCount; i > 0; i-- {
+			block.Encrypt(transformed[:16], transformed[:16])
+			block.Encrypt(transformed[16:], transformed[16:])
+		}
+		sum := sha256.Sum256(transformed)
+
+		return sum[:], nil
+	case bytes.Equal(uuid, kdfArgon2d), bytes.Equal(uuid, kdfArgon2id):
+		mode := argon2d
+		if bytes.Equal(uuid, kdfArgon2id) {
+			mode = argon2id
+		}
+
+		salt, parallelism, memory, iterations, version := kdf["S"], kdf["P"], kdf["M"], kdf["I"], kdf["V"]
+		if len(salt) == 0 || len(parallelism) != 4 || len(memory) != 8 || len(iterations) != 8 || len(version) != 4 {
+			return nil, ErrCorrupted
+		}
+		if binary.LittleEndian.Uint32(version) != argon2Version {
+			return nil, fmt.Errorf("%w: argon2 version %#x", ErrUnsupported, binary.LittleEndian.Uint32(version))
+		}
+
+		threads := binary.LittleEndian.Uint32(parallelism)
+		memoryBytes := binary.LittleEndian.Uint64(memory)
+		passes := binary.LittleEndian.Uint64(iterations)
+		if threads == 0 || passes == 0 || memoryBytes < 1024 {
+			return nil, ErrCorrupted
+		}
+		switch {
+		case threads > maxArgon2Parallelism:
+			return nil, fmt.Errorf("%w: argon2 parallelism %d > %d", ErrUnsupported, threads, maxArgon2Parallelism)
+		case memoryBytes > maxArgon2Memory:
+			return nil, fmt.Errorf("%w: argon2 memory %d > %d bytes", ErrUnsupported, memoryBytes, maxArgon2Memory)
+		case passes > maxArgon2Iterations:
+			return nil, fmt.Errorf("%w: argon2 iterations %d > %d", ErrUnsupported, passes, maxArgon2Iterations)
+		}
+
+		return argon2Key(
+			mode,
+			key,
+			salt,
+			kdf["K"],
+			kdf["A"],
+			uint32(passes),
+			uint32(memoryBytes/1024),
+			threads,
+			32,
+		), nil
+	}
+
+	return nil, fmt.Errorf("%w: kdf %x", ErrUnsupported, uuid)
+}
+
+// blockHMAC - HMAC-SHA256 блока данных с ключом, зависящим от номера блока
+func blockHMAC(hmacKey []byte, index uint64, data ...[]byte) []byte {
+	var indexBytes [8]byte
+	binary.LittleEndian.PutUint64(indexBytes[:], index)
+	key := sha512.Sum512(concat(indexBytes[:], hmacKey))
+
+	mac := hmac.New(sha256.New, key[:])
+	for _, part := range data {
+		mac.Write(part)
+	}
+
+	return mac.Sum(nil)
+}
+
+// readBlocks - собрать зашифрованные данные из блоков, проверив HMAC каждого
+func readBlocks(content, hmacKey []byte) ([]byte, error) {
+	var payload []byte
+	for index := uint64(0); ; index++ {
+		if len(content) < 36 {
+			return nil, ErrCorrupted
+		}
+		sum, sizeBytes := content[:32], content[32:36]
+		size := int(int32(binary.LittleEndian.Uint32(sizeBytes)))
+		if size < 0 || len(content) < 36+size {
+			return nil, ErrCorrupted
+		}
+		data := content[36 : 36+size]
+		content = content[36+size:]
+
+		var indexBytes [8]byte
+		binary.LittleEndian.PutUint64(indexBytes[:], index)
+		if !hmac.Equal(blockHMAC(hmacKey, index, indexBytes[:], sizeBytes, data), sum) {
+			return nil, ErrCorrupted
+		}
+
+		if size == 0 {
+			return payload, nil
+		}
+		payload = append(payload, data...)
+	}
+}
+
+// decrypt - расшифровать данные базы
+func decrypt(hdr *header, key, payload []byte) ([]byte, error) {
+	switch {
+	case bytes.Equal(hdr.cipherID, cipherAES256):
+		if len(hdr.iv) != aes.BlockSize || len(payload) == 0 || len(payload)%aes.BlockSize != 0 {
+			return nil, ErrCorrupted
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, err
+		}
+		plain := make([]byte, len(payload))
+		cipher.NewCBCDecrypter(block, hdr.iv).CryptBlocks(plain, payload)
+
+		padding := int(plain[len(plain)-1])
+		if padding == 0 || padding > aes.BlockSize {
+			return nil, ErrCorrupted
+		}
+
+		return plain[:len(plain)-padding], nil
+	case bytes.Equal(hdr.cipherID, cipherChaCha20):
+		stream, err := chacha20.NewUnauthenticatedCipher(key, hdr.iv)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
+		}
+		plain := make([]byte, len(payload))
+		stream.XORKeyStream(plain, payload)
+
+		return plain, nil
+	}
+
+	return nil, fmt.Errorf("%w: cipher %x", ErrUnsupported, hdr.cipherID)
+}
+
+// readInnerHeader - прочитать внутренний заголовок: шифр защищенных значений и XML документ
+func readInnerHeader(payload []byte) (cipher.Stream, []byte, error) {
+	var (
+		streamID  uint32
+		streamKey []byte
+	)
+	for {
+		if len(payload) < 5 {
+			return nil, nil, ErrCorrupted
+		}
+		id := payload[0]
+		size := int(binary.LittleEndian.Uint32(payload[1:]))
+		if size < 0 || len(payload) < 5+size {
+			return nil, nil, ErrCorrupted
+		}
+		data := payload[5 : 5+size]
+		payload = payload[5+size:]
+
+		switch id {
+		case innerHeaderEnd:
+			if streamID != innerStreamChaCha20 {
+				return nil, nil, fmt.Errorf("%w: inner stream %d", ErrUnsupported, streamID)
+			}
+			keyHash := sha512.Sum512(streamKey)
+			stream, err := chacha20.NewUnauthenticatedCipher(keyHash[:32], keyHash[32:44])
+			if err != nil {
+				return nil, nil, err
+			}
+			return stream, payload, nil
+		case innerHeaderStreamID:
+			if len(data) != 4 {
+				return nil, nil, ErrCorrupted
+			}
+			streamID = binary.LittleEndian.Uint32(data)
+		case innerHeaderKey:
+			streamKey = data
+		}
+	}
+}
+
+// concat - склеить байтовые срезы
+func concat(parts ...[]byte) []byte {
+	var res []byte
+	for _, part := range parts {
+		res = append(res, part...)
+	}
+
+	return res
+}
@@ -0,0 +1,327 @@
+package kdbx
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/chacha20"
+)
+
+// testDocument - XML базы, защищенные значения подставляются вместо {{значение}}
+const testDocument = `<?xml version="1.0" encoding="utf-8" standalone="yes"?>
+<KeePassFile>
+	<Meta>
+		<RecycleBinEnabled>True</RecycleBinEnabled>
+		<RecycleBinUUID>YmluYmluYmluYmluYmluYg==</RecycleBinUUID>
+	</Meta>
+	<Root>
+		<Group>
+			<UUID>cm9vdHJvb3Ryb290cm9vdA==</UUID>
+			<Name>Database</Name>
+			<Entry>
+				<String><Key>Title</Key><Value>Mail</Value></String>
+				<String><Key>UserName</Key><Value>user@example.com</Value></String>
+				<String><Key>Password</Key><Value Protected="True">{{mail-secret}}</Value></String>
+				<String><Key>URL</Key><Value>https://mail.example.com</Value></String>
+				<String><Key>Notes</Key><Value>primary mailbox</Value></String>
+				<Times><Expires>True</Expires><ExpiryTime>{{expiry}}</ExpiryTime></Times>
+				<History>
+					<Entry>
+						<String><Key>Password</Key><Value Protected="True">{{old-secret}}</Value></String>
+					</Entry>
+				</History>
+			</Entry>
+			<Group>
+				<UUID>d29ya3dvcmt3b3Jrd29yaw==</UUID>
+				<Name>Work</Name>
+				<Entry>
+					<String><Key>Title</Key><Value>VPN</Value></String>
+					<String><Key>Password</Key><Value Protected="True">{{vpn-secret}}</Value></String>
+					<String><Key>PIN</Key><Value Protected="True">{{1234}}</Value></String>
+					<Tags>work;vpn</Tags>
+				</Entry>
+			</Group>
+			<Group>
+				<UUID>YmluYmluYmluYmluYmluYg==</UUID>
+				<Name>Recycle Bin</Name>
+				<Entry>
+					<String><Key>Title</Key><Value>Deleted</Value></String>
+				</Entry>
+			</Group>
+		</Group>
+	</Root>
+</KeePassFile>`
+
+// testOptions - параметры создаваемой базы
+type testOptions struct {
+	cipherID []byte
+	kdf      map[string][]byte
+}
+
+// writeTestDatabase - создать базу KDBX 4 с документом testDocument
+func writeTestDatabase(t *testing.T, password string, options testOptions) []byte {
+	t.Helper()
+
+	streamKey := bytes.Repeat([]byte{7}, 64)
+	keyHash := sha512.Sum512(streamKey)
+	stream, err := chacha20.NewUnauthenticatedCipher(keyHash[:32], keyHash[32:44])
+	require.NoError(t, err)
+
+	// Защищенные значения шифруются по порядку, как в KeePass
+	document := testDocument
+	for {
+		start := strings.Index(document, "{{")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(document, "}}")
+		value := []byte(document[start+2 : end])
+		if string(value) == "expiry" {
+			var raw [8]byte
+			binary.LittleEndian.PutUint64(raw[:], uint64(time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC).Unix()-kdbxEpoch))
+			document = document[:start] + base64.StdEncoding.EncodeToString(raw[:]) + document[end+2:]
+			continue
+		}
+		stream.XORKeyStream(value, value)
+		document = document[:start] + base64.StdEncoding.EncodeToString(value) + document[end+2:]
+	}
+
+	var inner bytes.Buffer
+	writeField(&inner, innerHeaderStreamID, binary.LittleEndian.AppendUint32(nil, innerStreamChaCha20))
+	writeField(&inner, innerHeaderKey, streamKey)
+	writeField(&inner, innerHeaderEnd, nil)
+	inner.WriteString(document)
+
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	_, err = gz.Write(inner.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	masterSeed := bytes.Repeat([]byte{1}, 32)
+	iv := bytes.Repeat([]byte{2}, 16)
+	if bytes.Equal(options.cipherID, cipherChaCha20) {
+		iv = iv[:12]
+	}
+
+	var hdr bytes.Buffer
+	hdr.Write(binary.LittleEndian.AppendUint32(nil, signature1))
+	hdr.Write(binary.LittleEndian.AppendUint32(nil, signature2))
+	hdr.Write(binary.LittleEndian.AppendUint32(nil, majorVersion<<16|1))
+	writeField(&hdr, headerCipherID, options.cipherID)
+	writeField(&hdr, headerCompression, binary.LittleEndian.AppendUint32(nil, 1))
+	writeField(&hdr, headerMasterSeed, masterSeed)
+	writeField(&hdr, headerIV, iv)
+	writeField(&hdr, headerKDF, writeVariantDictionary(options.kdf))
+	writeField(&hdr, headerEnd, nil)
+
+	transformed, err := transformKey(compositeKey(password), options.kdf)
+	require.NoError(t, err)
+	encryptionKey := sha256.Sum256(concat(masterSeed, transformed))
+	hmacKey := sha512.Sum512(concat(masterSeed, transformed, []byte{1}))
+
+	var payload []byte
+	if bytes.Equal(options.cipherID, cipherChaCha20) {
+		chacha, err := chacha20.NewUnauthenticatedCipher(encryptionKey[:], iv)
+		require.NoError(t, err)
+		payload = make([]byte, compressed.Len())
+		chacha.XORKeyStream(payload, compressed.Bytes())
+	} else {
+		block, err := aes.NewCipher(encryptionKey[:])
+		require.NoError(t, err)
+		padding := aes.BlockSize - compressed.Len()%aes.BlockSize
+		payload = append(compressed.Bytes(), bytes.Repeat([]byte{byte(padding)}, padding)...)
+		cipher.NewCBCEncrypter(block, iv).CryptBlocks(payload, payload)
+	}
+
+	var file bytes.Buffer
+	file.Write(hdr.Bytes())
+	headerHash := sha256.Sum256(hdr.Bytes())
+	file.Write(headerHash[:])
+	file.Write(blockHMAC(hmacKey[:], ^uint64(0), hdr.Bytes()))
+
+	for index, data := range [][]byte{payload, nil} {
+		indexBytes := binary.LittleEndian.AppendUint64(nil, uint64(index))
+		sizeBytes := binary.LittleEndian.AppendUint32(nil, uint32(len(data)))
+		file.Write(blockHMAC(hmacKey[:], uint64(index), indexBytes, sizeBytes, data))
+		file.Write(sizeBytes)
+		file.Write(data)
+	}
+
+	return file.Bytes()
+}
+
+// writeField - записать поле заголовка
+func writeField(buf *bytes.Buffer, id byte, data []byte) {
+	buf.WriteByte(id)
+	buf.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(data))))
+	buf.Write(data)
+}
+
+// writeVariantDictionary - записать словарь параметров KDF
+func writeVariantDictionary(dict map[string][]byte) []byte {
+	buf := bytes.NewBuffer([]byte{0, 1})
+	for name, value := range dict {
+		buf.WriteByte(0x42)
+		buf.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(name))))
+		buf.WriteString(name)
+		buf.Write(binary.LittleEndian.AppendUint32(nil, uint32(len(value))))
+		buf.Write(value)
+	}
+	buf.WriteByte(0)
+
+	return buf.Bytes()
+}
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name    string
+		options testOptions
+	}{
+		{
+			name: "aes kdf, aes cipher",
+			options: testOptions{
+				cipherID: cipherAES256,
+				kdf: map[string][]byte{
+					"$UUID": kdfAES,
+					"S":     bytes.Repeat([]byte{3}, 32),
+					"R":     binary.LittleEndian.AppendUint64(nil, 100),
+				},
+			},
+		},
+		{
+			name: "argon2d kdf, chacha20 cipher",
+			options: testOptions{
+				cipherID: cipherChaCha20,
+				kdf: map[string][]byte{
+					"$UUID": kdfArgon2d,
+					"S":     bytes.Repeat([]byte{4}, 32),
+					"P":     binary.LittleEndian.AppendUint32(nil, 2),
+					"M":     binary.LittleEndian.AppendUint64(nil, 64*1024),
+					"I":     binary.LittleEndian.AppendUint64(nil, 2),
+					"V":     binary.LittleEndian.AppendUint32(nil, argon2Version),
+				},
+			},
+		},
+		{
+			name: "argon2id kdf, aes cipher",
+			options: testOptions{
+				cipherID: cipherAES256,
+				kdf: map[string][]byte{
+					"$UUID": kdfArgon2id,
+					"S":     bytes.Repeat([]byte{5}, 32),
+					"P":     binary.LittleEndian.AppendUint32(nil, 1),
+					"M":     binary.LittleEndian.AppendUint64(nil, 32*1024),
+					"I":     binary.LittleEndian.AppendUint64(nil, 1),
+					"V":     binary.LittleEndian.AppendUint32(nil, argon2Version),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := writeTestDatabase(t, "master", tt.options)
+
+			db, err := Read(bytes.NewReader(file), "master")
+			require.NoError(t, err)
+			require.Len(t, db.Entries, 2)
+
+			mail := db.Entries[0]
+			assert.Empty(t, mail.Path)
+			assert.Equal(t, "Mail", mail.Get(FieldTitle))
+			assert.Equal(t, "user@example.com", mail.Get(FieldUserName))
+			assert.Equal(t, "mail-secret", mail.Get(FieldPassword))
+			assert.Equal(t, "https://mail.example.com", mail.Get(FieldURL))
+			assert.Equal(t, "primary mailbox", mail.Get(FieldNotes))
+			require.NotNil(t, mail.Expires)
+			assert.Equal(t, time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC), *mail.Expires)
+
+			// Значение из истории расшифровано раньше, поэтому следующие значения верны
+			vpn := db.Entries[1]
+			assert.Equal(t, []string{"Work"}, vpn.Path)
+			assert.Equal(t, "vpn-secret", vpn.Get(FieldPassword))
+			assert.Equal(t, Field{Key: "PIN", Value: "1234", Protected: true}, vpn.Fields[2])
+			assert.Equal(t, "work;vpn", vpn.Tags)
+			assert.Nil(t, vpn.Expires)
+
+			_, err = Read(bytes.NewReader(file), "wrong")
+			assert.ErrorIs(t, err, ErrCredentials)
+		})
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	_, err := Read(strings.NewReader("not a database"), "master")
+	assert.ErrorIs(t, err, ErrSignature)
+
+	file := writeTestDatabase(t, "master", testOptions{
+		cipherID: cipherAES256,
+		kdf: map[string][]byte{
+			"$UUID": kdfAES,
+			"S":     bytes.Repeat([]byte{3}, 32),
+			"R":     binary.LittleEndian.AppendUint64(nil, 1),
+		},
+	})
+
+	old := append([]byte(nil), file...)
+	binary.LittleEndian.PutUint32(old[8:], 3<<16|1)
+	_, err = Read(bytes.NewReader(old), "master")
+	assert.ErrorIs(t, err, ErrVersion)
+
+	corrupted := append([]byte(nil), file...)
+	corrupted[len(corrupted)-60] ^= 0xFF
+	_, err = Read(bytes.NewReader(corrupted), "master")
+	assert.ErrorIs(t, err, ErrCorrupted)
+}
+
+func TestTransformKeyLimits(t *testing.T) {
+	argon2KDF := func(memory, iterations uint64, parallelism uint32) map[string][]byte {
+		return map[string][]byte{
+			"$UUID": kdfArgon2id,
+			"S":     bytes.Repeat([]byte{1}, 32),
+			"P":     binary.LittleEndian.AppendUint32(nil, parallelism),
+			"M":     binary.LittleEndian.AppendUint64(nil, memory),
+			"I":     binary.LittleEndian.AppendUint64(nil, iterations),
+			"V":     binary.LittleEndian.AppendUint32(nil, argon2Version),
+		}
+	}
+	key := compositeKey("master")
+
+	tests := []struct {
+		name    string
+		kdf     map[string][]byte
+		wantErr error
+	}{
+		{
+			name: "aes rounds",
+			kdf: map[string][]byte{
+				"$UUID": kdfAES,
+				"S":     bytes.Repeat([]byte{3}, 32),
+				"R":     binary.LittleEndian.AppendUint64(nil, maxAESRounds+1),
+			},
+			wantErr: ErrUnsupported,
+		},
+		{name: "argon2 memory", kdf: argon2KDF(maxArgon2Memory+1024, 1, 1), wantErr: ErrUnsupported},
+		{name: "argon2 memory over uint32", kdf: argon2KDF(1<<52, 1, 1), wantErr: ErrUnsupported},
+		{name: "argon2 iterations", kdf: argon2KDF(1<<16, maxArgon2Iterations+1, 1), wantErr: ErrUnsupported},
+		{name: "argon2 parallelism", kdf: argon2KDF(1<<16, 1, maxArgon2Parallelism+1), wantErr: ErrUnsupported},
+		{name: "argon2 zero iterations", kdf: argon2KDF(1<<16, 0, 1), wantErr: ErrCorrupted},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := transformKey(key, tt.kdf)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
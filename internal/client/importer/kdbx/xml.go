@@ -0,0 +1,218 @@
+package kdbx
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Стандартные поля записи KeePass
+const (
+	FieldTitle    = "Title"
+	FieldUserName = "UserName"
+	FieldPassword = "Password"
+	FieldURL      = "URL"
+	FieldNotes    = "Notes"
+)
+
+// kdbxEpoch - начало отсчета времени в KDBX 4 (0001-01-01) в секундах Unix
+const kdbxEpoch = -62135596800
+
+// Database - содержимое базы KeePass
+type Database struct {
+	Entries []Entry
+}
+
+// Entry - запись базы
+type Entry struct {
+	// Path - группы записи от корня базы, без корневой группы
+	Path    []string
+	Fields  []Field
+	Tags    string
+	Expires *time.Time
+}
+
+// Field - строковое поле записи
+type Field struct {
+	Key       string
+	Value     string
+	Protected bool
+}
+
+// Get - значение поля записи по имени
+func (e Entry) Get(key string) string {
+	for _, field := range e.Fields {
+		if field.Key == key {
+			return field.Value
+		}
+	}
+
+	return ""
+}
+
+type xmlFile struct {
+	Meta struct {
+		RecycleBinEnabled string `xml:"RecycleBinEnabled"`
+		RecycleBinUUID    string `xml:"RecycleBinUUID"`
+	} `xml:"Meta"`
+	Root struct {
+		Groups []xmlGroup `xml:"Group"`
+	} `xml:"Root"`
+}
+
+type xmlGroup struct {
+	UUID    string     `xml:"UUID"`
+	Name    string     `xml:"Name"`
+	Groups  []xmlGroup `xml:"Group"`
+	Entries []xmlEntry `xml:"Entry"`
+}
+
+type xmlEntry struct {
+	Strings []xmlString `xml:"String"`
+	Tags    string      `xml:"Tags"`
+	Times   struct {
+		Expires    string `xml:"Expires"`
+		ExpiryTime string `xml:"ExpiryTime"`
+	} `xml:"Times"`
+}
+
+type xmlString struct {
+	Key   string `xml:"Key"`
+	Value struct {
+		Protected string `xml:"Protected,attr"`
+		Text      string `xml:",chardata"`
+	} `xml:"Value"`
+}
+
+// parseXML - разобрать XML документ базы
+func parseXML(document []byte, stream cipher.Stream) (*Database, error) {
+	document, err := unprotect(document, stream)
+	if err != nil {
+		return nil, err
+	}
+
+	var file xmlFile
+	if err = xml.Unmarshal(document, &file); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
+	}
+
+	recycleBin := ""
+	if !strings.EqualFold(file.Meta.RecycleBinEnabled, "False") {
+		recycleBin = file.Meta.RecycleBinUUID
+	}
+
+	db := &Database{}
+	for _, root := range file.Root.Groups {
+		db.collect(root, nil, recycleBin)
+	}
+
+	return db, nil
+}
+
+// collect - добавить записи группы и вложенных групп, пропуская корзину
+func (db *Database) collect(group xmlGroup, path []string, recycleBin string) {
+	if recycleBin != "" && group.UUID == recycleBin {
+		return
+	}
+
+	for _, entry := range group.Entries {
+		res := Entry{
+			Path: path,
+			Tags: entry.Tags,
+		}
+		for _, str := range entry.Strings {
+			res.Fields = append(res.Fields, Field{
+				Key:       str.Key,
+				Value:     str.Value.Text,
+				Protected: strings.EqualFold(str.Value.Protected, "True"),
+			})
+		}
+		if strings.EqualFold(entry.Times.Expires, "True") {
+			res.Expires = parseTime(entry.Times.ExpiryTime)
+		}
+		db.Entries = append(db.Entries, res)
+	}
+
+	for _, child := range group.Groups {
+		db.collect(child, append(path[:len(path):len(path)], child.Name), recycleBin)
+	}
+}
+
+// unprotect - расшифровать защищенные значения. Шифр потоковый, поэтому значения
+// обрабатываются строго в порядке документа, включая историю записей.
+func unprotect(document []byte, stream cipher.Stream) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(document))
+	var buf bytes.Buffer
+	encoder := xml.NewEncoder(&buf)
+
+	protected := false
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			protected = false
+			for _, attr := range t.Attr {
+				if attr.Name.Local == "Protected" && strings.EqualFold(attr.Value, "True") {
+					protected = true
+				}
+			}
+		case xml.EndElement:
+			protected = false
+		case xml.CharData:
+			if protected {
+				value, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(t)))
+				if err != nil {
+					return nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
+				}
+				stream.XORKeyStream(value, value)
+				token = xml.CharData(value)
+				protected = false
+			}
+		case xml.ProcInst:
+			// Заголовок документа не нужен для разбора
+			continue
+		}
+
+		if err = encoder.EncodeToken(xml.CopyToken(token)); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
+		}
+	}
+	if err := encoder.Flush(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// parseTime - разобрать время KDBX 4 (секунды от 0001-01-01 в base64) или в формате RFC 3339
+func parseTime(text string) *time.Time {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil
+	}
+
+	if raw, err := base64.StdEncoding.DecodeString(text); err == nil && len(raw) == 8 {
+		date := time.Unix(int64(binary.LittleEndian.Uint64(raw))+kdbxEpoch, 0).UTC()
+		return &date
+	}
+
+	if date, err := time.Parse(time.RFC3339, text); err == nil {
+		return &date
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package importer
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/importer/kdbx"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// keePassOTPFields - поля KeePass и KeePassXC с секретом TOTP
+var keePassOTPFields = map[string]bool{
+	"otp":                   true,
+	"TimeOtp-Secret-Base32": true,
+	"TOTP Seed":             true,
+}
+
+// parseKeePass - разобрать базу KeePass
+func parseKeePass(content []byte, options Options) (*Result, error) {
+	db, err := kdbx.Read(bytes.NewReader(content), options.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	for _, entry := range db.Entries {
+		title := entry.Get(kdbx.FieldTitle)
+		login, password, entryURL := entry.Get(kdbx.FieldUserName), entry.Get(kdbx.FieldPassword), entry.Get(kdbx.FieldURL)
+
+		var item Item
+		if login == "" && password == "" && entryURL == "" {
+			notes := entry.Get(kdbx.FieldNotes)
+			if notes == "" {
+				result.Skipped = append(result.Skipped, Skipped{Name: title, Reason: i18n.T("import.empty_entry")})
+				continue
+			}
+			item, err = newItem(models.DataTypeNote, title, &models.NoteValue{Markdown: notes})
+		} else {
+			item, err = newItem(models.DataTypeCredentials, title, &models.CredentialsValue{
+				Login:    login,
+				Password: password,
+			})
+			item.addURL(entryURL, models.URLMatchDomain)
+			item.addField(FieldNotes, entry.Get(kdbx.FieldNotes), models.FieldTypeText)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, field := range entry.Fields {
+			switch {
+			case field.Key == kdbx.FieldTitle || field.Key == kdbx.FieldUserName || field.Key == kdbx.FieldPassword ||
+				field.Key == kdbx.FieldURL || field.Key == kdbx.FieldNotes:
+			case keePassOTPFields[field.Key]:
+				item.addField(FieldOTP, field.Value, models.FieldTypeHidden)
+			case field.Protected:
+				item.addField(field.Key, field.Value, models.FieldTypeHidden)
+			default:
+				item.addField(field.Key, field.Value, models.FieldTypeText)
+			}
+		}
+		item.addField(FieldTags, entry.Tags, models.FieldTypeText)
+		item.setFolder(strings.Join(entry.Path, "/"))
+		item.Data.ExpiresAt = entry.Expires
+
+		result.Items = append(result.Items, item)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,332 @@
+package importer
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// errNoExportData - в архиве 1PUX нет файла export.data
+var errNoExportData = errors.New("export.data not found in 1pux archive")
+
+// onePasswordExportData - файл с записями внутри архива 1PUX
+const onePasswordExportData = "export.data"
+
+// Категории записей 1Password
+const (
+	onePasswordLogin         = "001"
+	onePasswordCreditCard    = "002"
+	onePasswordSecureNote    = "003"
+	onePasswordIdentity      = "004"
+	onePasswordPassword      = "005"
+	onePasswordDriverLicense = "103"
+	onePasswordPassport      = "106"
+	onePasswordWirelessRoute = "109"
+	onePasswordAPICredential = "112"
+	onePasswordSSHKey        = "114"
+)
+
+type onePasswordExport struct {
+	Accounts []struct {
+		Vaults []struct {
+			Attrs struct {
+				Name string `json:"name"`
+			} `json:"attrs"`
+			Items []onePasswordItem `json:"items"`
+		} `json:"vaults"`
+	} `json:"accounts"`
+}
+
+type onePasswordItem struct {
+	CategoryUUID string `json:"categoryUuid"`
+	State        string `json:"state"`
+	Details      struct {
+		LoginFields []struct {
+			Value       string `json:"value"`
+			Name        string `json:"name"`
+			FieldType   string `json:"fieldType"`
+			Designation string `json:"designation"`
+		} `json:"loginFields"`
+		NotesPlain string `json:"notesPlain"`
+		Password   string `json:"password"`
+		Sections   []struct {
+			Title  string             `json:"title"`
+			Fields []onePasswordField `json:"fields"`
+		} `json:"sections"`
+	} `json:"details"`
+	Overview struct {
+		Title string `json:"title"`
+		URL   string `json:"url"`
+		URLs  []struct {
+			URL string `json:"url"`
+		} `json:"urls"`
+		Tags []string `json:"tags"`
+	} `json:"overview"`
+}
+
+type onePasswordField struct {
+	Title string                     `json:"title"`
+	ID    string                     `json:"id"`
+	Value map[string]json.RawMessage `json:"value"`
+}
+
+// parseOnePassword - разобрать экспорт 1Password: архив 1PUX или CSV
+func parseOnePassword(content []byte, options Options) (*Result, error) {
+	if !bytes.HasPrefix(content, []byte("PK\x03\x04")) {
+		return parseCSV(content, options)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, err
+	}
+
+	var export onePasswordExport
+	found := false
+	for _, file := range archive.File {
+		if file.Name != onePasswordExportData {
+			continue
+		}
+		reader, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		err = json.NewDecoder(reader).Decode(&export)
+		reader.Close()
+		if err != nil {
+			return nil, err
+		}
+		found = true
+	}
+	if !found {
+		return nil, errNoExportData
+	}
+
+	result := &Result{}
+	for _, account := range export.Accounts {
+		for _, vault := range account.Vaults {
+			for _, opItem := range vault.Items {
+				if opItem.State == "trashed" {
+					continue
+				}
+
+				item, err := onePasswordToItem(opItem)
+				if err != nil {
+					return nil, err
+				}
+				item.addField(FieldTags, strings.Join(opItem.Overview.Tags, ";"), models.FieldTypeText)
+				item.setFolder(vault.Attrs.Name)
+
+				result.Items = append(result.Items, item)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// onePasswordToItem - преобразовать запись 1Password. Поля разделов, которые не
+// соответствуют полям записи, переносятся в пользовательские поля.
+func onePasswordToItem(opItem onePasswordItem) (Item, error) {
+	fields := make(map[string]string)
+	var custom []onePasswordField
+	for _, section := range opItem.Details.Sections {
+		for _, field := range section.Fields {
+			text, _ := field.text()
+			if _, ok := fields[field.ID]; !ok && field.ID != "" {
+				fields[field.ID] = text
+			}
+			custom = append(custom, field)
+		}
+	}
+
+	// used - поля разделов, перенесенные в значение записи
+	used := make(map[string]bool)
+	take := func(ids ...string) string {
+		for _, id := range ids {
+			if value := fields[id]; value != "" {
+				used[id] = true
+				return value
+			}
+		}
+		return ""
+	}
+
+	var (
+		dataType models.DataType
+		value    interface{}
+	)
+	title := opItem.Overview.Title
+
+	switch opItem.CategoryUUID {
+	case onePasswordCreditCard:
+		dataType = models.DataTypeBankCard
+		date := take("expiry")
+		if len(date) == len("2006-01") {
+			date = date[5:] + "/" + date[2:4]
+		}
+		value = &models.BankCardValue{Number: take("ccnum"), Date: date, Secure: take("cvv")}
+	case onePasswordSecureNote:
+		dataType = models.DataTypeNote
+		value = &models.NoteValue{Markdown: opItem.Details.NotesPlain}
+	case onePasswordIdentity:
+		dataType = models.DataTypeIdentity
+		value = &models.IdentityValue{
+			Kind:     "identity",
+			FullName: joinNonEmpty(" ", take("firstname"), take("initial"), take("lastname")),
+		}
+	case onePasswordPassport, onePasswordDriverLicense:
+		dataType = models.DataTypeIdentity
+		kind := "passport"
+		if opItem.CategoryUUID == onePasswordDriverLicense {
+			kind = "license"
+		}
+		value = &models.IdentityValue{
+			Kind:       kind,
+			Number:     take("number"),
+			FullName:   take("fullname"),
+			IssuedBy:   take("issuing_authority", "state", "issuing_country"),
+			IssueDate:  take("issue_date"),
+			ExpiryDate: take("expiry_date"),
+		}
+	case onePasswordWirelessRoute:
+		dataType = models.DataTypeWiFi
+		value = &models.WiFiValue{
+			SSID:     take("network_name"),
+			Password: take("wireless_password", "password"),
+			Security: take("wireless_security"),
+		}
+	case onePasswordAPICredential:
+		dataType = models.DataTypeAPIKey
+		value = &models.APIKeyValue{
+			Provider: take("type"),
+			KeyID:    take("username"),
+			Secret:   take("credential"),
+			Endpoint: take("hostname"),
+		}
+	case onePasswordSSHKey:
+		dataType = models.DataTypeSSHKey
+		sshKey := &models.SSHKeyValue{}
+		for _, field := range custom {
+			if raw, ok := field.Value["sshKey"]; ok {
+				var key struct {
+					PrivateKey string `json:"privateKey"`
+					Metadata   struct {
+						PublicKey string `json:"publicKey"`
+					} `json:"metadata"`
+				}
+				if err := json.Unmarshal(raw, &key); err == nil {
+					sshKey.PrivateKey, sshKey.PublicKey = key.PrivateKey, key.Metadata.PublicKey
+					used[field.ID] = true
+				}
+			}
+		}
+		value = sshKey
+	default:
+		// Логины, пароли и остальные категории (серверы, базы данных) переносятся как учетные данные
+		dataType = models.DataTypeCredentials
+		credentials := &models.CredentialsValue{Password: opItem.Details.Password}
+		for _, field := range opItem.Details.LoginFields {
+			switch {
+			case field.Designation == "username" && credentials.Login == "":
+				credentials.Login = field.Value
+			case field.Designation == "password" && credentials.Password == "":
+				credentials.Password = field.Value
+			}
+		}
+		if credentials.Login == "" {
+			credentials.Login = take("username")
+		}
+		if credentials.Password == "" {
+			credentials.Password = take("password")
+		}
+		value = credentials
+	}
+
+	item, err := newItem(dataType, title, value)
+	if err != nil {
+		return item, err
+	}
+
+	if dataType == models.DataTypeCredentials || opItem.CategoryUUID == onePasswordLogin {
+		item.addURL(opItem.Overview.URL, models.URLMatchDomain)
+		for _, opURL := range opItem.Overview.URLs {
+			item.addURL(opURL.URL, models.URLMatchDomain)
+		}
+	}
+
+	for _, field := range custom {
+		if used[field.ID] {
+			continue
+		}
+		text, fieldType := field.text()
+		name := field.Title
+		if name == "" {
+			name = field.ID
+		}
+		if _, ok := field.Value["totp"]; ok {
+			name = FieldOTP
+		}
+		item.addField(name, text, fieldType)
+	}
+
+	if dataType != models.DataTypeNote {
+		item.addField(FieldNotes, opItem.Details.NotesPlain, models.FieldTypeText)
+	}
+
+	return item, nil
+}
+
+// text - значение поля раздела в виде строки и тип пользовательского поля для него
+func (field onePasswordField) text() (string, models.FieldType) {
+	for kind, raw := range field.Value {
+		var text string
+		if err := json.Unmarshal(raw, &text); err == nil {
+			switch kind {
+			case "concealed", "totp":
+				return text, models.FieldTypeHidden
+			case "url":
+				return text, models.FieldTypeURL
+			}
+			return text, models.FieldTypeText
+		}
+
+		var number int64
+		if err := json.Unmarshal(raw, &number); err == nil {
+			switch kind {
+			case "date":
+				return time.Unix(number, 0).UTC().Format(time.DateOnly), models.FieldTypeDate
+			case "monthYear":
+				return fmt.Sprintf("%04d-%02d", number/100, number%100), models.FieldTypeText
+			}
+			return strconv.FormatInt(number, 10), models.FieldTypeText
+		}
+
+		var object map[string]interface{}
+		if err := json.Unmarshal(raw, &object); err == nil {
+			switch kind {
+			case "email":
+				if address, ok := object["email_address"].(string); ok {
+					return address, models.FieldTypeText
+				}
+			case "address":
+				parts := make([]string, 0, len(object))
+				for _, key := range []string{"street", "city", "state", "zip", "country"} {
+					if part, ok := object[key].(string); ok {
+						parts = append(parts, part)
+					}
+				}
+				return joinNonEmpty(", ", parts...), models.FieldTypeText
+			}
+		}
+	}
+
+	return "", models.FieldTypeText
+}
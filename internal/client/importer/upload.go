@@ -0,0 +1,37 @@
+package importer
+
+import (
+	"context"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/http"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+)
+
+// Upload - создать записи на сервере пакетами. Результаты возвращаются в порядке items,
+// Index результата указывает на запись в items.
+func Upload(ctx context.Context, client http.ClientInterface, items []Item) ([]models.DataBatchResult, error) {
+	results := make([]models.DataBatchResult, 0, len(items))
+	for start := 0; start < len(items); start += commonRequests.DataBatchMaxOperations {
+		end := min(start+commonRequests.DataBatchMaxOperations, len(items))
+
+		operations := make([]commonRequests.DataBatchOperation, 0, end-start)
+		for i := start; i < end; i++ {
+			operations = append(operations, commonRequests.DataBatchOperation{
+				Op:   models.DataBatchCreate,
+				Data: &items[i].Data,
+			})
+		}
+
+		chunk, err := client.Batch(ctx, commonRequests.DataBatch{Operations: operations})
+		if err != nil {
+			return results, err
+		}
+		for _, result := range chunk {
+			result.Index += start
+			results = append(results, result)
+		}
+	}
+
+	return results, nil
+}
@@ -0,0 +1,186 @@
+package records
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+var (
+	// ErrUnknownType - неизвестный тип записи
+	ErrUnknownType = errors.New("unknown data type")
+	// ErrUnknownField - в записи нет такого поля
+	ErrUnknownField = errors.New("unknown field")
+)
+
+// secretFields - поля значений, которые не показываются без явного запроса
+var secretFields = map[string]bool{
+	"password":    true,
+	"secure":      true,
+	"passphrase":  true,
+	"secret":      true,
+	"private_key": true,
+}
+
+// Field - поле записи в плоском виде: поле значения или пользовательское поле
+type Field struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Secret bool   `json:"secret,omitempty"`
+	Custom bool   `json:"custom,omitempty"`
+}
+
+// ByName - найти тип записи по короткому имени (credentials, card, ssh...)
+func ByName(name string) (TypeInfo, bool) {
+	for _, typeInfo := range Types {
+		if strings.EqualFold(typeInfo.Name, name) {
+			return typeInfo, true
+		}
+	}
+
+	return TypeInfo{}, false
+}
+
+// Name - короткое имя типа записи
+func Name(dataType models.DataType) string {
+	index := Index(dataType)
+	if index < 0 {
+		return ""
+	}
+
+	return Types[index].Name
+}
+
+// ValueFields - поля значения и пользовательские поля записи
+func ValueFields(data models.DataInfo) ([]Field, error) {
+	value := models.NewValue(data.Type)
+	if value == nil {
+		return nil, ErrUnknownType
+	}
+
+	err := models.DecodeValue(data.Value, value)
+	if err != nil {
+		return nil, fmt.Errorf("decode value: %w", err)
+	}
+
+	elem := reflect.ValueOf(value).Elem()
+	fields := make([]Field, 0, elem.NumField()+len(data.Fields))
+	for i := 0; i < elem.NumField(); i++ {
+		name := snakeCase(elem.Type().Field(i).Name)
+		fields = append(fields, Field{
+			Name:   name,
+			Value:  elem.Field(i).String(),
+			Secret: secretFields[name],
+		})
+	}
+
+	for _, field := range data.Fields {
+		fields = append(fields, Field{
+			Name:   field.Name,
+			Value:  field.Value,
+			Secret: field.Type == models.FieldTypeHidden,
+			Custom: true,
+		})
+	}
+
+	return fields, nil
+}
+
+// FolderField - пользовательское поле с папкой записи
+const FolderField = "folder"
+
+// Folder - папка записи из пользовательского поля folder
+func Folder(data models.DataInfo) string {
+	for _, field := range data.Fields {
+		if field.Name == FolderField {
+			return field.Value
+		}
+	}
+
+	return ""
+}
+
+// FindField - найти поле по имени без учета регистра
+func FindField(fields []Field, name string) (Field, error) {
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, name) {
+			return field, nil
+		}
+	}
+
+	return Field{}, fmt.Errorf("%w: %s", ErrUnknownField, name)
+}
+
+// ValueFieldNames - имена полей значения для типа записи
+func ValueFieldNames(dataType models.DataType) []string {
+	value := models.NewValue(dataType)
+	if value == nil {
+		return nil
+	}
+
+	elemType := reflect.TypeOf(value).Elem()
+	names := make([]string, 0, elemType.NumField())
+	for i := 0; i < elemType.NumField(); i++ {
+		names = append(names, snakeCase(elemType.Field(i).Name))
+	}
+
+	return names
+}
+
+// SetValueField - установить поле значения по имени (login, private_key...)
+func SetValueField(value interface{}, name string, text string) error {
+	elem := reflect.ValueOf(value)
+	if elem.Kind() != reflect.Pointer || elem.Elem().Kind() != reflect.Struct {
+		return ErrUnknownType
+	}
+	elem = elem.Elem()
+
+	for i := 0; i < elem.NumField(); i++ {
+		if strings.EqualFold(snakeCase(elem.Type().Field(i).Name), name) {
+			elem.Field(i).SetString(text)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: %s", ErrUnknownField, name)
+}
+
+// snakeCase - имя поля структуры в snake_case (PrivateKey -> private_key, SSID -> ssid)
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var builder strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+				builder.WriteRune('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		builder.WriteRune(r)
+	}
+
+	return builder.String()
+}
+
+// copyFields - поле, которое копируется в буфер обмена, если поле не указано явно
+var copyFields = map[models.DataType]string{
+	models.DataTypeCredentials: "password",
+	models.DataTypeText:        "text",
+	models.DataTypeBinary:      "binary",
+	models.DataTypeBankCard:    "number",
+	models.DataTypeSSHKey:      "public_key",
+	models.DataTypeIdentity:    "number",
+	models.DataTypeNote:        "markdown",
+	models.DataTypeWiFi:        "password",
+	models.DataTypeAPIKey:      "secret",
+}
+
+// CopyField - имя поля, которое копируется для типа записи по умолчанию
+func CopyField(dataType models.DataType) string {
+	return copyFields[dataType]
+}
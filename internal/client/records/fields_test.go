@@ -0,0 +1,45 @@
+package records_test
+
+import (
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValueFields(t *testing.T) {
+	value, err := models.EncodeValue(models.SSHKeyValue{PrivateKey: "key", PublicKey: "pub"})
+	assert.Nil(t, err)
+
+	fields, err := records.ValueFields(models.DataInfo{
+		Type:   models.DataTypeSSHKey,
+		Value:  value,
+		Fields: models.DataFields{{Name: "Host", Type: models.FieldTypeText, Value: "example.com"}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []records.Field{
+		{Name: "private_key", Value: "key", Secret: true},
+		{Name: "public_key", Value: "pub"},
+		{Name: "passphrase", Value: "", Secret: true},
+		{Name: "Host", Value: "example.com", Custom: true},
+	}, fields)
+
+	field, err := records.FindField(fields, "host")
+	assert.Nil(t, err)
+	assert.Equal(t, "example.com", field.Value)
+
+	_, err = records.FindField(fields, "password")
+	assert.ErrorIs(t, err, records.ErrUnknownField)
+}
+
+func TestSetValueField(t *testing.T) {
+	value := &models.APIKeyValue{}
+	assert.Nil(t, records.SetValueField(value, "key_id", "AK"))
+	assert.Nil(t, records.SetValueField(value, "Secret", "s3cr3t"))
+	assert.ErrorIs(t, records.SetValueField(value, "token", "x"), records.ErrUnknownField)
+	assert.Equal(t, models.APIKeyValue{KeyID: "AK", Secret: "s3cr3t"}, *value)
+
+	assert.Equal(t, []string{"ssid", "password", "security"}, records.ValueFieldNames(models.DataTypeWiFi))
+	assert.Equal(t, []string{"kind", "number", "full_name", "issued_by", "issue_date", "expiry_date"}, records.ValueFieldNames(models.DataTypeIdentity))
+}
@@ -0,0 +1,154 @@
+// Package records содержит описание типов записей и действия, специфичные для типа
+package records
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"golang.org/x/crypto/ssh"
+)
+
+// ErrNoSSHKey - в записи нет ключа, по которому можно посчитать отпечаток
+var ErrNoSSHKey = errors.New("ssh key is empty")
+
+// TypeInfo - описание типа записи
+type TypeInfo struct {
+	Type models.DataType
+	Name string
+}
+
+// Types - поддерживаемые типы записей в порядке отображения
+var Types = []TypeInfo{
+	{Type: models.DataTypeCredentials, Name: "credentials"},
+	{Type: models.DataTypeText, Name: "text"},
+	{Type: models.DataTypeBinary, Name: "binary"},
+	{Type: models.DataTypeBankCard, Name: "card"},
+	{Type: models.DataTypeSSHKey, Name: "ssh"},
+	{Type: models.DataTypeIdentity, Name: "identity"},
+	{Type: models.DataTypeNote, Name: "note"},
+	{Type: models.DataTypeWiFi, Name: "wifi"},
+	{Type: models.DataTypeAPIKey, Name: "apikey"},
+}
+
+// ExpirySoonDays - за сколько дней до срока запись считается истекающей
+const ExpirySoonDays = 14
+
+// WiFiSecurityTypes - варианты защиты Wi-Fi сети
+var WiFiSecurityTypes = []string{"WPA", "WEP", "nopass"}
+
+// Index - получить позицию типа в списке Types
+func Index(dataType models.DataType) int {
+	for i, typeInfo := range Types {
+		if typeInfo.Type == dataType {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Title - получить название типа записи
+func Title(dataType models.DataType) string {
+	index := Index(dataType)
+	if index < 0 {
+		return ""
+	}
+
+	return i18n.T("type." + Types[index].Name)
+}
+
+// Titles - получить названия всех типов записей
+func Titles() []string {
+	titles := make([]string, 0, len(Types))
+	for _, typeInfo := range Types {
+		titles = append(titles, Title(typeInfo.Type))
+	}
+
+	return titles
+}
+
+// MaskNumber - замаскировать номер, оставив последние 4 символа
+func MaskNumber(number string) string {
+	number = strings.ReplaceAll(strings.TrimSpace(number), " ", "")
+	length := utf8.RuneCountInString(number)
+	if length == 0 {
+		return ""
+	}
+	if length <= 4 {
+		return strings.Repeat("•", length)
+	}
+
+	runes := []rune(number)
+
+	return "•••• " + string(runes[length-4:])
+}
+
+// SSHFingerprint - получить SHA256 отпечаток открытого ключа.
+// Если открытый ключ не указан, он вычисляется из закрытого.
+func SSHFingerprint(value models.SSHKeyValue) (string, error) {
+	publicKey, err := SSHPublicKey(value)
+	if err != nil {
+		return "", err
+	}
+
+	return ssh.FingerprintSHA256(publicKey), nil
+}
+
+// SSHPublicKey - получить открытый ключ записи
+func SSHPublicKey(value models.SSHKeyValue) (ssh.PublicKey, error) {
+	if strings.TrimSpace(value.PublicKey) != "" {
+		publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(value.PublicKey))
+		if err != nil {
+			return nil, fmt.Errorf("parse public key: %w", err)
+		}
+
+		return publicKey, nil
+	}
+
+	signer, err := SSHSigner(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return signer.PublicKey(), nil
+}
+
+// SSHSigner - разобрать закрытый ключ записи
+func SSHSigner(value models.SSHKeyValue) (ssh.Signer, error) {
+	if strings.TrimSpace(value.PrivateKey) == "" {
+		return nil, ErrNoSSHKey
+	}
+
+	var (
+		signer ssh.Signer
+		err    error
+	)
+	if value.Passphrase != "" {
+		signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(value.PrivateKey), []byte(value.Passphrase))
+	} else {
+		signer, err = ssh.ParsePrivateKey([]byte(value.PrivateKey))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parse private key: %w", err)
+	}
+
+	return signer, nil
+}
+
+// WiFiConnectionString - строка подключения к Wi-Fi (формат QR-кодов)
+func WiFiConnectionString(value models.WiFiValue) string {
+	escape := strings.NewReplacer(`\`, `\\`, `;`, `\;`, `,`, `\,`, `:`, `\:`, `"`, `\"`)
+	security := value.Security
+	if security == "" {
+		security = "WPA"
+	}
+	if value.Password == "" {
+		security = "nopass"
+	}
+
+	return fmt.Sprintf("WIFI:T:%s;S:%s;P:%s;;", security, escape.Replace(value.SSID), escape.Replace(value.Password))
+}
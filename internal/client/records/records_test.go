@@ -0,0 +1,82 @@
+package records_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+)
+
+func TestMaskNumber(t *testing.T) {
+	tests := []struct {
+		name   string
+		number string
+		want   string
+	}{
+		{name: "empty", number: "", want: ""},
+		{name: "short", number: "123", want: "•••"},
+		{name: "card", number: "2200 1234 4321 9876", want: "•••• 9876"},
+		{name: "passport", number: "4510123456", want: "•••• 3456"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, records.MaskNumber(tt.number))
+		})
+	}
+}
+
+func TestSSHFingerprint(t *testing.T) {
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+
+	sshPublicKey, err := ssh.NewPublicKey(publicKey)
+	assert.Nil(t, err)
+	want := ssh.FingerprintSHA256(sshPublicKey)
+
+	block, err := ssh.MarshalPrivateKey(privateKey, "")
+	assert.Nil(t, err)
+
+	t.Run("from public key", func(t *testing.T) {
+		got, err := records.SSHFingerprint(models.SSHKeyValue{
+			PublicKey: string(ssh.MarshalAuthorizedKey(sshPublicKey)),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("from private key", func(t *testing.T) {
+		got, err := records.SSHFingerprint(models.SSHKeyValue{
+			PrivateKey: string(pem.EncodeToMemory(block)),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, want, got)
+	})
+
+	t.Run("empty key", func(t *testing.T) {
+		_, err := records.SSHFingerprint(models.SSHKeyValue{})
+		assert.ErrorIs(t, err, records.ErrNoSSHKey)
+	})
+}
+
+func TestWiFiConnectionString(t *testing.T) {
+	assert.Equal(t, `WIFI:T:WPA;S:home\;net;P:pa\:ss;;`, records.WiFiConnectionString(models.WiFiValue{
+		SSID:     "home;net",
+		Password: "pa:ss",
+	}))
+	assert.Equal(t, "WIFI:T:nopass;S:guest;P:;;", records.WiFiConnectionString(models.WiFiValue{
+		SSID:     "guest",
+		Security: "WPA",
+	}))
+}
+
+func TestIndex(t *testing.T) {
+	assert.Equal(t, 0, records.Index(models.DataTypeCredentials))
+	assert.Equal(t, -1, records.Index(models.DataTypeUnknown))
+	assert.Equal(t, "Заметки", records.Title(models.DataTypeNote))
+	assert.Equal(t, len(records.Types), len(records.Titles()))
+}
@@ -0,0 +1,136 @@
+package records
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrNoOTP - в записи нет поля с секретом одноразовых кодов
+var ErrNoOTP = errors.New("otp secret is empty")
+
+// otpFieldNames - имена пользовательских полей, в которых хранится секрет TOTP
+var otpFieldNames = []string{"otp", "totp"}
+
+// TOTPParams - параметры генерации одноразовых кодов (RFC 6238)
+type TOTPParams struct {
+	Secret    []byte
+	Digits    int
+	Period    time.Duration
+	Algorithm func() hash.Hash
+}
+
+// ParseTOTP - разобрать секрет TOTP: ссылку otpauth://totp/... или секрет в base32
+func ParseTOTP(text string) (TOTPParams, error) {
+	params := TOTPParams{
+		Digits:    6,
+		Period:    30 * time.Second,
+		Algorithm: sha1.New,
+	}
+
+	text = strings.TrimSpace(text)
+	secret := text
+	if strings.HasPrefix(strings.ToLower(text), "otpauth://") {
+		uri, err := url.Parse(text)
+		if err != nil {
+			return params, fmt.Errorf("parse otpauth uri: %w", err)
+		}
+		if !strings.EqualFold(uri.Host, "totp") {
+			return params, fmt.Errorf("unsupported otp type %q", uri.Host)
+		}
+
+		query := uri.Query()
+		secret = query.Get("secret")
+		if digits := query.Get("digits"); digits != "" {
+			params.Digits, err = strconv.Atoi(digits)
+			if err != nil || params.Digits < 6 || params.Digits > 10 {
+				return params, fmt.Errorf("invalid otp digits %q", digits)
+			}
+		}
+		if period := query.Get("period"); period != "" {
+			seconds, err := strconv.Atoi(period)
+			if err != nil || seconds <= 0 {
+				return params, fmt.Errorf("invalid otp period %q", period)
+			}
+			params.Period = time.Duration(seconds) * time.Second
+		}
+		switch strings.ToUpper(query.Get("algorithm")) {
+		case "", "SHA1":
+		case "SHA256":
+			params.Algorithm = sha256.New
+		case "SHA512":
+			params.Algorithm = sha512.New
+		default:
+			return params, fmt.Errorf("unsupported otp algorithm %q", query.Get("algorithm"))
+		}
+	}
+
+	secret = strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(secret))
+	if secret == "" {
+		return params, ErrNoOTP
+	}
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(strings.TrimRight(secret, "="))
+	if err != nil {
+		return params, fmt.Errorf("decode otp secret: %w", err)
+	}
+	params.Secret = key
+
+	return params, nil
+}
+
+// Code - одноразовый код на момент now
+func (params TOTPParams) Code(now time.Time) string {
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(now.Unix()/int64(params.Period.Seconds())))
+
+	mac := hmac.New(params.Algorithm, params.Secret)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint64(1)
+	for i := 0; i < params.Digits; i++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", params.Digits, uint64(code)%modulo)
+}
+
+// Remaining - сколько осталось до смены кода
+func (params TOTPParams) Remaining(now time.Time) time.Duration {
+	period := int64(params.Period.Seconds())
+
+	return time.Duration(period-now.Unix()%period) * time.Second
+}
+
+// OTPCode - текущий одноразовый код записи по пользовательскому полю otp или totp
+func OTPCode(fields []Field, now time.Time) (string, error) {
+	for _, name := range otpFieldNames {
+		field, err := FindField(fields, name)
+		if err != nil {
+			continue
+		}
+
+		params, err := ParseTOTP(field.Value)
+		if err != nil {
+			return "", err
+		}
+
+		return params.Code(now), nil
+	}
+
+	return "", ErrNoOTP
+}
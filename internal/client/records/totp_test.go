@@ -0,0 +1,50 @@
+package records_test
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTOTPCode(t *testing.T) {
+	// Тестовые значения из RFC 6238, приложение B
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	secret256 := base32.StdEncoding.EncodeToString([]byte("12345678901234567890123456789012"))
+
+	tests := []struct {
+		name string
+		text string
+		time int64
+		want string
+	}{
+		{name: "base32", text: secret, time: 59, want: "287082"},
+		{name: "uri sha1", text: "otpauth://totp/test?secret=" + secret + "&digits=8", time: 1111111109, want: "07081804"},
+		{name: "uri sha256", text: "otpauth://totp/test?secret=" + secret256 + "&digits=8&algorithm=SHA256", time: 20000000000, want: "77737706"},
+		{name: "пробелы и нижний регистр", text: "gezd gnbv gy3t qojq gezd gnbv gy3t qojq", time: 59, want: "287082"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params, err := records.ParseTOTP(tt.text)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, params.Code(time.Unix(tt.time, 0)))
+		})
+	}
+}
+
+func TestOTPCode(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+
+	code, err := records.OTPCode([]records.Field{{Name: "TOTP", Value: secret, Custom: true}}, time.Unix(59, 0))
+	assert.Nil(t, err)
+	assert.Equal(t, "287082", code)
+
+	_, err = records.OTPCode([]records.Field{{Name: "login", Value: "user"}}, time.Now())
+	assert.ErrorIs(t, err, records.ErrNoOTP)
+
+	_, err = records.ParseTOTP("otpauth://hotp/test?secret=" + secret)
+	assert.Error(t, err)
+}
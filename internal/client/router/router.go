@@ -6,4 +6,12 @@ const (
 	RegisterPage    = "register"
 	ErrorPage       = "error"
 	DataPage        = "data"
+	FieldPage       = "field"
+	ConfirmPage     = "confirm"
+	GeneratorPage   = "generator"
+	AuditPage       = "audit"
+	SSHConfirmPage  = "sshConfirm"
+	SearchPage      = "search"
+	HelpPage        = "help"
+	FilePage        = "file"
 )
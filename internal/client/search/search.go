@@ -0,0 +1,149 @@
+// Package search содержит нечеткий поиск записей по описанию, логину и адресам.
+// Поиск выполняется на клиенте по расшифрованным записям.
+package search
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// Веса совпадений
+const (
+	scoreMatch       = 1
+	scoreConsecutive = 5
+	scoreWordStart   = 8
+	scoreSubstring   = 20
+	scoreDescription = 10
+)
+
+// Result - найденная запись
+type Result struct {
+	Data  models.DataInfo
+	Login string
+	Score int
+}
+
+// entry - запись с текстом, по которому идет поиск
+type entry struct {
+	data  models.DataInfo
+	login string
+	texts []string
+}
+
+// Index - записи, подготовленные для поиска
+type Index struct {
+	entries []entry
+}
+
+// NewIndex - подготовить записи к поиску. Логин расшифровывается один раз для учетных данных.
+func NewIndex(dataList []models.DataInfo) *Index {
+	entries := make([]entry, 0, len(dataList))
+	for _, data := range dataList {
+		item := entry{data: data, texts: []string{data.Description}}
+		if data.Type == models.DataTypeCredentials {
+			value := models.CredentialsValue{}
+			if err := models.DecodeValue(data.Value, &value); err == nil {
+				item.login = value.Login
+			}
+		}
+		item.texts = append(item.texts, item.login)
+		for _, dataURL := range data.URLs {
+			item.texts = append(item.texts, dataURL.URL)
+		}
+		entries = append(entries, item)
+	}
+
+	return &Index{entries: entries}
+}
+
+// Find - записи, подходящие под запрос, от лучших совпадений к худшим.
+// Пустой запрос возвращает все записи по алфавиту.
+func (index *Index) Find(query string) []Result {
+	query = strings.TrimSpace(query)
+	res := make([]Result, 0, len(index.entries))
+	for _, item := range index.entries {
+		best, found := 0, query == ""
+		for i, text := range item.texts {
+			score, ok := Score(query, text)
+			if !ok {
+				continue
+			}
+			// Совпадение в описании важнее совпадения в логине или адресе
+			if i == 0 {
+				score += scoreDescription
+			}
+			if !found || score > best {
+				best, found = score, true
+			}
+		}
+		if found {
+			res = append(res, Result{Data: item.data, Login: item.login, Score: best})
+		}
+	}
+
+	slices.SortStableFunc(res, func(a, b Result) int {
+		if a.Score != b.Score {
+			return cmp.Compare(b.Score, a.Score)
+		}
+		return cmp.Compare(strings.ToLower(a.Data.Description), strings.ToLower(b.Data.Description))
+	})
+
+	return res
+}
+
+// Score - оценить, насколько текст подходит под запрос. Все символы запроса должны
+// встречаться в тексте по порядку без учета регистра; подряд идущие символы, начала слов
+// и вхождение запроса целиком оцениваются выше.
+func Score(query string, text string) (int, bool) {
+	if query == "" {
+		return 0, true
+	}
+
+	queryRunes := []rune(strings.ToLower(query))
+	textRunes := []rune(strings.ToLower(text))
+	score := 0
+	last := -2
+	position := 0
+	for _, queryRune := range queryRunes {
+		if unicode.IsSpace(queryRune) {
+			continue
+		}
+
+		found := false
+		for ; position < len(textRunes); position++ {
+			if textRunes[position] != queryRune {
+				continue
+			}
+
+			score += scoreMatch
+			if position == last+1 {
+				score += scoreConsecutive
+			}
+			if position == 0 || !isWordRune(textRunes[position-1]) {
+				score += scoreWordStart
+			}
+			last = position
+			position++
+			found = true
+			break
+		}
+		if !found {
+			return 0, false
+		}
+	}
+
+	if strings.Contains(string(textRunes), string(queryRunes)) {
+		score += scoreSubstring
+	}
+
+	return score, true
+}
+
+// isWordRune - символ является частью слова
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
@@ -0,0 +1,57 @@
+package search_test
+
+import (
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/search"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScore(t *testing.T) {
+	_, ok := search.Score("gh", "GitHub")
+	assert.True(t, ok)
+	_, ok = search.Score("hg", "GitHub")
+	assert.False(t, ok)
+	_, ok = search.Score("почта", "Рабочая Почта")
+	assert.True(t, ok)
+
+	// Вхождение целиком и начала слов ценнее разбросанных символов
+	substring, _ := search.Score("hub", "GitHub")
+	scattered, _ := search.Score("hub", "hello ubuntu")
+	assert.Greater(t, substring, scattered)
+
+	wordStart, _ := search.Score("gm", "google mail")
+	middle, _ := search.Score("gm", "programs")
+	assert.Greater(t, wordStart, middle)
+}
+
+func TestIndexFind(t *testing.T) {
+	value, err := models.EncodeValue(models.CredentialsValue{Login: "alice@example.com", Password: "secret"})
+	require.NoError(t, err)
+
+	index := search.NewIndex([]models.DataInfo{
+		{ID: 1, Type: models.DataTypeCredentials, Description: "Mail", Value: value},
+		{ID: 2, Type: models.DataTypeNote, Description: "Example notes", Value: "e30="},
+		{ID: 3, Type: models.DataTypeCredentials, Description: "Git", Value: "e30=",
+			URLs: models.DataURLs{{URL: "https://github.com"}}},
+	})
+
+	ids := func(results []search.Result) []uint {
+		res := make([]uint, 0, len(results))
+		for _, result := range results {
+			res = append(res, result.Data.ID)
+		}
+		return res
+	}
+
+	assert.Equal(t, []uint{2, 3, 1}, ids(index.Find("")))
+	assert.Equal(t, []uint{3}, ids(index.Find("github")))
+	assert.Equal(t, []uint{2, 1}, ids(index.Find("example")))
+
+	results := index.Find("alice")
+	require.Len(t, results, 1)
+	assert.Equal(t, "alice@example.com", results[0].Login)
+	assert.Empty(t, index.Find("zzz"))
+}
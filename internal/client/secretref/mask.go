@@ -0,0 +1,89 @@
+package secretref
+
+import (
+	"bytes"
+	"io"
+	"sort"
+	"sync"
+)
+
+// Concealed - замена значений секретов в выводе
+const Concealed = "<concealed by gophkeeper>"
+
+// MaskWriter - скрывает значения секретов в выводе дочернего процесса.
+// Вывод передается дальше сразу, задерживается только хвост, который может оказаться
+// началом секрета, поэтому буфер не больше самого длинного секрета.
+type MaskWriter struct {
+	mu      sync.Mutex
+	w       io.Writer
+	secrets [][]byte
+	buf     []byte
+}
+
+// NewMaskWriter - создать вывод со скрытием секретов
+func NewMaskWriter(w io.Writer, secrets []string) *MaskWriter {
+	mw := &MaskWriter{w: w}
+	for _, secret := range secrets {
+		if secret != "" {
+			mw.secrets = append(mw.secrets, []byte(secret))
+		}
+	}
+	// Длинные секреты заменяются первыми, если один содержит другой
+	sort.Slice(mw.secrets, func(i, j int) bool {
+		return len(mw.secrets[i]) > len(mw.secrets[j])
+	})
+
+	return mw
+}
+
+// Write - записать вывод, все, что не может быть началом секрета, сразу передается дальше
+func (mw *MaskWriter) Write(p []byte) (int, error) {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+
+	mw.buf = append(mw.buf, p...)
+	if err := mw.flush(false); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// Flush - передать задержанный остаток вывода
+func (mw *MaskWriter) Flush() error {
+	mw.mu.Lock()
+	defer mw.mu.Unlock()
+
+	return mw.flush(true)
+}
+
+// flush - передать буфер со скрытыми секретами. Если final = false, хвост буфера,
+// совпадающий с началом секрета, остается в буфере до следующей записи.
+func (mw *MaskWriter) flush(final bool) error {
+	out := make([]byte, 0, len(mw.buf))
+	i := 0
+scan:
+	for i < len(mw.buf) {
+		rest := mw.buf[i:]
+		for _, secret := range mw.secrets {
+			if bytes.HasPrefix(rest, secret) {
+				out = append(out, Concealed...)
+				i += len(secret)
+				continue scan
+			}
+			if !final && len(rest) < len(secret) && bytes.HasPrefix(secret, rest) {
+				break scan
+			}
+		}
+		out = append(out, mw.buf[i])
+		i++
+	}
+
+	mw.buf = append(mw.buf[:0], mw.buf[i:]...)
+	if len(out) == 0 {
+		return nil
+	}
+	_, err := mw.w.Write(out)
+
+	return err
+}
@@ -0,0 +1,156 @@
+// Package secretref разрешает ссылки на поля записей вида gk://<id>/<поле> и
+// gk://<папка>/<название>/<поле> в переменных окружения и шаблонах
+package secretref
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// Scheme - префикс ссылки на поле записи
+const Scheme = "gk://"
+
+var (
+	// ErrInvalidRef - ссылка не соответствует формату
+	ErrInvalidRef = errors.New("invalid secret reference")
+	// ErrNotFound - запись по ссылке не найдена
+	ErrNotFound = errors.New("secret reference not found")
+	// ErrAmbiguous - ссылке соответствует несколько записей
+	ErrAmbiguous = errors.New("secret reference is ambiguous")
+)
+
+// Ref - ссылка на поле записи. Запись задается идентификатором или папкой и названием.
+type Ref struct {
+	ID     uint
+	Folder string
+	Name   string
+	Field  string
+}
+
+// String - ссылка в исходном виде
+func (ref Ref) String() string {
+	if ref.ID != 0 {
+		return fmt.Sprintf("%s%d/%s", Scheme, ref.ID, url.PathEscape(ref.Field))
+	}
+
+	parts := make([]string, 0, 3)
+	if ref.Folder != "" {
+		for _, part := range strings.Split(ref.Folder, "/") {
+			parts = append(parts, url.PathEscape(part))
+		}
+	}
+	parts = append(parts, url.PathEscape(ref.Name), url.PathEscape(ref.Field))
+
+	return Scheme + strings.Join(parts, "/")
+}
+
+// IsRef - строка является ссылкой
+func IsRef(text string) bool {
+	return strings.HasPrefix(text, Scheme)
+}
+
+// Parse - разобрать ссылку. Последняя часть пути - поле, предпоследняя - название
+// или идентификатор записи, остальные - папка. Символ / в названии кодируется как %2F.
+func Parse(text string) (Ref, error) {
+	if !IsRef(text) {
+		return Ref{}, fmt.Errorf("%w: %q has no %s prefix", ErrInvalidRef, text, Scheme)
+	}
+
+	rawParts := strings.Split(strings.TrimPrefix(text, Scheme), "/")
+	if len(rawParts) < 2 {
+		return Ref{}, fmt.Errorf("%w: %q", ErrInvalidRef, text)
+	}
+	parts := make([]string, 0, len(rawParts))
+	for _, rawPart := range rawParts {
+		part, err := url.PathUnescape(rawPart)
+		if err != nil || strings.TrimSpace(part) == "" {
+			return Ref{}, fmt.Errorf("%w: %q", ErrInvalidRef, text)
+		}
+		parts = append(parts, part)
+	}
+
+	ref := Ref{
+		Name:  parts[len(parts)-2],
+		Field: parts[len(parts)-1],
+	}
+	if len(parts) == 2 {
+		if id, err := strconv.ParseUint(ref.Name, 10, 0); err == nil && id > 0 {
+			return Ref{ID: uint(id), Field: ref.Field}, nil
+		}
+	}
+	ref.Folder = strings.Join(parts[:len(parts)-2], "/")
+
+	return ref, nil
+}
+
+// Resolver - разрешение ссылок по списку записей пользователя
+type Resolver struct {
+	dataList []models.DataInfo
+}
+
+// NewResolver - создать разрешение ссылок по записям, полученным с сервера
+func NewResolver(dataList []models.DataInfo) *Resolver {
+	return &Resolver{dataList: dataList}
+}
+
+// Resolve - значение поля по ссылке. Текст ошибок содержит только ссылку, но не значения.
+func (r *Resolver) Resolve(text string) (string, error) {
+	ref, err := Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := r.find(ref)
+	if err != nil {
+		return "", err
+	}
+
+	fields, err := records.ValueFields(data)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", ref, err)
+	}
+	field, err := records.FindField(fields, ref.Field)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", ref, err)
+	}
+
+	return field.Value, nil
+}
+
+// find - найти запись по ссылке
+func (r *Resolver) find(ref Ref) (models.DataInfo, error) {
+	var found []models.DataInfo
+	for _, data := range r.dataList {
+		switch {
+		case ref.ID != 0:
+			if data.ID != ref.ID {
+				continue
+			}
+		case !strings.EqualFold(data.Description, ref.Name):
+			continue
+		case ref.Folder != "" && !strings.EqualFold(records.Folder(data), ref.Folder):
+			continue
+		}
+		found = append(found, data)
+	}
+
+	switch len(found) {
+	case 0:
+		return models.DataInfo{}, fmt.Errorf("%w: %s", ErrNotFound, ref)
+	case 1:
+		return found[0], nil
+	}
+
+	ids := make([]string, 0, len(found))
+	for _, data := range found {
+		ids = append(ids, strconv.FormatUint(uint64(data.ID), 10))
+	}
+
+	return models.DataInfo{}, fmt.Errorf("%w: %s matches records %s, use gk://<id>/%s", ErrAmbiguous, ref, strings.Join(ids, ", "), url.PathEscape(ref.Field))
+}
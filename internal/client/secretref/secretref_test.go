@@ -0,0 +1,132 @@
+package secretref_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/secretref"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// credentials - учетные данные в папке
+func credentials(t *testing.T, id uint, folder, description, password string) models.DataInfo {
+	t.Helper()
+
+	value, err := models.EncodeValue(models.CredentialsValue{Login: "user" + password, Password: password})
+	require.NoError(t, err)
+	data := models.DataInfo{ID: id, Type: models.DataTypeCredentials, Description: description, Value: value}
+	if folder != "" {
+		data.Fields = models.DataFields{{Name: records.FolderField, Value: folder}}
+	}
+
+	return data
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		text string
+		want secretref.Ref
+	}{
+		{text: "gk://7/password", want: secretref.Ref{ID: 7, Field: "password"}},
+		{text: "gk://github/token", want: secretref.Ref{Name: "github", Field: "token"}},
+		{text: "gk://Work/Dev/github/password", want: secretref.Ref{Folder: "Work/Dev", Name: "github", Field: "password"}},
+		{text: "gk://Work/my%20db%2Fprod/password", want: secretref.Ref{Folder: "Work", Name: "my db/prod", Field: "password"}},
+	}
+	for _, tt := range tests {
+		ref, err := secretref.Parse(tt.text)
+		require.NoError(t, err, tt.text)
+		assert.Equal(t, tt.want, ref)
+		assert.Equal(t, tt.text, ref.String())
+	}
+
+	for _, text := range []string{"op://vault/item/field", "gk://7", "gk://Work//password", "gk://%zz/password"} {
+		_, err := secretref.Parse(text)
+		assert.ErrorIs(t, err, secretref.ErrInvalidRef, text)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	resolver := secretref.NewResolver([]models.DataInfo{
+		credentials(t, 1, "Work", "db", "work-pass"),
+		credentials(t, 2, "Home", "db", "home-pass"),
+		credentials(t, 3, "", "github", "gh-pass"),
+	})
+
+	value, err := resolver.Resolve("gk://2/password")
+	require.NoError(t, err)
+	assert.Equal(t, "home-pass", value)
+
+	value, err = resolver.Resolve("gk://work/DB/password")
+	require.NoError(t, err)
+	assert.Equal(t, "work-pass", value)
+
+	value, err = resolver.Resolve("gk://github/login")
+	require.NoError(t, err)
+	assert.Equal(t, "usergh-pass", value)
+
+	_, err = resolver.Resolve("gk://db/password")
+	assert.ErrorIs(t, err, secretref.ErrAmbiguous)
+	assert.NotContains(t, err.Error(), "pass\"")
+
+	_, err = resolver.Resolve("gk://9/password")
+	assert.ErrorIs(t, err, secretref.ErrNotFound)
+
+	_, err = resolver.Resolve("gk://1/pin")
+	assert.ErrorIs(t, err, records.ErrUnknownField)
+}
+
+func TestExpandAndEnv(t *testing.T) {
+	resolver := secretref.NewResolver([]models.DataInfo{credentials(t, 1, "Work", "db", "work-pass")})
+
+	text, secrets, err := resolver.Expand("DB_USER={{ gk://1/login }}\nDB_PASSWORD={{gk://Work/db/password}}\nDB_HOST=localhost\n")
+	require.NoError(t, err)
+	assert.Equal(t, "DB_USER=userwork-pass\nDB_PASSWORD=work-pass\nDB_HOST=localhost\n", text)
+	assert.Equal(t, []string{"userwork-pass", "work-pass"}, secrets)
+
+	_, _, err = resolver.Expand("{{ gk://2/password }}")
+	assert.ErrorIs(t, err, secretref.ErrNotFound)
+
+	environ, secrets, err := resolver.ResolveEnv([]string{"HOME=/home/user", "DB_PASSWORD=gk://1/password", "DSN=postgres://u:{{ gk://1/password }}@db"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"HOME=/home/user", "DB_PASSWORD=work-pass", "DSN=postgres://u:work-pass@db"}, environ)
+	assert.Equal(t, []string{"work-pass", "work-pass"}, secrets)
+
+	_, _, err = resolver.ResolveEnv([]string{"TOKEN=gk://1/token"})
+	assert.ErrorContains(t, err, "TOKEN")
+}
+
+func TestReadEnvFile(t *testing.T) {
+	environ, err := secretref.ReadEnvFile(strings.NewReader("# database\nexport DB_PASSWORD=\"gk://1/password\"\n\nDB_HOST = 'localhost'\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"DB_PASSWORD=gk://1/password", "DB_HOST=localhost"}, environ)
+
+	_, err = secretref.ReadEnvFile(strings.NewReader("not a variable\n"))
+	assert.Error(t, err)
+}
+
+func TestMaskWriter(t *testing.T) {
+	var buf bytes.Buffer
+	writer := secretref.NewMaskWriter(&buf, []string{"s3cr3t", "my-s3cr3t", ""})
+
+	// Задерживается только возможное начало секрета
+	_, err := writer.Write([]byte("password is my-"))
+	require.NoError(t, err)
+	assert.Equal(t, "password is ", buf.String())
+
+	_, err = writer.Write([]byte("s3cr3t\ntail s3cr3t and s3c"))
+	require.NoError(t, err)
+	assert.Equal(t, "password is "+secretref.Concealed+"\ntail "+secretref.Concealed+" and ", buf.String())
+
+	require.NoError(t, writer.Flush())
+	assert.Equal(t, "password is "+secretref.Concealed+"\ntail "+secretref.Concealed+" and s3c", buf.String())
+
+	// Вывод без переводов строк не копится в буфере
+	buf.Reset()
+	_, err = writer.Write(bytes.Repeat([]byte("x"), 1<<20))
+	require.NoError(t, err)
+	assert.Equal(t, 1<<20, buf.Len())
+}
@@ -0,0 +1,97 @@
+package secretref
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// templateRef - ссылка в шаблоне: {{ gk://... }}
+var templateRef = regexp.MustCompile(`\{\{\s*(gk://[^\s{}]+)\s*\}\}`)
+
+// Expand - подставить значения вместо ссылок {{ gk://... }} в тексте.
+// Возвращает подставленные значения, чтобы их можно было скрыть в выводе.
+func (r *Resolver) Expand(text string) (string, []string, error) {
+	var (
+		secrets []string
+		err     error
+	)
+	res := templateRef.ReplaceAllStringFunc(text, func(match string) string {
+		if err != nil {
+			return match
+		}
+		var value string
+		value, err = r.Resolve(templateRef.FindStringSubmatch(match)[1])
+		secrets = append(secrets, value)
+
+		return value
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return res, secrets, nil
+}
+
+// ResolveEnv - заменить значения переменных окружения вида NAME=gk://... или
+// со ссылками {{ gk://... }}. Возвращает окружение и подставленные значения.
+func (r *Resolver) ResolveEnv(environ []string) ([]string, []string, error) {
+	res := make([]string, 0, len(environ))
+	var secrets []string
+	for _, variable := range environ {
+		name, value, ok := strings.Cut(variable, "=")
+		if !ok {
+			res = append(res, variable)
+			continue
+		}
+
+		var (
+			resolved []string
+			err      error
+		)
+		if IsRef(value) {
+			value, err = r.Resolve(value)
+			resolved = []string{value}
+		} else {
+			value, resolved, err = r.Expand(value)
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		secrets = append(secrets, resolved...)
+		res = append(res, name+"="+value)
+	}
+
+	return res, secrets, nil
+}
+
+// ReadEnvFile - прочитать файл переменных в формате .env: NAME=value, пустые строки
+// и комментарии # пропускаются, кавычки вокруг значения снимаются
+func ReadEnvFile(r io.Reader) ([]string, error) {
+	var environ []string
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		text = strings.TrimPrefix(text, "export ")
+
+		name, value, ok := strings.Cut(text, "=")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" {
+			return nil, fmt.Errorf("env file line %d: expected NAME=value", line)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+
+		environ = append(environ, name+"="+value)
+	}
+
+	return environ, scanner.Err()
+}
@@ -0,0 +1,125 @@
+package session
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// keySize - размер ключа шифрования файла сессии (AES-256)
+const keySize = 32
+
+var (
+	errCiphertextTooShort = errors.New("ciphertext too short")
+	// errKeyFileMode - файл ключа доступен не только владельцу
+	errKeyFileMode = errors.New("session key file must be accessible only by owner (0600)")
+)
+
+// LoadKey - ключ шифрования файла сессии из файла path. Если файла нет, создается
+// случайный ключ, доступный только владельцу. Ключ не выводится из данных компьютера,
+// поэтому файл сессии без файла ключа расшифровать не получится.
+func LoadKey(path string) ([]byte, error) {
+	key, err := readKey(path)
+	if !errors.Is(err, fs.ErrNotExist) {
+		return key, err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("create session key dir: %w", err)
+	}
+
+	key = make([]byte, keySize)
+	if _, err = rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generate session key: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if errors.Is(err, fs.ErrExist) {
+		// Ключ создан параллельно запущенным клиентом
+		return readKey(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("create session key: %w", err)
+	}
+	_, err = file.Write(key)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(path)
+		return nil, fmt.Errorf("write session key: %w", err)
+	}
+
+	return key, nil
+}
+
+// readKey - прочитать ключ, проверив права доступа к файлу
+func readKey(path string) ([]byte, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		return nil, fmt.Errorf("%s: %w", path, errKeyFileMode)
+	}
+
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read session key: %w", err)
+	}
+	if len(key) != keySize {
+		return nil, fmt.Errorf("read session key: invalid key size %d", len(key))
+	}
+
+	return key, nil
+}
+
+// encrypt - зашифровать данные AES-GCM, случайный nonce записывается перед шифротекстом
+func encrypt(key []byte, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("generate nonce: %w", err)
+	}
+
+	return gcm.Seal(nonce, nonce, data, nil), nil
+}
+
+// decrypt - расшифровать данные, зашифрованные encrypt
+func decrypt(key []byte, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, errCiphertextTooShort
+	}
+
+	nonce, ciphertext := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt session: %w", err)
+	}
+
+	return plaintext, nil
+}
+
+// newGCM - AES-GCM с ключом key
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("session cipher: %w", err)
+	}
+
+	return cipher.NewGCM(block)
+}
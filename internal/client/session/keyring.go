@@ -0,0 +1,149 @@
+package session
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// secretTool - утилита libsecret для работы с Secret Service (GNOME Keyring, KWallet)
+const secretTool = "secret-tool"
+
+// errKeyringNotFound - в Secret Service нет записи сессии
+var errKeyringNotFound = errors.New("keyring: session not found")
+
+// KeyringStore - хранение сессии в Secret Service. Если служба недоступна,
+// сессия сохраняется в запасное хранилище.
+type KeyringStore struct {
+	command  string
+	account  string
+	fallback StoreInterface
+}
+
+// NewKeyringStore - создать хранилище сессии в Secret Service под именем account
+func NewKeyringStore(account string, fallback StoreInterface) *KeyringStore {
+	return &KeyringStore{
+		command:  secretTool,
+		account:  account,
+		fallback: fallback,
+	}
+}
+
+// KeyringAvailable - доступен ли Secret Service в текущем окружении
+func KeyringAvailable() bool {
+	if os.Getenv("DBUS_SESSION_BUS_ADDRESS") == "" {
+		return false
+	}
+	_, err := exec.LookPath(secretTool)
+
+	return err == nil
+}
+
+// attributes - атрибуты, по которым запись сессии ищется в Secret Service
+func (s *KeyringStore) attributes() []string {
+	return []string{"service", "gophkeeper", "account", s.account}
+}
+
+// Load - загрузить сессию
+func (s *KeyringStore) Load() (*Session, error) {
+	stdout, err := s.lookup()
+	if errors.Is(err, errKeyringNotFound) {
+		// Сессия могла быть сохранена в файл, пока Secret Service был недоступен
+		return s.fallback.Load()
+	}
+	if err != nil {
+		session, fallbackErr := s.fallback.Load()
+		if errors.Is(fallbackErr, ErrNoSession) {
+			return nil, err
+		}
+
+		return session, fallbackErr
+	}
+
+	session := &Session{}
+	err = json.Unmarshal(stdout, session)
+	if err != nil {
+		return nil, fmt.Errorf("parse session: %w", err)
+	}
+
+	return session, nil
+}
+
+// Save - сохранить сессию
+func (s *KeyringStore) Save(session *Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+
+	args := append([]string{"store", "--label=GophKeeper session " + s.account}, s.attributes()...)
+	if _, err = s.run(data, args...); err != nil {
+		return s.fallback.Save(session)
+	}
+
+	// Старая сессия из файла больше не нужна
+	return s.fallback.Delete()
+}
+
+// Delete - удалить сессию
+func (s *KeyringStore) Delete() error {
+	// Недоступный Secret Service не мешает выходу: сессия тогда хранится только в запасном хранилище
+	_, _ = s.run(nil, append([]string{"clear"}, s.attributes()...)...)
+
+	return s.fallback.Delete()
+}
+
+// lookup - прочитать запись сессии. secret-tool завершается с кодом 1 без вывода,
+// если записи нет, это возвращается как errKeyringNotFound.
+func (s *KeyringStore) lookup() ([]byte, error) {
+	stdout, stderr, err := s.execute(nil, append([]string{"lookup"}, s.attributes()...)...)
+	exitErr := &exec.ExitError{}
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 && len(stdout) == 0 && stderr == "" {
+		return nil, errKeyringNotFound
+	}
+	if err != nil {
+		return nil, s.commandError(err, stderr)
+	}
+	if len(stdout) == 0 {
+		return nil, errKeyringNotFound
+	}
+
+	return stdout, nil
+}
+
+// run - выполнить secret-tool, любой ненулевой код завершения - ошибка
+func (s *KeyringStore) run(stdin []byte, args ...string) ([]byte, error) {
+	stdout, stderr, err := s.execute(stdin, args...)
+	if err != nil {
+		return nil, s.commandError(err, stderr)
+	}
+
+	return stdout, nil
+}
+
+// commandError - ошибка secret-tool с выводом stderr
+func (s *KeyringStore) commandError(err error, stderr string) error {
+	if stderr == "" {
+		return fmt.Errorf("%s: %w", s.command, err)
+	}
+
+	return fmt.Errorf("%s: %w: %s", s.command, err, stderr)
+}
+
+// execute - запустить secret-tool и вернуть stdout и stderr
+func (s *KeyringStore) execute(stdin []byte, args ...string) ([]byte, string, error) {
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd := exec.Command(s.command, args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err := cmd.Run()
+
+	return stdout.Bytes(), strings.TrimSpace(stderr.String()), err
+}
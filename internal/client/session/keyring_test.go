@@ -0,0 +1,90 @@
+package session
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeSecretTool - secret-tool, который хранит секрет в файле рядом со скриптом
+const fakeSecretTool = `#!/bin/sh
+secret="$(dirname "$0")/secret"
+case "$1" in
+store) cat > "$secret" ;;
+lookup) [ -f "$secret" ] && cat "$secret" || exit 1 ;;
+clear) rm -f "$secret" ;;
+esac
+`
+
+func TestKeyringStore(t *testing.T) {
+	dir := t.TempDir()
+	command := filepath.Join(dir, "secret-tool")
+	require.NoError(t, os.WriteFile(command, []byte(fakeSecretTool), 0o700))
+
+	fallback := NewFileStore(filepath.Join(dir, "session"), bytes.Repeat([]byte{1}, 32))
+	store := NewKeyringStore("default", fallback)
+	store.command = command
+
+	_, err := store.Load()
+	assert.ErrorIs(t, err, ErrNoSession)
+
+	// Сессия, сохраненная в файл без Secret Service, переносится в него при следующем сохранении
+	require.NoError(t, fallback.Save(&Session{Login: "old"}))
+	require.NoError(t, store.Save(&Session{Login: "user"}))
+
+	loaded, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "user", loaded.Login)
+	_, err = fallback.Load()
+	assert.ErrorIs(t, err, ErrNoSession)
+
+	require.NoError(t, store.Delete())
+	_, err = store.Load()
+	assert.ErrorIs(t, err, ErrNoSession)
+}
+
+func TestKeyringStoreFallback(t *testing.T) {
+	dir := t.TempDir()
+	fallback := NewFileStore(filepath.Join(dir, "session"), bytes.Repeat([]byte{1}, 32))
+	store := NewKeyringStore("default", fallback)
+	store.command = filepath.Join(dir, "missing-secret-tool")
+
+	require.NoError(t, store.Save(&Session{Login: "user"}))
+
+	loaded, err := fallback.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "user", loaded.Login)
+
+	loaded, err = store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "user", loaded.Login)
+
+	require.NoError(t, store.Delete())
+	_, err = fallback.Load()
+	assert.ErrorIs(t, err, ErrNoSession)
+}
+
+func TestKeyringStoreCommandError(t *testing.T) {
+	dir := t.TempDir()
+	command := filepath.Join(dir, "secret-tool")
+	// Secret Service отвечает ошибкой без вывода: это не отсутствие записи
+	require.NoError(t, os.WriteFile(command, []byte("#!/bin/sh\nexit 2\n"), 0o700))
+
+	fallback := NewFileStore(filepath.Join(dir, "session"), bytes.Repeat([]byte{1}, 32))
+	store := NewKeyringStore("default", fallback)
+	store.command = command
+
+	_, err := store.Load()
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNoSession)
+
+	// Сессия не потеряна: она осталась в запасном хранилище
+	require.NoError(t, store.Save(&Session{Login: "user"}))
+	loaded, err := fallback.Load()
+	require.NoError(t, err)
+	assert.Equal(t, "user", loaded.Login)
+}
@@ -0,0 +1,7 @@
+package session
+
+type StoreInterface interface {
+	Load() (*Session, error)
+	Save(session *Session) error
+	Delete() error
+}
@@ -0,0 +1,140 @@
+// Package session хранит сессию клиента между запусками команд
+package session
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+)
+
+// ErrNoSession - сохраненной сессии нет, нужно выполнить вход
+var ErrNoSession = i18n.NewError("session.not_found")
+
+// Session - сохраненная сессия пользователя
+type Session struct {
+	Server  string         `json:"server"`
+	Login   string         `json:"login"`
+	Cookies []*http.Cookie `json:"cookies"`
+}
+
+// AccessTokenCookie - cookie с access токеном
+const AccessTokenCookie = "access-token"
+
+// AccessExpired - истек ли access токен. Просроченный токен можно обновить по refresh токену.
+func (s *Session) AccessExpired(now time.Time) bool {
+	for _, cookie := range s.Cookies {
+		if cookie.Name == AccessTokenCookie && cookie.Value != "" {
+			return !cookie.Expires.IsZero() && !cookie.Expires.After(now)
+		}
+	}
+
+	return true
+}
+
+// FileStore - хранение сессии в зашифрованном файле, доступном только владельцу
+type FileStore struct {
+	path string
+	key  []byte
+}
+
+// NewFileStore - создать хранилище сессии в файле path, зашифрованном ключом key (32 байта)
+func NewFileStore(path string, key []byte) *FileStore {
+	return &FileStore{
+		path: path,
+		key:  key,
+	}
+}
+
+// DefaultPath - путь к файлу сессии профиля в каталоге конфигурации пользователя
+func DefaultPath(profile string) (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("user config dir: %w", err)
+	}
+
+	name := "session"
+	if profile != "" {
+		// Имя профиля не должно выводить файл за пределы каталога
+		name += "-" + strings.NewReplacer("/", "_", `\`, "_").Replace(profile)
+	}
+
+	return filepath.Join(configDir, "gophkeeper", name), nil
+}
+
+// Load - загрузить сессию
+func (s *FileStore) Load() (*Session, error) {
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNoSession
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read session: %w", err)
+	}
+
+	// Файл другого пользователя или компьютера не расшифруется, такую сессию нужно открыть заново
+	data, err = decrypt(s.key, data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNoSession, err)
+	}
+
+	session := &Session{}
+	err = json.Unmarshal(data, session)
+	if err != nil {
+		return nil, fmt.Errorf("parse session: %w", err)
+	}
+
+	return session, nil
+}
+
+// Save - сохранить сессию. Файл записывается целиком через временный файл.
+func (s *FileStore) Save(session *Session) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	data, err = encrypt(s.key, data)
+	if err != nil {
+		return err
+	}
+
+	dir := filepath.Dir(s.path)
+	if err = os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("create session dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".session-*")
+	if err != nil {
+		return fmt.Errorf("create session file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err = tmp.Chmod(0o600); err == nil {
+		_, err = tmp.Write(data)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("write session file: %w", err)
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// Delete - удалить сессию
+func (s *FileStore) Delete() error {
+	err := os.Remove(s.path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("delete session: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package session_test
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/session"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "gophkeeper", "session.json")
+	key := bytes.Repeat([]byte{1}, 32)
+	store := session.NewFileStore(path, key)
+
+	_, err := store.Load()
+	assert.ErrorIs(t, err, session.ErrNoSession)
+
+	expires := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	saved := &session.Session{
+		Server: "http://localhost:8080",
+		Login:  "user",
+		Cookies: []*http.Cookie{
+			{Name: "access-token", Value: "token", Path: "/", Expires: expires},
+		},
+	}
+	assert.Nil(t, store.Save(saved))
+
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// Токены не хранятся в открытом виде
+	data, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.NotContains(t, string(data), "token")
+
+	// Файл, зашифрованный другим ключом, считается отсутствующей сессией
+	_, err = session.NewFileStore(path, bytes.Repeat([]byte{2}, 32)).Load()
+	assert.ErrorIs(t, err, session.ErrNoSession)
+
+	loaded, err := store.Load()
+	assert.Nil(t, err)
+	assert.Equal(t, saved.Login, loaded.Login)
+	assert.Equal(t, "token", loaded.Cookies[0].Value)
+	assert.True(t, expires.Equal(loaded.Cookies[0].Expires))
+
+	assert.Nil(t, store.Delete())
+	assert.Nil(t, store.Delete())
+	_, err = store.Load()
+	assert.ErrorIs(t, err, session.ErrNoSession)
+}
+
+func TestAccessExpired(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name    string
+		cookies []*http.Cookie
+		want    bool
+	}{
+		{
+			name: "valid access token",
+			cookies: []*http.Cookie{
+				{Name: session.AccessTokenCookie, Value: "token", Expires: now.Add(time.Hour)},
+			},
+			want: false,
+		},
+		{
+			name: "expired access token",
+			cookies: []*http.Cookie{
+				{Name: session.AccessTokenCookie, Value: "token", Expires: now.Add(-time.Hour)},
+				{Name: "refresh-token", Value: "token", Expires: now.Add(time.Hour)},
+			},
+			want: true,
+		},
+		{
+			name: "only refresh token",
+			cookies: []*http.Cookie{
+				{Name: "refresh-token", Value: "token", Expires: now.Add(time.Hour)},
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &session.Session{Cookies: tt.cookies}
+			assert.Equal(t, tt.want, s.AccessExpired(now))
+		})
+	}
+}
+
+func TestLoadKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "gophkeeper", "session.key")
+
+	key, err := session.LoadKey(path)
+	assert.NoError(t, err)
+	assert.Len(t, key, 32)
+
+	info, err := os.Stat(path)
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	again, err := session.LoadKey(path)
+	assert.NoError(t, err)
+	assert.Equal(t, key, again)
+
+	// Ключ, доступный другим пользователям, не используется
+	assert.NoError(t, os.Chmod(path, 0o644))
+	_, err = session.LoadKey(path)
+	assert.Error(t, err)
+}
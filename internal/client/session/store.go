@@ -0,0 +1,41 @@
+package session
+
+import (
+	"path/filepath"
+	"runtime"
+)
+
+const (
+	// keyringAccount - имя записи сессии в Secret Service
+	keyringAccount = "default"
+	// keyFileName - файл ключа шифрования файлов сессии в каталоге конфигурации
+	keyFileName = "session.key"
+)
+
+// StoreFactory - создать хранилище сессии профиля
+type StoreFactory func(profile string) (StoreInterface, error)
+
+// NewStore - хранилище сессии профиля для текущей ОС: Secret Service, если он доступен,
+// иначе зашифрованный файл в каталоге конфигурации пользователя
+func NewStore(profile string) (StoreInterface, error) {
+	path, err := DefaultPath(profile)
+	if err != nil {
+		return nil, err
+	}
+	key, err := LoadKey(filepath.Join(filepath.Dir(path), keyFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	fileStore := NewFileStore(path, key)
+	if runtime.GOOS == "linux" && KeyringAvailable() {
+		account := profile
+		if account == "" {
+			account = keyringAccount
+		}
+
+		return NewKeyringStore(account, fileStore), nil
+	}
+
+	return fileStore, nil
+}
@@ -0,0 +1,304 @@
+// Package sshagent реализует SSH агента поверх SSH ключей хранилища.
+// Закрытые ключи расшифровываются только в памяти и удаляются из нее при блокировке хранилища.
+package sshagent
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+var (
+	// ErrLocked - агент заблокирован, подписи недоступны
+	ErrLocked = errors.New("agent is locked")
+	// ErrReadOnly - ключи добавляются только через хранилище
+	ErrReadOnly = errors.New("keys are managed by the vault")
+	// ErrNotFound - у агента нет ключа для подписи
+	ErrNotFound = errors.New("key not found")
+	// ErrDenied - пользователь отклонил подпись
+	ErrDenied = errors.New("signature denied")
+)
+
+// Key - описание ключа, доступного через агента
+type Key struct {
+	ID          uint
+	Comment     string
+	Fingerprint string
+}
+
+// Skipped - запись, ключ из которой не удалось загрузить
+type Skipped struct {
+	ID     uint
+	Reason error
+}
+
+// ConfirmFunc - спросить пользователя, разрешить ли подпись ключом
+type ConfirmFunc func(key Key) bool
+
+// loadedKey - расшифрованный ключ в памяти
+type loadedKey struct {
+	Key
+	signer ssh.Signer
+	blob   []byte
+}
+
+// Agent - SSH агент с ключами из хранилища
+type Agent struct {
+	mu         sync.Mutex
+	keys       []loadedKey
+	confirm    ConfirmFunc
+	passphrase []byte
+}
+
+var _ agent.ExtendedAgent = (*Agent)(nil)
+
+// New - создать агента без ключей. Если confirm задан, каждая подпись подтверждается пользователем.
+func New(confirm ConfirmFunc) *Agent {
+	return &Agent{confirm: confirm}
+}
+
+// SetKeys - заменить ключи агента ключами из записей "SSH ключи".
+// Если ids не пусты, загружаются только записи с этими идентификаторами.
+func (a *Agent) SetKeys(dataList []models.DataInfo, ids []uint) []Skipped {
+	keys := make([]loadedKey, 0, len(dataList))
+	var skipped []Skipped
+	for _, data := range dataList {
+		if data.Type != models.DataTypeSSHKey || (len(ids) > 0 && !slices.Contains(ids, data.ID)) {
+			continue
+		}
+
+		key, err := loadKey(data)
+		if err != nil {
+			skipped = append(skipped, Skipped{ID: data.ID, Reason: err})
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.keys = keys
+
+	return skipped
+}
+
+// Clear - удалить все ключи из памяти, например при блокировке хранилища
+func (a *Agent) Clear() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.keys = nil
+}
+
+// Keys - ключи, доступные через агента
+func (a *Agent) Keys() []Key {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	res := make([]Key, 0, len(a.keys))
+	for _, key := range a.keys {
+		res = append(res, key.Key)
+	}
+
+	return res
+}
+
+// List - открытые ключи агента. Заблокированный агент возвращает пустой список.
+func (a *Agent) List() ([]*agent.Key, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.passphrase != nil {
+		return nil, nil
+	}
+
+	res := make([]*agent.Key, 0, len(a.keys))
+	for _, key := range a.keys {
+		publicKey := key.signer.PublicKey()
+		res = append(res, &agent.Key{
+			Format:  publicKey.Type(),
+			Blob:    publicKey.Marshal(),
+			Comment: key.Comment,
+		})
+	}
+
+	return res, nil
+}
+
+// Sign - подписать данные ключом
+func (a *Agent) Sign(key ssh.PublicKey, data []byte) (*ssh.Signature, error) {
+	return a.SignWithFlags(key, data, 0)
+}
+
+// SignWithFlags - подписать данные ключом с учетом запрошенного алгоритма RSA
+func (a *Agent) SignWithFlags(publicKey ssh.PublicKey, data []byte, flags agent.SignatureFlags) (*ssh.Signature, error) {
+	key, err := a.find(publicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// Подтверждение ждет пользователя, поэтому выполняется без блокировки агента
+	if a.confirm != nil && !a.confirm(key.Key) {
+		return nil, ErrDenied
+	}
+
+	if flags == 0 {
+		return key.signer.Sign(nil, data)
+	}
+
+	algorithmSigner, ok := key.signer.(ssh.AlgorithmSigner)
+	if !ok {
+		return nil, fmt.Errorf("key %s does not support algorithm selection", key.Fingerprint)
+	}
+
+	var algorithm string
+	switch {
+	case flags&agent.SignatureFlagRsaSha256 != 0:
+		algorithm = ssh.KeyAlgoRSASHA256
+	case flags&agent.SignatureFlagRsaSha512 != 0:
+		algorithm = ssh.KeyAlgoRSASHA512
+	default:
+		return nil, fmt.Errorf("unsupported signature flags: %d", flags)
+	}
+
+	return algorithmSigner.SignWithAlgorithm(nil, data, algorithm)
+}
+
+// find - найти ключ по открытому ключу
+func (a *Agent) find(publicKey ssh.PublicKey) (loadedKey, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.passphrase != nil {
+		return loadedKey{}, ErrLocked
+	}
+
+	blob := publicKey.Marshal()
+	for _, key := range a.keys {
+		if bytes.Equal(key.blob, blob) {
+			return key, nil
+		}
+	}
+
+	return loadedKey{}, ErrNotFound
+}
+
+// Add - ключи нельзя добавить через агента, только сохранить в хранилище
+func (a *Agent) Add(_ agent.AddedKey) error {
+	return ErrReadOnly
+}
+
+// Remove - убрать ключ из агента. Запись в хранилище не меняется.
+func (a *Agent) Remove(publicKey ssh.PublicKey) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.passphrase != nil {
+		return ErrLocked
+	}
+
+	blob := publicKey.Marshal()
+	for i, key := range a.keys {
+		if bytes.Equal(key.blob, blob) {
+			a.keys = slices.Delete(a.keys, i, i+1)
+			return nil
+		}
+	}
+
+	return ErrNotFound
+}
+
+// RemoveAll - убрать все ключи из агента. Записи в хранилище не меняются.
+func (a *Agent) RemoveAll() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.passphrase != nil {
+		return ErrLocked
+	}
+	a.keys = nil
+
+	return nil
+}
+
+// Lock - заблокировать агента паролем (ssh-add -x)
+func (a *Agent) Lock(passphrase []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.passphrase != nil {
+		return ErrLocked
+	}
+	a.passphrase = slices.Clone(passphrase)
+	if a.passphrase == nil {
+		a.passphrase = []byte{}
+	}
+
+	return nil
+}
+
+// Unlock - разблокировать агента паролем (ssh-add -X)
+func (a *Agent) Unlock(passphrase []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.passphrase == nil {
+		return errors.New("agent is not locked")
+	}
+	if subtle.ConstantTimeCompare(a.passphrase, passphrase) != 1 {
+		return errors.New("incorrect passphrase")
+	}
+	a.passphrase = nil
+
+	return nil
+}
+
+// Signers - подписывающие ключи агента
+func (a *Agent) Signers() ([]ssh.Signer, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.passphrase != nil {
+		return nil, ErrLocked
+	}
+
+	res := make([]ssh.Signer, 0, len(a.keys))
+	for _, key := range a.keys {
+		res = append(res, key.signer)
+	}
+
+	return res, nil
+}
+
+// Extension - расширения протокола не поддерживаются
+func (a *Agent) Extension(_ string, _ []byte) ([]byte, error) {
+	return nil, agent.ErrExtensionUnsupported
+}
+
+// loadKey - расшифровать ключ записи
+func loadKey(data models.DataInfo) (loadedKey, error) {
+	value := models.SSHKeyValue{}
+	if err := models.DecodeValue(data.Value, &value); err != nil {
+		return loadedKey{}, fmt.Errorf("decode value: %w", err)
+	}
+
+	signer, err := records.SSHSigner(value)
+	if err != nil {
+		return loadedKey{}, err
+	}
+
+	comment := data.Description
+	if comment == "" {
+		comment = fmt.Sprintf("gophkeeper:%d", data.ID)
+	}
+
+	return loadedKey{
+		Key: Key{
+			ID:          data.ID,
+			Comment:     comment,
+			Fingerprint: ssh.FingerprintSHA256(signer.PublicKey()),
+		},
+		signer: signer,
+		blob:   signer.PublicKey().Marshal(),
+	}, nil
+}
@@ -0,0 +1,80 @@
+package sshagent
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// SocketEnv - переменная окружения, через которую ssh находит агента
+const SocketEnv = "SSH_AUTH_SOCK"
+
+// DefaultSocketPath - путь к сокету агента: $XDG_RUNTIME_DIR/gophkeeper/agent.sock
+// или каталог пользователя во временном каталоге
+func DefaultSocketPath() string {
+	if runtimeDir := os.Getenv("XDG_RUNTIME_DIR"); runtimeDir != "" {
+		return filepath.Join(runtimeDir, "gophkeeper", "agent.sock")
+	}
+
+	return filepath.Join(os.TempDir(), "gophkeeper-"+strconv.Itoa(os.Getuid()), "agent.sock")
+}
+
+// Listen - открыть Unix сокет агента, доступный только текущему пользователю.
+// Каталог сокета должен принадлежать пользователю и быть закрыт для остальных.
+// Оставшийся от прошлого запуска сокет удаляется.
+func Listen(path string) (net.Listener, error) {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("create socket dir: %w", err)
+	}
+	if err := checkSocketDir(dir); err != nil {
+		return nil, err
+	}
+
+	info, err := os.Lstat(path)
+	switch {
+	case err == nil && info.Mode().Type() == fs.ModeSocket:
+		if err = os.Remove(path); err != nil {
+			return nil, fmt.Errorf("remove stale socket: %w", err)
+		}
+	case err == nil:
+		return nil, fmt.Errorf("%s exists and is not a socket", path)
+	case !errors.Is(err, fs.ErrNotExist):
+		return nil, err
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+	if err = os.Chmod(path, 0o600); err != nil {
+		_ = listener.Close()
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+// Serve - обслуживать подключения к агенту, пока listener не закрыт
+func (a *Agent) Serve(listener net.Listener) error {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return err
+		}
+
+		go func() {
+			defer conn.Close()
+			_ = agent.ServeAgent(a, conn)
+		}()
+	}
+}
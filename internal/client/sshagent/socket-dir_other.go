@@ -0,0 +1,22 @@
+//go:build !unix
+
+package sshagent
+
+import (
+	"fmt"
+	"os"
+)
+
+// checkSocketDir - на других ОС владельца каталога проверить нельзя, проверяется,
+// что это каталог, а не ссылка
+func checkSocketDir(dir string) error {
+	info, err := os.Lstat(dir)
+	if err != nil {
+		return fmt.Errorf("check socket dir: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("socket dir %s is not a directory", dir)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+//go:build unix
+
+package sshagent
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// checkSocketDir - каталог сокета должен быть каталогом текущего пользователя (не ссылкой)
+// с правами 0700, иначе другой пользователь может подменить сокет
+func checkSocketDir(dir string) error {
+	info, err := os.Lstat(dir)
+	if err != nil {
+		return fmt.Errorf("check socket dir: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("socket dir %s is not a directory", dir)
+	}
+
+	stat, ok := info.Sys().(*syscall.Stat_t)
+	if !ok {
+		return fmt.Errorf("socket dir %s: unknown owner", dir)
+	}
+	if int(stat.Uid) != os.Getuid() {
+		return fmt.Errorf("socket dir %s is owned by uid %d, not by current user", dir, stat.Uid)
+	}
+	if info.Mode().Perm() != 0o700 {
+		return fmt.Errorf("socket dir %s has mode %04o, must be 0700", dir, info.Mode().Perm())
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package sshagent_test
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/pem"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/sshagent"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// sshKeyData - запись "SSH ключи" с закрытым ключом, зашифрованным passphrase
+func sshKeyData(t *testing.T, id uint, key crypto.PrivateKey, passphrase string) models.DataInfo {
+	t.Helper()
+
+	var (
+		block *pem.Block
+		err   error
+	)
+	if passphrase != "" {
+		block, err = ssh.MarshalPrivateKeyWithPassphrase(key, "", []byte(passphrase))
+	} else {
+		block, err = ssh.MarshalPrivateKey(key, "")
+	}
+	require.NoError(t, err)
+
+	value, err := models.EncodeValue(models.SSHKeyValue{
+		PrivateKey: string(pem.EncodeToMemory(block)),
+		Passphrase: passphrase,
+	})
+	require.NoError(t, err)
+
+	return models.DataInfo{ID: id, Type: models.DataTypeSSHKey, Description: "key", Value: value}
+}
+
+// serve - запустить агента на сокете во временном каталоге и подключиться к нему
+func serve(t *testing.T, a *sshagent.Agent) agent.ExtendedAgent {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "agent", "agent.sock")
+	listener, err := sshagent.Listen(path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+	go func() { _ = a.Serve(listener) }()
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	conn, err := net.Dial("unix", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return agent.NewClient(conn)
+}
+
+func TestAgent(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, otherKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	a := sshagent.New(nil)
+	skipped := a.SetKeys([]models.DataInfo{
+		sshKeyData(t, 1, edKey, "secret"),
+		sshKeyData(t, 2, rsaKey, ""),
+		sshKeyData(t, 3, otherKey, ""),
+		{ID: 4, Type: models.DataTypeSSHKey, Value: "e30="},
+		{ID: 5, Type: models.DataTypeText, Value: "e30="},
+	}, []uint{1, 2, 4})
+	require.Len(t, skipped, 1)
+	assert.Equal(t, uint(4), skipped[0].ID)
+
+	client := serve(t, a)
+
+	keys, err := client.List()
+	require.NoError(t, err)
+	require.Len(t, keys, 2)
+	assert.Equal(t, ssh.KeyAlgoED25519, keys[0].Format)
+	assert.Equal(t, ssh.KeyAlgoRSA, keys[1].Format)
+	assert.Equal(t, "key", keys[0].Comment)
+
+	data := []byte("session data")
+	signature, err := client.Sign(keys[0], data)
+	require.NoError(t, err)
+	assert.NoError(t, keys[0].Verify(data, signature))
+
+	signature, err = client.SignWithFlags(keys[1], data, agent.SignatureFlagRsaSha512)
+	require.NoError(t, err)
+	assert.Equal(t, ssh.KeyAlgoRSASHA512, signature.Format)
+	assert.NoError(t, keys[1].Verify(data, signature))
+
+	otherSigner, err := ssh.NewSignerFromKey(otherKey)
+	require.NoError(t, err)
+	_, err = client.Sign(otherSigner.PublicKey(), data)
+	assert.Error(t, err)
+
+	assert.Error(t, client.Add(agent.AddedKey{PrivateKey: otherKey}))
+
+	// Блокировка паролем по протоколу агента
+	require.NoError(t, client.Lock([]byte("pass")))
+	keys, err = client.List()
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+	assert.Error(t, client.Unlock([]byte("wrong")))
+	require.NoError(t, client.Unlock([]byte("pass")))
+
+	// Блокировка хранилища удаляет ключи из памяти
+	a.Clear()
+	keys, err = client.List()
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+	assert.Empty(t, a.Keys())
+}
+
+func TestAgentConfirm(t *testing.T) {
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var asked []sshagent.Key
+	allow := false
+	a := sshagent.New(func(key sshagent.Key) bool {
+		asked = append(asked, key)
+		return allow
+	})
+	require.Empty(t, a.SetKeys([]models.DataInfo{sshKeyData(t, 7, edKey, "")}, nil))
+
+	client := serve(t, a)
+	keys, err := client.List()
+	require.NoError(t, err)
+	require.Len(t, keys, 1)
+
+	_, err = client.Sign(keys[0], []byte("data"))
+	assert.Error(t, err)
+
+	allow = true
+	_, err = client.Sign(keys[0], []byte("data"))
+	assert.NoError(t, err)
+
+	require.Len(t, asked, 2)
+	assert.Equal(t, uint(7), asked[0].ID)
+	assert.Equal(t, ssh.FingerprintSHA256(mustPublicKey(t, edKey)), asked[0].Fingerprint)
+}
+
+func TestListen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "agent", "file")
+	require.NoError(t, os.Mkdir(filepath.Dir(path), 0o700))
+	require.NoError(t, os.WriteFile(path, []byte("data"), 0o600))
+
+	_, err := sshagent.Listen(path)
+	assert.Error(t, err)
+
+	// Сокет от прошлого запуска заменяется
+	path = filepath.Join(t.TempDir(), "agent", "agent.sock")
+	listener, err := sshagent.Listen(path)
+	require.NoError(t, err)
+	listener.(*net.UnixListener).SetUnlinkOnClose(false)
+	require.NoError(t, listener.Close())
+
+	listener, err = sshagent.Listen(path)
+	require.NoError(t, err)
+	assert.NoError(t, listener.Close())
+
+	// Каталог, доступный другим пользователям, или ссылка на каталог не принимаются
+	dir := filepath.Join(t.TempDir(), "open")
+	require.NoError(t, os.Mkdir(dir, 0o700))
+	require.NoError(t, os.Chmod(dir, 0o755))
+	_, err = sshagent.Listen(filepath.Join(dir, "agent.sock"))
+	assert.Error(t, err)
+
+	link := filepath.Join(t.TempDir(), "link")
+	require.NoError(t, os.Symlink(filepath.Dir(path), link))
+	_, err = sshagent.Listen(filepath.Join(link, "agent.sock"))
+	assert.Error(t, err)
+}
+
+// mustPublicKey - открытый ключ закрытого ключа
+func mustPublicKey(t *testing.T, key crypto.PrivateKey) ssh.PublicKey {
+	t.Helper()
+
+	signer, err := ssh.NewSignerFromKey(key)
+	require.NoError(t, err)
+
+	return signer.PublicKey()
+}
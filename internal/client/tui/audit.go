@@ -0,0 +1,90 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/audit"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/router"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// auditEntryTitle - строка записи в отчете
+func auditEntryTitle(entry audit.Entry) string {
+	title := fmt.Sprintf("#%d %s", entry.ID, entry.Description)
+	if entry.Login != "" {
+		title += " (" + entry.Login + ")"
+	}
+
+	return tview.Escape(title)
+}
+
+// formatAudit - отчет аудита в виде текста с цветовыми тегами tview
+func formatAudit(report audit.Report) string {
+	var builder strings.Builder
+
+	color := "green"
+	switch {
+	case report.Score < 50:
+		color = "red"
+	case report.Score < 80:
+		color = "yellow"
+	}
+	builder.WriteString(i18n.T("audit.summary", report.Total, color, report.Score))
+
+	fmt.Fprintf(&builder, "\n[yellow::b]%s[-::-]\n", i18n.T("audit.weak", len(report.Weak)))
+	for _, entry := range report.Weak {
+		fmt.Fprintf(&builder, "  • %s — %s", auditEntryTitle(entry), i18n.T("strength.entropy", entry.Strength.Entropy, entry.Strength.Level))
+		if len(entry.Strength.Patterns) > 0 {
+			fmt.Fprintf(&builder, " [gray](%s)[-]", strings.Join(audit.PatternTitles(entry.Strength.Patterns), ", "))
+		}
+		builder.WriteString("\n")
+	}
+
+	fmt.Fprintf(&builder, "\n[yellow::b]%s[-::-]\n", i18n.T("audit.reused", len(report.Reused)))
+	for i, group := range report.Reused {
+		fmt.Fprintf(&builder, "  %s\n", i18n.T("audit.group", i+1))
+		for _, entry := range group {
+			fmt.Fprintf(&builder, "    • %s\n", auditEntryTitle(entry))
+		}
+	}
+
+	fmt.Fprintf(&builder, "\n[yellow::b]%s[-::-]\n", i18n.T("audit.old", len(report.Old)))
+	for _, entry := range report.Old {
+		fmt.Fprintf(&builder, "  • %s — %s\n", auditEntryTitle(entry), i18n.T("audit.days", entry.AgeDays))
+	}
+
+	return builder.String()
+}
+
+// DrawAudit - отобразить отчет аудита безопасности
+func (tuiService *TUIService) DrawAudit(report audit.Report) {
+	back := func() {
+		tuiService.pages.SwitchToPage(router.DataPage)
+	}
+
+	view := tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetText(formatAudit(report))
+	view.SetBorder(true).SetTitle(i18n.T("audit.title"))
+	view.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEscape {
+			back()
+		}
+	})
+
+	form := tview.NewForm().AddButton(i18n.T("button.back"), back)
+
+	flex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(view, 0, 1, true).
+		AddItem(form, 3, 0, false)
+
+	tuiService.pages.AddAndSwitchToPage(router.AuditPage, flex, true)
+
+	if tuiService.running {
+		tuiService.application.Draw()
+	}
+}
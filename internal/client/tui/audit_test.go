@@ -0,0 +1,28 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/audit"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatAudit(t *testing.T) {
+	entry := audit.Entry{ID: 7, Description: "mail [work]", Login: "alice", AgeDays: 120}
+	entry.Strength.Entropy = 12
+	entry.Strength.Level = "слабый"
+
+	text := formatAudit(audit.Report{
+		Total:  2,
+		Weak:   []audit.Entry{entry},
+		Reused: [][]audit.Entry{{entry, entry}},
+		Old:    []audit.Entry{entry},
+		Score:  0,
+	})
+
+	assert.Contains(t, text, "[red::b]0%")
+	assert.Contains(t, text, "Слабые пароли (1)")
+	assert.Contains(t, text, "#7 mail [work[] (alice) — 12 бит, слабый")
+	assert.Contains(t, text, "Группа 1")
+	assert.Contains(t, text, "120 дн.")
+}
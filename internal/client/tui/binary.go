@@ -0,0 +1,209 @@
+package tui
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+const (
+	// previewLimit - файлы больше этого размера не показываются в карточке записи
+	previewLimit = 16 << 10
+	// maxBinaryFileSize - наибольший загружаемый файл, пока лимит сервера неизвестен
+	maxBinaryFileSize = 32 << 20
+	// binaryValueOverhead - запас на имя файла, тип и ключи JSON в значении записи
+	binaryValueOverhead = 256
+)
+
+// errFileTooLarge - файл больше допустимого размера записи
+var errFileTooLarge = errors.New("file is too large")
+
+// fileEntry - элемент каталога в выборе файла
+type fileEntry struct {
+	Name  string
+	IsDir bool
+	Size  int64
+}
+
+// readDir - содержимое каталога: сначала каталоги, затем файлы, по алфавиту
+func readDir(dir string) ([]fileEntry, error) {
+	dirEntries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]fileEntry, 0, len(dirEntries))
+	for _, dirEntry := range dirEntries {
+		info, err := dirEntry.Info()
+		if err != nil {
+			continue
+		}
+		// Ссылка на каталог открывается как каталог
+		isDir := info.IsDir()
+		if info.Mode()&os.ModeSymlink != 0 {
+			if target, err := os.Stat(filepath.Join(dir, dirEntry.Name())); err == nil {
+				isDir = target.IsDir()
+			}
+		}
+		entries = append(entries, fileEntry{Name: dirEntry.Name(), IsDir: isDir, Size: info.Size()})
+	}
+
+	slices.SortFunc(entries, func(a, b fileEntry) int {
+		if a.IsDir != b.IsDir {
+			if a.IsDir {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+
+	return entries, nil
+}
+
+// binaryFileLimit - примерный наибольший размер файла для записи при лимите сервера maxItemSize.
+// Содержимое кодируется в base64 дважды: в поле Binary и вместе с JSON значения,
+// поэтому значение примерно в 16/9 раза больше файла. Точно размер проверяет checkEncodedSize.
+func binaryFileLimit(maxItemSize int64) int64 {
+	if maxItemSize <= 0 {
+		return maxBinaryFileSize
+	}
+
+	limit := (maxItemSize/4*3 - binaryValueOverhead) / 4 * 3
+	if limit < 0 {
+		return 0
+	}
+
+	return limit
+}
+
+// checkEncodedSize - проверить, что закодированное значение записи помещается в лимит сервера
+func checkEncodedSize(value models.BinaryValue, maxItemSize int64) error {
+	if maxItemSize <= 0 {
+		return nil
+	}
+
+	encoded, err := models.EncodeValue(value)
+	if err != nil {
+		return err
+	}
+	if size := int64(len(encoded)); size > maxItemSize {
+		return fmt.Errorf("%w: %s > %s", errFileTooLarge, formatBytes(size), formatBytes(maxItemSize))
+	}
+
+	return nil
+}
+
+// readBinaryFile - прочитать файл не больше limit байт в значение записи "Бинарные данные"
+func readBinaryFile(path string, limit int64) (models.BinaryValue, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return models.BinaryValue{}, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return models.BinaryValue{}, err
+	}
+	if !info.Mode().IsRegular() {
+		return models.BinaryValue{}, fmt.Errorf("%s is not a regular file", filepath.Base(path))
+	}
+	if info.Size() > limit {
+		return models.BinaryValue{}, fmt.Errorf("%w: %s > %s", errFileTooLarge, formatBytes(info.Size()), formatBytes(limit))
+	}
+
+	// Файл мог вырасти после проверки размера
+	content, err := io.ReadAll(io.LimitReader(file, limit+1))
+	if err != nil {
+		return models.BinaryValue{}, err
+	}
+	if int64(len(content)) > limit {
+		return models.BinaryValue{}, fmt.Errorf("%w: > %s", errFileTooLarge, formatBytes(limit))
+	}
+
+	return models.NewBinaryFile(filepath.Base(path), detectMIMEType(path, content), content), nil
+}
+
+// detectMIMEType - тип файла по расширению, а если оно неизвестно - по содержимому
+func detectMIMEType(path string, content []byte) string {
+	mimeType := mime.TypeByExtension(filepath.Ext(path))
+	if mimeType == "" {
+		mimeType = http.DetectContentType(content)
+	}
+	mimeType, _, _ = strings.Cut(mimeType, ";")
+
+	return strings.TrimSpace(mimeType)
+}
+
+// binaryInfo - имя, размер и тип файла одной строкой
+func binaryInfo(value models.BinaryValue, size int) string {
+	name := value.FileName
+	if name == "" {
+		name = i18n.T("binary.unnamed")
+	}
+	mimeType := value.MIMEType
+	if mimeType == "" {
+		mimeType = "text/plain"
+	}
+
+	return fmt.Sprintf("%s, %s, %s", name, formatBytes(int64(size)), mimeType)
+}
+
+// binaryPreview - текст файла или шестнадцатеричный дамп, если файл двоичный
+func binaryPreview(content []byte) string {
+	if len(content) > previewLimit {
+		return i18n.T("binary.preview_too_large", formatBytes(previewLimit))
+	}
+	if isText(content) {
+		return string(content)
+	}
+
+	return strings.TrimRight(hex.Dump(content), "\n")
+}
+
+// isText - содержимое является текстом в UTF-8 без управляющих символов
+func isText(content []byte) bool {
+	if !utf8.Valid(content) {
+		return false
+	}
+
+	for _, r := range string(content) {
+		if unicode.IsControl(r) && r != '\n' && r != '\r' && r != '\t' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// writeBinaryFile - сохранить содержимое в файл, доступный только владельцу.
+// У существующего файла права тоже сужаются до 0600.
+func writeBinaryFile(path string, content []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+
+	err = file.Chmod(0o600)
+	if err == nil {
+		_, err = file.Write(content)
+	}
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
@@ -0,0 +1,97 @@
+package tui
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.txt"), []byte("hello"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "A.bin"), nil, 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "zeta"), 0o700))
+	require.NoError(t, os.Symlink(filepath.Join(dir, "zeta"), filepath.Join(dir, "link")))
+
+	entries, err := readDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []fileEntry{
+		{Name: "link", IsDir: true, Size: entries[0].Size},
+		{Name: "zeta", IsDir: true, Size: entries[1].Size},
+		{Name: "A.bin"},
+		{Name: "b.txt", Size: 5},
+	}, entries)
+
+	_, err = readDir(filepath.Join(dir, "missing"))
+	assert.Error(t, err)
+}
+
+func TestReadBinaryFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.pdf")
+	require.NoError(t, os.WriteFile(path, []byte("%PDF-1.4"), 0o600))
+
+	value, err := readBinaryFile(path, maxBinaryFileSize)
+	require.NoError(t, err)
+	assert.Equal(t, "report.pdf", value.FileName)
+	assert.Equal(t, "application/pdf", value.MIMEType)
+	assert.Equal(t, models.BinaryEncodingBase64, value.Encoding)
+	assert.Equal(t, "report.pdf, 8 Б, application/pdf", binaryInfo(value, 8))
+	assert.Equal(t, "без имени, 0 Б, text/plain", binaryInfo(models.BinaryValue{}, 0))
+
+	// Файл больше лимита записи не читается
+	_, err = readBinaryFile(path, 4)
+	assert.ErrorIs(t, err, errFileTooLarge)
+	_, err = readBinaryFile(filepath.Dir(path), maxBinaryFileSize)
+	assert.Error(t, err)
+
+	assert.Equal(t, int64(maxBinaryFileSize), binaryFileLimit(0))
+}
+
+func TestBinaryFileLimit(t *testing.T) {
+	const maxItemSize = 1024
+	limit := binaryFileLimit(maxItemSize)
+	require.Positive(t, limit)
+
+	// Файл наибольшего допустимого размера помещается в лимит после кодирования
+	value := models.NewBinaryFile("report.pdf", "application/pdf", bytes.Repeat([]byte{0xff}, int(limit)))
+	encoded, err := models.EncodeValue(value)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, len(encoded), maxItemSize)
+	assert.NoError(t, checkEncodedSize(value, maxItemSize))
+
+	// Файл на лимит сервера без учета кодирования не помещается
+	value = models.NewBinaryFile("report.pdf", "application/pdf", bytes.Repeat([]byte{0xff}, maxItemSize/4*3))
+	assert.ErrorIs(t, checkEncodedSize(value, maxItemSize), errFileTooLarge)
+	assert.NoError(t, checkEncodedSize(value, 0))
+}
+
+func TestDetectMIMEType(t *testing.T) {
+	assert.Equal(t, "image/png", detectMIMEType("image.png", nil))
+	assert.Equal(t, "text/plain", detectMIMEType("notes", []byte("hello")))
+	assert.Equal(t, "application/octet-stream", detectMIMEType("blob", []byte{0, 1, 2}))
+}
+
+func TestBinaryPreview(t *testing.T) {
+	assert.Equal(t, "строка\nвторая", binaryPreview([]byte("строка\nвторая")))
+	assert.Equal(t, "00000000  00 01 ff                                          |...|", binaryPreview([]byte{0, 1, 0xff}))
+	assert.Contains(t, binaryPreview(make([]byte, previewLimit+1)), "просмотр недоступен")
+}
+
+func TestWriteBinaryFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.bin")
+	require.NoError(t, os.WriteFile(path, []byte("old content"), 0o644))
+
+	require.NoError(t, writeBinaryFile(path, []byte("new")))
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "new", string(content))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+}
@@ -0,0 +1,32 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/breach"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/rivo/tview"
+)
+
+// SetBreachList - задать локальный список утечек для предупреждений в учетных данных
+func (tuiService *TUIService) SetBreachList(list breach.ListInterface) {
+	tuiService.breachList = list
+}
+
+// addBreachWarning - предупредить, если пароль найден в списке утечек
+func (tuiService *TUIService) addBreachWarning(form *tview.Form, password string) {
+	if tuiService.breachList == nil || password == "" {
+		return
+	}
+
+	count, err := tuiService.breachList.Count(password)
+	if err != nil {
+		tuiService.appLog.Error(fmt.Sprintf("error check breach: %v", err))
+		return
+	}
+	if count == 0 {
+		return
+	}
+
+	form.AddTextView(i18n.T("field.breach"), "[red::b]"+i18n.T("breach.found", count)+"[-::-]", 60, 1, true, false)
+}
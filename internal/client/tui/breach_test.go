@@ -0,0 +1,27 @@
+package tui
+
+import (
+	"testing"
+
+	breachMocks "github.com/ShukinDmitriy/GophKeeper/mocks/internal_/client/breach"
+	"github.com/rivo/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddBreachWarning(t *testing.T) {
+	list := breachMocks.NewListInterface(t)
+	list.EXPECT().Count("qwerty").Return(10556095, nil)
+	list.EXPECT().Count("6K[3}J:3Ftef&H7;G8Ne").Return(0, nil)
+
+	tuiService := &TUIService{}
+	tuiService.SetBreachList(list)
+
+	form := tview.NewForm()
+	tuiService.addBreachWarning(form, "qwerty")
+	assert.Equal(t, 1, form.GetFormItemCount())
+
+	form = tview.NewForm()
+	tuiService.addBreachWarning(form, "6K[3}J:3Ftef&H7;G8Ne")
+	tuiService.addBreachWarning(form, "")
+	assert.Equal(t, 0, form.GetFormItemCount())
+}
@@ -0,0 +1,97 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/clipboard"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// SetClipboard - задать буфер обмена и время, через которое скопированное значение удаляется
+func (tuiService *TUIService) SetClipboard(cb clipboard.ClipboardInterface, timeout time.Duration) {
+	tuiService.clipboard = cb
+	tuiService.clipboardTimeout = timeout
+}
+
+// copyField - скопировать поле записи; пустое имя означает основное поле типа
+func (tuiService *TUIService) copyField(data models.DataInfo, name string) {
+	fields, err := records.ValueFields(data)
+	if err != nil {
+		tuiService.appLog.Error(fmt.Sprintf("error decode value: %v", err))
+		return
+	}
+	if name == "" {
+		name = records.CopyField(data.Type)
+	}
+
+	field, err := records.FindField(fields, name)
+	if err != nil || field.Value == "" {
+		tuiService.showStatus(i18n.T("clipboard.no_field", name))
+		return
+	}
+
+	tuiService.copyText(field.Value, field.Name)
+}
+
+// copyOTP - скопировать текущий одноразовый код записи
+func (tuiService *TUIService) copyOTP(data models.DataInfo) {
+	fields, err := records.ValueFields(data)
+	if err != nil {
+		tuiService.appLog.Error(fmt.Sprintf("error decode value: %v", err))
+		return
+	}
+
+	code, err := records.OTPCode(fields, time.Now())
+	if err != nil {
+		tuiService.showStatus(i18n.T("clipboard.no_otp"))
+		return
+	}
+
+	tuiService.copyText(code, i18n.T("clipboard.otp"))
+}
+
+// copyText - записать значение в буфер обмена с последующей очисткой
+func (tuiService *TUIService) copyText(text string, name string) {
+	if tuiService.clipboard == nil {
+		tuiService.showStatus(clipboard.ErrUnavailable.Error())
+		return
+	}
+
+	_, err := clipboard.Copy(tuiService.clipboard, text, tuiService.clipboardTimeout)
+	if err != nil {
+		tuiService.appLog.Error(fmt.Sprintf("error copy to clipboard: %v", err))
+		tuiService.showStatus(err.Error())
+		return
+	}
+	tuiService.copiedSum = clipboard.Sum(text)
+
+	if tuiService.clipboardTimeout > 0 {
+		tuiService.showStatus(i18n.T("clipboard.copied_for", name, tuiService.clipboardTimeout))
+	} else {
+		tuiService.showStatus(i18n.T("clipboard.copied", name))
+	}
+}
+
+// clearClipboard - при выходе удалить из буфера скопированное значение, не дожидаясь таймера
+func (tuiService *TUIService) clearClipboard() {
+	if tuiService.clipboard == nil || tuiService.copiedSum == "" || tuiService.clipboardTimeout <= 0 {
+		return
+	}
+
+	_, err := clipboard.ClearIfUnchanged(tuiService.clipboard, tuiService.copiedSum)
+	if err != nil {
+		tuiService.appLog.Error(fmt.Sprintf("error clear clipboard: %v", err))
+	}
+	tuiService.copiedSum = ""
+}
+
+// showStatus - показать сообщение в заголовке списка записей
+func (tuiService *TUIService) showStatus(text string) {
+	tuiService.dataList.SetTitle(i18n.T("data.records") + " (" + text + ")")
+	if tuiService.searchList != nil {
+		tuiService.searchList.SetTitle(i18n.T("search.title") + " (" + text + ")")
+	}
+}
@@ -0,0 +1,70 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/rivo/tview"
+)
+
+// newDateInputField - поле ввода даты в формате ГГГГ-ММ-ДД.
+// Пустое значение сбрасывает дату, неполная дата не меняет текущее значение.
+func newDateInputField(label string, date **time.Time) *tview.InputField {
+	text := ""
+	if *date != nil {
+		text = (*date).Local().Format(time.DateOnly)
+	}
+
+	return tview.NewInputField().
+		SetLabel(label).
+		SetText(text).
+		SetPlaceholder(i18n.T("date.placeholder")).
+		SetFieldWidth(12).
+		SetAcceptanceFunc(func(textToCheck string, lastChar rune) bool {
+			return len(textToCheck) <= len(time.DateOnly) && ((lastChar >= '0' && lastChar <= '9') || lastChar == '-')
+		}).
+		SetChangedFunc(func(text string) {
+			text = strings.TrimSpace(text)
+			if text == "" {
+				*date = nil
+				return
+			}
+
+			parsed, err := time.ParseInLocation(time.DateOnly, text, time.Local)
+			if err != nil {
+				return
+			}
+			*date = &parsed
+		})
+}
+
+// dataListTitle - заголовок записи в списке с подсветкой истекших и истекающих записей
+func dataListTitle(data models.DataInfo, now time.Time) string {
+	title := fmt.Sprintf("%d. %s", data.ID, tview.Escape(data.Description))
+
+	switch {
+	case data.Expired(now):
+		return "[red]" + title + " " + i18n.T("data.expired") + "[-]"
+	case data.ExpiresWithin(now, records.ExpirySoonDays):
+		return "[yellow]" + title + "[-]"
+	}
+
+	return title
+}
+
+// ExpiringNotice - показать количество истекающих записей в заголовке списка типов
+func (tuiService *TUIService) ExpiringNotice(dataList []models.DataInfo) {
+	title := i18n.T("data.types")
+	if len(dataList) > 0 {
+		title += " [red]" + i18n.T("data.expiring", len(dataList)) + "[-]"
+	}
+	tuiService.dataTypes.SetTitle(title)
+
+	if tuiService.running {
+		tuiService.application.Draw()
+	}
+}
@@ -0,0 +1,225 @@
+package tui
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/event"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/router"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/rivo/tview"
+)
+
+// fieldTypeTitles - названия типов пользовательских полей
+func fieldTypeTitles() []string {
+	return []string{
+		i18n.T("field_type.text"),
+		i18n.T("field_type.hidden"),
+		i18n.T("field_type.url"),
+		i18n.T("field_type.date"),
+		i18n.T("field_type.boolean"),
+	}
+}
+
+// urlMatchTitles - названия правил сопоставления URL
+func urlMatchTitles() []string {
+	return []string{
+		i18n.T("url_match.domain"),
+		i18n.T("url_match.host"),
+		i18n.T("url_match.starts_with"),
+		i18n.T("url_match.exact"),
+		i18n.T("url_match.regexp"),
+		i18n.T("url_match.never"),
+	}
+}
+
+// drawDataMeta - отрисовать пользовательские поля и адреса записи
+func (tuiService *TUIService) drawDataMeta(data *models.DataInfo, value interface{}) {
+	tuiService.dataForm.
+		AddFormItem(newDateInputField(i18n.T("field.rotate_at"), &data.RotateAt)).
+		AddFormItem(newDateInputField(i18n.T("field.expires_at"), &data.ExpiresAt))
+
+	if !data.UpdatedAt.IsZero() {
+		tuiService.dataForm.AddTextView(i18n.T("field.updated_at"), data.UpdatedAt.Local().Format(time.DateTime), 50, 1, true, false)
+	}
+
+	for i, field := range data.Fields {
+		switch field.Type {
+		case models.FieldTypeHidden:
+			tuiService.dataForm.AddFormItem(tuiService.newSecretInput(field.Name, field.Value, false, func(text string) {
+				data.Fields[i].Value = text
+			}))
+		case models.FieldTypeBoolean:
+			checked, _ := strconv.ParseBool(field.Value)
+			tuiService.dataForm.AddCheckbox(field.Name, checked, func(checked bool) {
+				data.Fields[i].Value = strconv.FormatBool(checked)
+			})
+		case models.FieldTypeDate:
+			tuiService.dataForm.AddInputField(field.Name, field.Value, 50, func(textToCheck string, lastChar rune) bool {
+				return (lastChar >= '0' && lastChar <= '9') || lastChar == '-'
+			}, func(text string) {
+				data.Fields[i].Value = text
+			})
+		default:
+			tuiService.dataForm.AddInputField(field.Name, field.Value, 50, nil, func(text string) {
+				data.Fields[i].Value = text
+			})
+		}
+	}
+
+	if data.Type == models.DataTypeCredentials {
+		for i, dataURL := range data.URLs {
+			tuiService.dataForm.
+				AddInputField("URL "+strconv.Itoa(i+1), dataURL.URL, 50, nil, func(text string) {
+					data.URLs[i].URL = text
+				}).
+				AddDropDown(i18n.T("field.url_match"), urlMatchTitles(), int(dataURL.Match), func(_ string, index int) {
+					data.URLs[i].Match = models.URLMatch(index)
+				})
+		}
+	}
+
+	tuiService.dataForm.AddButton(i18n.T("button.add_field"), func() {
+		tuiService.addFieldPage(data, value)
+	})
+
+	if len(data.Fields) > 0 {
+		tuiService.dataForm.AddButton(i18n.T("button.remove_field"), func() {
+			tuiService.removeFieldPage(data, value)
+		})
+	}
+
+	if data.Type == models.DataTypeCredentials {
+		tuiService.dataForm.AddButton(i18n.T("button.add_url"), func() {
+			tuiService.addURLPage(data, value)
+		})
+	}
+}
+
+// updateData - сохранить изменения записи
+func (tuiService *TUIService) updateData(data models.DataInfo, value interface{}) {
+	base64Data := tuiService.dataToBase64(value)
+	if base64Data == "" {
+		return
+	}
+	data.Value = base64Data
+
+	// Пустой адрес означает, что его удалили
+	urls := make(models.DataURLs, 0, len(data.URLs))
+	for _, dataURL := range data.URLs {
+		if strings.TrimSpace(dataURL.URL) != "" {
+			urls = append(urls, dataURL)
+		}
+	}
+	data.URLs = urls
+
+	tuiService.eventBus.Next(&event.Event{
+		Name: event.ClientEventUpdateData,
+		Data: data,
+	})
+}
+
+// redrawDataRow - перерисовать запись с учетом несохраненных изменений
+func (tuiService *TUIService) redrawDataRow(data *models.DataInfo, value interface{}) {
+	base64Data := tuiService.dataToBase64(value)
+	if base64Data != "" {
+		data.Value = base64Data
+	}
+
+	tuiService.pages.SwitchToPage(router.DataPage)
+	tuiService.eventBus.Next(&event.Event{
+		Name: event.ClientEventSelectDataRow,
+		Data: *data,
+	})
+}
+
+// addFieldPage - отобразить форму добавления пользовательского поля
+func (tuiService *TUIService) addFieldPage(data *models.DataInfo, value interface{}) {
+	field := models.DataField{}
+
+	form := tview.NewForm().
+		AddInputField(i18n.T("field.name"), "", 30, nil, func(text string) {
+			field.Name = text
+		}).
+		AddDropDown(i18n.T("field.type"), fieldTypeTitles(), 0, func(_ string, index int) {
+			field.Type = models.FieldType(index)
+		}).
+		AddInputField(i18n.T("field.value"), "", 50, nil, func(text string) {
+			field.Value = text
+		}).
+		AddButton(i18n.T("button.add"), func() {
+			if strings.TrimSpace(field.Name) == "" {
+				return
+			}
+			if field.Type == models.FieldTypeBoolean {
+				checked, _ := strconv.ParseBool(field.Value)
+				field.Value = strconv.FormatBool(checked)
+			}
+
+			data.Fields = append(data.Fields, field)
+			tuiService.redrawDataRow(data, value)
+		}).
+		AddButton(i18n.T("button.cancel"), func() {
+			tuiService.pages.SwitchToPage(router.DataPage)
+		})
+	form.SetBorder(true).SetTitle(i18n.T("field.new_title"))
+
+	tuiService.pages.AddAndSwitchToPage(router.FieldPage, form, true)
+}
+
+// removeFieldPage - отобразить форму удаления пользовательского поля
+func (tuiService *TUIService) removeFieldPage(data *models.DataInfo, value interface{}) {
+	names := make([]string, 0, len(data.Fields))
+	for _, field := range data.Fields {
+		names = append(names, field.Name)
+	}
+	index := 0
+
+	form := tview.NewForm().
+		AddDropDown(i18n.T("field.field"), names, 0, func(_ string, optionIndex int) {
+			index = optionIndex
+		}).
+		AddButton(i18n.T("button.delete"), func() {
+			if index < 0 || index >= len(data.Fields) {
+				return
+			}
+
+			data.Fields = append(data.Fields[:index:index], data.Fields[index+1:]...)
+			tuiService.redrawDataRow(data, value)
+		}).
+		AddButton(i18n.T("button.cancel"), func() {
+			tuiService.pages.SwitchToPage(router.DataPage)
+		})
+	form.SetBorder(true).SetTitle(i18n.T("field.remove_title"))
+
+	tuiService.pages.AddAndSwitchToPage(router.FieldPage, form, true)
+}
+
+// addURLPage - отобразить форму добавления адреса
+func (tuiService *TUIService) addURLPage(data *models.DataInfo, value interface{}) {
+	dataURL := models.DataURL{}
+
+	form := tview.NewForm().
+		AddInputField("URL", "", 50, nil, func(text string) {
+			dataURL.URL = text
+		}).
+		AddDropDown(i18n.T("field.url_match"), urlMatchTitles(), 0, func(_ string, index int) {
+			dataURL.Match = models.URLMatch(index)
+		}).
+		AddButton(i18n.T("button.add"), func() {
+			if strings.TrimSpace(dataURL.URL) == "" {
+				return
+			}
+
+			data.URLs = append(data.URLs, dataURL)
+			tuiService.redrawDataRow(data, value)
+		}).
+		AddButton(i18n.T("button.cancel"), func() {
+			tuiService.pages.SwitchToPage(router.DataPage)
+		})
+	form.SetBorder(true).SetTitle(i18n.T("field.new_url_title"))
+
+	tuiService.pages.AddAndSwitchToPage(router.FieldPage, form, true)
+}
@@ -0,0 +1,121 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/event"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/router"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// dataListInputCapture - выбор нескольких записей: пробел отмечает запись, Delete удаляет отмеченные.
+// Клавиши u, c и o копируют логин, основное поле записи и одноразовый код.
+func (tuiService *TUIService) dataListInputCapture(key *tcell.EventKey) *tcell.EventKey {
+	if key.Key() == tcell.KeyRune {
+		if data, ok := tuiService.currentData(); ok {
+			switch key.Rune() {
+			case 'u':
+				tuiService.copyField(data, "login")
+				return nil
+			case 'c':
+				tuiService.copyField(data, "")
+				return nil
+			case 'o':
+				tuiService.copyOTP(data)
+				return nil
+			}
+		}
+	}
+
+	switch {
+	case key.Key() == tcell.KeyRune && key.Rune() == ' ':
+		tuiService.toggleSelection(tuiService.dataList.GetCurrentItem())
+
+		return nil
+	case key.Key() == tcell.KeyDelete:
+		if len(tuiService.selected) > 0 {
+			tuiService.confirmDeleteSelected()
+
+			return nil
+		}
+	}
+
+	return key
+}
+
+// currentData - запись под курсором в списке
+func (tuiService *TUIService) currentData() (models.DataInfo, bool) {
+	index := tuiService.dataList.GetCurrentItem()
+	if index < 0 || index >= len(tuiService.listData) {
+		return models.DataInfo{}, false
+	}
+
+	return tuiService.listData[index], true
+}
+
+// resetSelection - сбросить отметки при отрисовке нового списка
+func (tuiService *TUIService) resetSelection(dataList []models.DataInfo) {
+	tuiService.listData = dataList
+	tuiService.selected = make(map[uint]bool)
+	tuiService.dataList.SetTitle(i18n.T("data.records"))
+}
+
+// toggleSelection - отметить запись или снять отметку
+func (tuiService *TUIService) toggleSelection(index int) {
+	if index < 0 || index >= len(tuiService.listData) {
+		return
+	}
+
+	data := tuiService.listData[index]
+	title := dataListTitle(data, time.Now())
+	if tuiService.selected[data.ID] {
+		delete(tuiService.selected, data.ID)
+	} else {
+		tuiService.selected[data.ID] = true
+		title = "[::r]✓[::-] " + title
+	}
+	tuiService.dataList.SetItemText(index, title, "")
+
+	if len(tuiService.selected) > 0 {
+		tuiService.dataList.SetTitle(i18n.T("data.records_selected", len(tuiService.selected)))
+	} else {
+		tuiService.dataList.SetTitle(i18n.T("data.records"))
+	}
+}
+
+// selectedData - отмеченные записи в порядке списка
+func (tuiService *TUIService) selectedData() []models.DataInfo {
+	res := make([]models.DataInfo, 0, len(tuiService.selected))
+	for _, data := range tuiService.listData {
+		if tuiService.selected[data.ID] {
+			res = append(res, data)
+		}
+	}
+
+	return res
+}
+
+// confirmDeleteSelected - подтвердить удаление отмеченных записей
+func (tuiService *TUIService) confirmDeleteSelected() {
+	dataList := tuiService.selectedData()
+
+	modal := tview.NewModal().
+		SetText(i18n.T("data.delete_selected", len(dataList))).
+		AddButtons([]string{i18n.T("button.delete"), i18n.T("button.cancel")}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			tuiService.pages.SwitchToPage(router.DataPage)
+			if buttonIndex != 0 {
+				return
+			}
+
+			tuiService.eventBus.Next(&event.Event{
+				Name: event.ClientEventDeleteDataBatch,
+				Data: dataList,
+			})
+		})
+
+	tuiService.pages.AddAndSwitchToPage(router.ConfirmPage, modal, true)
+}
@@ -0,0 +1,188 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/router"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// filePickerPage - выбрать файл для загрузки или, если save, путь для сохранения.
+// При сохранении name - предлагаемое имя файла, существующий файл перезаписывается после подтверждения.
+func (tuiService *TUIService) filePickerPage(title string, name string, save bool, done func(path string)) {
+	dir := tuiService.pickerDir
+	if dir == "" {
+		dir, _ = os.Getwd()
+	}
+
+	pathView := tview.NewTextView().SetDynamicColors(true)
+	list := tview.NewList().ShowSecondaryText(false)
+	list.SetBorder(true).SetTitle(title)
+	nameInput := tview.NewInputField().SetLabel(i18n.T("picker.file_name")).SetText(name)
+
+	back := func() {
+		tuiService.pages.RemovePage(router.FilePage)
+		tuiService.pages.SwitchToPage(router.DataPage)
+	}
+	finish := func(path string) {
+		tuiService.pickerDir = filepath.Dir(path)
+		back()
+		done(path)
+	}
+
+	var open func(next string)
+	open = func(next string) {
+		entries, err := readDir(next)
+		if err != nil {
+			pathView.SetText("[red]" + tview.Escape(err.Error()) + "[-]")
+			return
+		}
+
+		dir = next
+		pathView.SetText(tview.Escape(dir))
+		list.Clear()
+		if parent := filepath.Dir(dir); parent != dir {
+			list.AddItem("../", "", 0, func() {
+				open(parent)
+			})
+		}
+		for _, entry := range entries {
+			path := filepath.Join(dir, entry.Name)
+			if entry.IsDir {
+				list.AddItem(tview.Escape(entry.Name)+"/", "", 0, func() {
+					open(path)
+				})
+				continue
+			}
+
+			list.AddItem(fmt.Sprintf("%s  [gray]%s[-]", tview.Escape(entry.Name), formatBytes(entry.Size)), "", 0, func() {
+				if save {
+					nameInput.SetText(entry.Name)
+					tuiService.application.SetFocus(nameInput)
+					return
+				}
+				finish(path)
+			})
+		}
+	}
+
+	list.SetDoneFunc(back)
+	list.SetInputCapture(func(key *tcell.EventKey) *tcell.EventKey {
+		if key.Key() == tcell.KeyBackspace || key.Key() == tcell.KeyBackspace2 {
+			open(filepath.Dir(dir))
+			return nil
+		}
+		return vimNavigation(key)
+	})
+
+	saveTo := func() {
+		fileName := strings.TrimSpace(nameInput.GetText())
+		if fileName == "" {
+			return
+		}
+		path := fileName
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+
+		info, err := os.Stat(path)
+		switch {
+		case err == nil && info.IsDir():
+			open(path)
+			tuiService.application.SetFocus(list)
+		case err == nil:
+			tuiService.confirmOverwrite(path, func() {
+				finish(path)
+			})
+		case errors.Is(err, fs.ErrNotExist):
+			finish(path)
+		default:
+			pathView.SetText("[red]" + tview.Escape(err.Error()) + "[-]")
+		}
+	}
+
+	buttons := tview.NewForm()
+	flex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(pathView, 1, 0, false).
+		AddItem(list, 0, 1, true)
+	if save {
+		nameInput.SetDoneFunc(func(key tcell.Key) {
+			switch key {
+			case tcell.KeyEnter:
+				saveTo()
+			case tcell.KeyEscape:
+				back()
+			default:
+				tuiService.application.SetFocus(buttons)
+			}
+		})
+		buttons.AddButton(i18n.T("button.save"), saveTo)
+		flex.AddItem(nameInput, 1, 0, false)
+	}
+	buttons.AddButton(i18n.T("button.cancel"), back)
+	buttons.SetCancelFunc(back)
+	flex.AddItem(buttons, 3, 0, false)
+
+	open(dir)
+	tuiService.pages.AddAndSwitchToPage(router.FilePage, flex, true)
+	tuiService.application.SetFocus(list)
+
+	if tuiService.running {
+		tuiService.application.Draw()
+	}
+}
+
+// confirmOverwrite - подтвердить замену существующего файла
+func (tuiService *TUIService) confirmOverwrite(path string, overwrite func()) {
+	modal := tview.NewModal().
+		SetText(i18n.T("picker.overwrite", path)).
+		AddButtons([]string{i18n.T("button.replace"), i18n.T("button.cancel")}).
+		SetDoneFunc(func(buttonIndex int, _ string) {
+			tuiService.pages.RemovePage(router.ConfirmPage)
+			if buttonIndex == 0 {
+				overwrite()
+			}
+		})
+
+	tuiService.pages.AddPage(router.ConfirmPage, modal, true, true)
+}
+
+// pickBinaryFile - выбрать файл и прочитать его в значение записи
+func (tuiService *TUIService) pickBinaryFile(done func(value models.BinaryValue)) {
+	limit := binaryFileLimit(tuiService.maxItemSize)
+	title := i18n.T("picker.open_title", formatBytes(limit))
+	tuiService.filePickerPage(title, "", false, func(path string) {
+		value, err := readBinaryFile(path, limit)
+		if err == nil {
+			err = checkEncodedSize(value, tuiService.maxItemSize)
+		}
+		if err != nil {
+			tuiService.appLog.Error(fmt.Sprintf("error read file: %v", err))
+			tuiService.DataError(err.Error())
+			return
+		}
+
+		done(value)
+	})
+}
+
+// saveBinaryFile - выбрать путь и сохранить содержимое записи в файл
+func (tuiService *TUIService) saveBinaryFile(content []byte, name string) {
+	tuiService.filePickerPage(i18n.T("picker.save_title"), name, true, func(path string) {
+		if err := writeBinaryFile(path, content); err != nil {
+			tuiService.appLog.Error(fmt.Sprintf("error write file: %v", err))
+			tuiService.DataError(err.Error())
+			return
+		}
+
+		tuiService.showStatus(i18n.T("picker.saved", filepath.Base(path)))
+	})
+}
@@ -0,0 +1,127 @@
+package tui
+
+import (
+	"strconv"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/generator"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/router"
+	"github.com/rivo/tview"
+)
+
+// generatorKinds - что генерировать
+func generatorKinds() []string {
+	return []string{i18n.T("generator.password"), i18n.T("generator.passphrase")}
+}
+
+// addGenerateButton - добавить в форму кнопку генерации значения для поля label
+func (tuiService *TUIService) addGenerateButton(form *tview.Form, label string) {
+	var setText func(text string)
+	switch input := form.GetFormItemByLabel(label).(type) {
+	case *tview.InputField:
+		setText = func(text string) {
+			input.SetText(text)
+		}
+	case *secretInput:
+		setText = input.SetValue
+	default:
+		return
+	}
+
+	form.AddButton(i18n.T("button.generate"), func() {
+		tuiService.generatorPage(func(text string) {
+			// SetText вызывает обработчик изменения поля, значение попадает в запись
+			setText(text)
+		})
+	})
+}
+
+// generatorPage - отобразить генератор паролей, apply получает выбранное значение
+func (tuiService *TUIService) generatorPage(apply func(text string)) {
+	passwordOpts := generator.DefaultPasswordOptions()
+	passphraseOpts := generator.DefaultPassphraseOptions()
+	passphrase := false
+
+	var (
+		res         generator.Result
+		resultView  *tview.TextView
+		entropyView *tview.TextView
+	)
+	generate := func() {
+		// Обработчики полей вызываются и при построении формы
+		if resultView == nil || entropyView == nil {
+			return
+		}
+
+		var err error
+		if passphrase {
+			res, err = generator.Passphrase(passphraseOpts)
+		} else {
+			res, err = generator.Password(passwordOpts)
+		}
+		if err != nil {
+			res = generator.Result{}
+			resultView.SetText(err.Error())
+			entropyView.SetText("")
+			return
+		}
+
+		resultView.SetText(res.Value)
+		entropyView.SetText(i18n.T("strength.entropy", res.Entropy, generator.Strength(res.Entropy)))
+	}
+	intChanged := func(value *int) func(text string) {
+		return func(text string) {
+			*value, _ = strconv.Atoi(text)
+			generate()
+		}
+	}
+	boolChanged := func(value *bool) func(checked bool) {
+		return func(checked bool) {
+			*value = checked
+			generate()
+		}
+	}
+
+	resultLabel := i18n.T("generator.result")
+	entropyLabel := i18n.T("generator.entropy")
+	form := tview.NewForm().
+		AddDropDown(i18n.T("generator.kind"), generatorKinds(), 0, func(_ string, index int) {
+			passphrase = index == 1
+			generate()
+		}).
+		AddInputField(i18n.T("generator.length"), strconv.Itoa(passwordOpts.Length), 5, tview.InputFieldInteger, intChanged(&passwordOpts.Length)).
+		AddCheckbox(i18n.T("generator.lower"), passwordOpts.Lower, boolChanged(&passwordOpts.Lower)).
+		AddCheckbox(i18n.T("generator.upper"), passwordOpts.Upper, boolChanged(&passwordOpts.Upper)).
+		AddCheckbox(i18n.T("generator.digits"), passwordOpts.Digits, boolChanged(&passwordOpts.Digits)).
+		AddCheckbox(i18n.T("generator.symbols"), passwordOpts.Symbols, boolChanged(&passwordOpts.Symbols)).
+		AddCheckbox(i18n.T("generator.exclude_ambiguous"), passwordOpts.ExcludeAmbiguous, boolChanged(&passwordOpts.ExcludeAmbiguous)).
+		AddInputField(i18n.T("generator.words"), strconv.Itoa(passphraseOpts.Words), 5, tview.InputFieldInteger, intChanged(&passphraseOpts.Words)).
+		AddInputField(i18n.T("generator.separator"), passphraseOpts.Separator, 5, nil, func(text string) {
+			passphraseOpts.Separator = text
+			generate()
+		}).
+		AddCheckbox(i18n.T("generator.capitalize"), passphraseOpts.Capitalize, boolChanged(&passphraseOpts.Capitalize)).
+		AddCheckbox(i18n.T("generator.number"), passphraseOpts.Number, boolChanged(&passphraseOpts.Number)).
+		AddTextView(resultLabel, "", 60, 2, false, false).
+		AddTextView(entropyLabel, "", 60, 1, false, false)
+
+	resultView, _ = form.GetFormItemByLabel(resultLabel).(*tview.TextView)
+	entropyView, _ = form.GetFormItemByLabel(entropyLabel).(*tview.TextView)
+	generate()
+
+	form.
+		AddButton(i18n.T("button.use"), func() {
+			if res.Value == "" {
+				return
+			}
+			apply(res.Value)
+			tuiService.pages.SwitchToPage(router.DataPage)
+		}).
+		AddButton(i18n.T("button.refresh"), generate).
+		AddButton(i18n.T("button.cancel"), func() {
+			tuiService.pages.SwitchToPage(router.DataPage)
+		})
+	form.SetBorder(true).SetTitle(i18n.T("generator.title"))
+
+	tuiService.pages.AddAndSwitchToPage(router.GeneratorPage, form, true)
+}
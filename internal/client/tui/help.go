@@ -0,0 +1,103 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/router"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// keyBinding - горячая клавиша и ее действие
+type keyBinding struct {
+	Key         string
+	Description string
+}
+
+// keySection - раздел справки с горячими клавишами
+type keySection struct {
+	Title    string
+	Bindings []keyBinding
+}
+
+// keyBindings - горячие клавиши по разделам в порядке отображения
+func keyBindings() []keySection {
+	return []keySection{
+		{Title: i18n.T("help.everywhere"), Bindings: []keyBinding{
+			{Key: "Ctrl+L", Description: i18n.T("help.lock")},
+			{Key: "?", Description: i18n.T("help.help")},
+			{Key: "/", Description: i18n.T("help.search")},
+		}},
+		{Title: i18n.T("help.lists"), Bindings: []keyBinding{
+			{Key: "j, k", Description: i18n.T("help.down_up")},
+			{Key: "g, G", Description: i18n.T("help.home_end")},
+			{Key: "h, l", Description: i18n.T("help.next_column")},
+			{Key: "Enter", Description: i18n.T("help.open")},
+			{Key: "c", Description: i18n.T("help.copy_main")},
+			{Key: "u", Description: i18n.T("help.copy_login")},
+			{Key: "o", Description: i18n.T("help.copy_otp")},
+			{Key: i18n.T("help.key_space"), Description: i18n.T("help.select")},
+			{Key: "Delete", Description: i18n.T("help.delete_selected")},
+		}},
+		{Title: i18n.T("help.form"), Bindings: []keyBinding{
+			{Key: "Ctrl+R", Description: i18n.T("help.reveal")},
+			{Key: "Tab", Description: i18n.T("help.next_field")},
+		}},
+		{Title: i18n.T("help.search_section"), Bindings: []keyBinding{
+			{Key: "↓, Tab", Description: i18n.T("help.to_results")},
+			{Key: "Enter", Description: i18n.T("help.open")},
+			{Key: "e", Description: i18n.T("help.edit")},
+			{Key: "d, Delete", Description: i18n.T("help.delete")},
+			{Key: "/", Description: i18n.T("help.edit_query")},
+			{Key: "Esc, q", Description: i18n.T("help.back")},
+		}},
+		{Title: i18n.T("help.file_picker"), Bindings: []keyBinding{
+			{Key: "Enter", Description: i18n.T("help.picker_open")},
+			{Key: "Backspace", Description: i18n.T("help.picker_up")},
+			{Key: "Esc", Description: i18n.T("help.cancel")},
+		}},
+	}
+}
+
+// formatHelp - список горячих клавиш с цветовыми тегами tview
+func formatHelp() string {
+	var builder strings.Builder
+	for i, section := range keyBindings() {
+		if i > 0 {
+			builder.WriteString("\n")
+		}
+		builder.WriteString("[yellow::b]" + section.Title + "[-::-]\n")
+		for _, binding := range section.Bindings {
+			builder.WriteString(fmt.Sprintf("  [green]%-10s[-] %s\n", tview.Escape(binding.Key), binding.Description))
+		}
+	}
+
+	return builder.String()
+}
+
+// helpPage - показать горячие клавиши поверх текущей страницы
+func (tuiService *TUIService) helpPage() {
+	view := tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetText(formatHelp())
+	view.SetBorder(true).SetTitle(i18n.T("help.title"))
+	view.SetInputCapture(func(key *tcell.EventKey) *tcell.EventKey {
+		if key.Key() == tcell.KeyEscape || (key.Key() == tcell.KeyRune && (key.Rune() == '?' || key.Rune() == 'q')) {
+			tuiService.pages.RemovePage(router.HelpPage)
+			return nil
+		}
+		return vimNavigation(key)
+	})
+
+	// Окно по центру экрана
+	grid := tview.NewGrid().
+		SetColumns(0, 60, 0).
+		SetRows(0, 30, 0).
+		AddItem(view, 1, 1, 1, 1, 0, 0, true)
+
+	tuiService.pages.AddPage(router.HelpPage, grid, true, true)
+	tuiService.application.SetFocus(view)
+}
@@ -0,0 +1,26 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVimNavigation(t *testing.T) {
+	assert.Equal(t, tcell.KeyDown, vimNavigation(tcell.NewEventKey(tcell.KeyRune, 'j', tcell.ModNone)).Key())
+	assert.Equal(t, tcell.KeyUp, vimNavigation(tcell.NewEventKey(tcell.KeyRune, 'k', tcell.ModNone)).Key())
+	assert.Equal(t, tcell.KeyHome, vimNavigation(tcell.NewEventKey(tcell.KeyRune, 'g', tcell.ModNone)).Key())
+	assert.Equal(t, tcell.KeyEnd, vimNavigation(tcell.NewEventKey(tcell.KeyRune, 'G', tcell.ModShift)).Key())
+
+	key := tcell.NewEventKey(tcell.KeyRune, 'j', tcell.ModAlt)
+	assert.Same(t, key, vimNavigation(key))
+	key = tcell.NewEventKey(tcell.KeyRune, 'x', tcell.ModNone)
+	assert.Same(t, key, vimNavigation(key))
+}
+
+func TestFormatHelp(t *testing.T) {
+	help := formatHelp()
+	assert.Contains(t, help, "[yellow::b]Поиск[-::-]")
+	assert.Contains(t, help, "[green]Ctrl+L    [-] заблокировать хранилище")
+}
@@ -0,0 +1,128 @@
+package tui
+
+import (
+	"sync/atomic"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/event"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/router"
+	"github.com/gdamore/tcell/v2"
+)
+
+const (
+	// lockCheckInterval - как часто проверять бездействие пользователя
+	lockCheckInterval = 5 * time.Second
+	// suspendThreshold - насколько системные часы должны убежать от монотонных, чтобы считать, что компьютер спал
+	suspendThreshold = 30 * time.Second
+	// LockKey - горячая клавиша блокировки
+	LockKey = tcell.KeyCtrlL
+)
+
+// lockedPages - страницы, на которых могут остаться расшифрованные данные
+var lockedPages = []string{
+	router.DataPage,
+	router.FieldPage,
+	router.ConfirmPage,
+	router.GeneratorPage,
+	router.AuditPage,
+	router.SearchPage,
+	router.HelpPage,
+	router.FilePage,
+	router.ErrorPage,
+	router.LoginPage,
+	router.RegisterPage,
+}
+
+// lockState - состояние автоматической блокировки
+type lockState struct {
+	timeout      time.Duration
+	unlocked     atomic.Bool
+	lastActivity atomic.Int64
+}
+
+// SetLockTimeout - через сколько бездействия блокировать интерфейс, 0 - не блокировать по таймеру
+func (tuiService *TUIService) SetLockTimeout(timeout time.Duration) {
+	tuiService.lock.timeout = timeout
+}
+
+// Lock - удалить расшифрованные данные с экрана и из памяти и показать страницу входа.
+// Вызывается клиентом после того, как он забыл сессию.
+func (tuiService *TUIService) Lock(login string) {
+	tuiService.lock.unlocked.Store(false)
+	tuiService.clearClipboard()
+
+	tuiService.listData = nil
+	tuiService.selected = nil
+	tuiService.searchList = nil
+	if tuiService.dataForm != nil {
+		tuiService.dataForm.Clear(true)
+	}
+	if tuiService.dataList != nil {
+		tuiService.dataList.Clear()
+	}
+
+	// Страницы создаются заново после входа, в том числе форма входа с введенным паролем
+	for _, page := range lockedPages {
+		tuiService.pages.RemovePage(page)
+	}
+	tuiService.login = login
+
+	tuiService.LoginPage()
+}
+
+// requestLock - попросить клиента заблокировать хранилище
+func (tuiService *TUIService) requestLock() {
+	if !tuiService.lock.unlocked.CompareAndSwap(true, false) {
+		return
+	}
+
+	tuiService.eventBus.Next(&event.Event{
+		Name: event.ClientEventLock,
+	})
+}
+
+// touch - запомнить время последнего действия пользователя
+func (tuiService *TUIService) touch() {
+	tuiService.lock.lastActivity.Store(time.Now().UnixNano())
+}
+
+// lockInputCapture - отслеживать действия пользователя и горячую клавишу блокировки
+func (tuiService *TUIService) lockInputCapture(key *tcell.EventKey) *tcell.EventKey {
+	tuiService.touch()
+	if key.Key() == LockKey && tuiService.lock.unlocked.Load() {
+		tuiService.requestLock()
+		return nil
+	}
+
+	return key
+}
+
+// watchIdle - блокировать интерфейс после бездействия или сна компьютера
+func (tuiService *TUIService) watchIdle() {
+	ticker := time.NewTicker(lockCheckInterval)
+	defer ticker.Stop()
+
+	previous := time.Now()
+	for now := range ticker.C {
+		if !tuiService.running {
+			return
+		}
+
+		lastActivity := time.Unix(0, tuiService.lock.lastActivity.Load())
+		wall := now.Round(0).Sub(previous.Round(0))
+		if suspended(wall, now.Sub(previous)) || idle(lastActivity, now, tuiService.lock.timeout) {
+			tuiService.requestLock()
+		}
+		previous = now
+	}
+}
+
+// suspended - между проверками компьютер спал: во сне монотонные часы стоят, а системные идут
+func suspended(wall time.Duration, monotonic time.Duration) bool {
+	return wall-monotonic > suspendThreshold
+}
+
+// idle - пользователь бездействует дольше timeout
+func idle(lastActivity time.Time, now time.Time, timeout time.Duration) bool {
+	return timeout > 0 && now.Sub(lastActivity) >= timeout
+}
@@ -0,0 +1,21 @@
+package tui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuspended(t *testing.T) {
+	assert.False(t, suspended(lockCheckInterval, lockCheckInterval))
+	assert.False(t, suspended(lockCheckInterval+time.Second, lockCheckInterval))
+	assert.True(t, suspended(time.Hour, lockCheckInterval))
+}
+
+func TestIdle(t *testing.T) {
+	now := time.Now()
+	assert.False(t, idle(now.Add(-time.Minute), now, 5*time.Minute))
+	assert.True(t, idle(now.Add(-5*time.Minute), now, 5*time.Minute))
+	assert.False(t, idle(now.Add(-time.Hour), now, 0))
+}
@@ -0,0 +1,85 @@
+package tui
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/rivo/tview"
+)
+
+var (
+	markdownHeading = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	markdownBullet  = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	markdownQuote   = regexp.MustCompile(`^>\s?(.*)$`)
+	markdownInline  = regexp.MustCompile("\\*\\*([^*]+)\\*\\*|`([^`]+)`|\\[([^\\]]+)\\]\\(([^)]+)\\)|\\*([^*]+)\\*|_([^_]+)_")
+)
+
+// renderMarkdown - преобразовать Markdown в текст с цветовыми тегами tview
+func renderMarkdown(text string) string {
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	res := make([]string, 0, len(lines))
+	inCode := false
+
+	for _, line := range lines {
+		if strings.HasPrefix(strings.TrimSpace(line), "```") {
+			inCode = !inCode
+			continue
+		}
+
+		if inCode {
+			res = append(res, "[green]"+tview.Escape(line)+"[-]")
+			continue
+		}
+
+		if match := markdownHeading.FindStringSubmatch(line); match != nil {
+			res = append(res, "[yellow::b]"+renderMarkdownInline(match[2])+"[-::-]")
+			continue
+		}
+
+		if match := markdownBullet.FindStringSubmatch(line); match != nil {
+			res = append(res, match[1]+"  • "+renderMarkdownInline(match[2]))
+			continue
+		}
+
+		if match := markdownQuote.FindStringSubmatch(line); match != nil {
+			res = append(res, "[gray]│ "+renderMarkdownInline(match[1])+"[-]")
+			continue
+		}
+
+		res = append(res, renderMarkdownInline(line))
+	}
+
+	return strings.Join(res, "\n")
+}
+
+// renderMarkdownInline - преобразовать строчные элементы Markdown (выделение, код, ссылки)
+func renderMarkdownInline(line string) string {
+	var builder strings.Builder
+	last := 0
+
+	for _, match := range markdownInline.FindAllStringSubmatchIndex(line, -1) {
+		builder.WriteString(tview.Escape(line[last:match[0]]))
+		last = match[1]
+
+		group := func(index int) string {
+			return tview.Escape(line[match[2*index]:match[2*index+1]])
+		}
+
+		switch {
+		case match[2] >= 0:
+			builder.WriteString("[::b]" + group(1) + "[::-]")
+		case match[4] >= 0:
+			builder.WriteString("[green]" + group(2) + "[-]")
+		case match[6] >= 0:
+			builder.WriteString("[::u]" + group(3) + "[::-] [blue](" + group(4) + ")[-]")
+		case match[10] >= 0:
+			builder.WriteString("[::i]" + group(5) + "[::-]")
+		case match[12] >= 0:
+			builder.WriteString("[::i]" + group(6) + "[::-]")
+		}
+	}
+
+	builder.WriteString(tview.Escape(line[last:]))
+
+	return builder.String()
+}
@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{
+			name: "heading",
+			text: "# Заголовок",
+			want: "[yellow::b]Заголовок[-::-]",
+		},
+		{
+			name: "bullet with bold",
+			text: "- **важно**",
+			want: "  • [::b]важно[::-]",
+		},
+		{
+			name: "inline code and link",
+			text: "см. `make test` и [доку](https://example.com)",
+			want: "см. [green]make test[-] и [::u]доку[::-] [blue](https://example.com)[-]",
+		},
+		{
+			name: "code block",
+			text: "```\n[red]x\n```",
+			want: "[green][red[]x[-]",
+		},
+		{
+			name: "quote",
+			text: "> _цитата_",
+			want: "[gray]│ [::i]цитата[::-][-]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, renderMarkdown(tt.text))
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package tui
+
+import (
+	"slices"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/event"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/router"
+	"github.com/rivo/tview"
+)
+
+// SetProfiles - задать профили для выбора на странице авторизации и логин текущего профиля
+func (tuiService *TUIService) SetProfiles(profiles []string, current string, login string) {
+	tuiService.profiles = profiles
+	tuiService.profile = current
+	tuiService.login = login
+
+	// Страница авторизации будет создана заново с новыми значениями
+	tuiService.pages.RemovePage(router.LoginPage)
+}
+
+// addProfileDropDown - добавить выбор профиля, если профилей несколько
+func (tuiService *TUIService) addProfileDropDown(form *tview.Form) {
+	if len(tuiService.profiles) < 2 {
+		return
+	}
+
+	current := slices.Index(tuiService.profiles, tuiService.profile)
+	form.AddDropDown(i18n.T("field.profile"), tuiService.profiles, current, func(option string, index int) {
+		if index < 0 || option == tuiService.profile {
+			return
+		}
+
+		tuiService.appLog.Debug("Select profile " + option)
+		tuiService.eventBus.Next(&event.Event{
+			Name: event.ClientEventSelectProfile,
+			Data: option,
+		})
+	})
+}
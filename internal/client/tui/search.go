@@ -0,0 +1,223 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/event"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/router"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/search"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// SearchPage - отобразить поиск по всем записям. Список обновляется при вводе запроса.
+func (tuiService *TUIService) SearchPage(dataList []models.DataInfo) {
+	index := search.NewIndex(dataList)
+	var results []search.Result
+
+	input := tview.NewInputField().SetLabel("/ ").SetFieldWidth(0)
+	list := tview.NewList().SetSecondaryTextColor(tcell.ColorGray)
+	list.SetBorder(true).SetTitle(i18n.T("search.title"))
+	tuiService.searchList = list
+
+	current := func() (models.DataInfo, bool) {
+		i := list.GetCurrentItem()
+		if i < 0 || i >= len(results) {
+			return models.DataInfo{}, false
+		}
+		return results[i].Data, true
+	}
+
+	draw := func(query string) {
+		results = index.Find(query)
+		list.Clear()
+		now := time.Now()
+		for _, result := range results {
+			secondary := records.Title(result.Data.Type)
+			if result.Login != "" {
+				secondary += " · " + tview.Escape(result.Login)
+			}
+			list.AddItem(dataListTitle(result.Data, now), secondary, 0, nil)
+		}
+		list.SetTitle(i18n.T("search.found", len(results)))
+	}
+
+	back := func() {
+		tuiService.searchList = nil
+		tuiService.pages.RemovePage(router.SearchPage)
+		tuiService.pages.SwitchToPage(router.DataPage)
+	}
+
+	input.SetChangedFunc(draw)
+	input.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEscape:
+			back()
+		case tcell.KeyEnter:
+			if data, ok := current(); ok {
+				back()
+				tuiService.openData(data, false)
+			}
+		default:
+			tuiService.application.SetFocus(list)
+		}
+	})
+	input.SetInputCapture(func(key *tcell.EventKey) *tcell.EventKey {
+		if key.Key() == tcell.KeyDown {
+			tuiService.application.SetFocus(list)
+			return nil
+		}
+		return key
+	})
+
+	list.SetSelectedFunc(func(_ int, _ string, _ string, _ rune) {
+		if data, ok := current(); ok {
+			back()
+			tuiService.openData(data, false)
+		}
+	})
+	list.SetDoneFunc(back)
+	list.SetInputCapture(func(key *tcell.EventKey) *tcell.EventKey {
+		key = vimNavigation(key)
+		if key.Key() == tcell.KeyDelete {
+			if data, ok := current(); ok {
+				tuiService.confirmDelete(data, back)
+			}
+			return nil
+		}
+		if key.Key() != tcell.KeyRune {
+			return key
+		}
+
+		switch key.Rune() {
+		case '/':
+			tuiService.application.SetFocus(input)
+			return nil
+		case '?':
+			tuiService.helpPage()
+			return nil
+		case 'q':
+			back()
+			return nil
+		}
+
+		data, ok := current()
+		if !ok {
+			return key
+		}
+		switch key.Rune() {
+		case 'u':
+			tuiService.copyField(data, "login")
+		case 'c':
+			tuiService.copyField(data, "")
+		case 'o':
+			tuiService.copyOTP(data)
+		case 'e':
+			back()
+			tuiService.openData(data, true)
+		case 'd':
+			tuiService.confirmDelete(data, back)
+		default:
+			return key
+		}
+
+		return nil
+	})
+
+	hint := tview.NewTextView().SetDynamicColors(true).
+		SetText("[gray]" + i18n.T("search.hint") + "[-]")
+
+	flex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(input, 1, 0, true).
+		AddItem(list, 0, 1, false).
+		AddItem(hint, 1, 0, false)
+
+	draw("")
+	tuiService.pages.AddAndSwitchToPage(router.SearchPage, flex, true)
+	tuiService.application.SetFocus(input)
+
+	if tuiService.running {
+		tuiService.application.Draw()
+	}
+}
+
+// dataPageShortcut - горячие клавиши страницы данных вне формы записи: поиск, справка
+// и навигация h, j, k, l, g, G
+func (tuiService *TUIService) dataPageShortcut(key *tcell.EventKey) *tcell.EventKey {
+	if key.Key() == tcell.KeyRune && key.Modifiers()&(tcell.ModCtrl|tcell.ModAlt) == 0 {
+		switch key.Rune() {
+		case '/':
+			tuiService.eventBus.Next(&event.Event{
+				Name: event.ClientEventOpenSearch,
+			})
+			return nil
+		case '?':
+			tuiService.helpPage()
+			return nil
+		case 'h':
+			return tcell.NewEventKey(tcell.KeyLeft, 0, tcell.ModNone)
+		case 'l':
+			return tcell.NewEventKey(tcell.KeyRight, 0, tcell.ModNone)
+		}
+	}
+
+	return vimNavigation(key)
+}
+
+// openData - показать запись на странице данных: выбрать ее тип и строку.
+// Если edit, фокус переходит в форму записи, иначе остается на списке
+func (tuiService *TUIService) openData(data models.DataInfo, edit bool) {
+	tuiService.focusID = data.ID
+	tuiService.editID = 0
+	if edit {
+		tuiService.editID = data.ID
+	}
+	tuiService.eventBus.Next(&event.Event{
+		Name: event.ClientEventSelectDataType,
+		Data: data.Type,
+	})
+}
+
+// confirmDelete - подтвердить удаление одной записи, done вызывается после ответа
+func (tuiService *TUIService) confirmDelete(data models.DataInfo, done func()) {
+	modal := tview.NewModal().
+		SetText(i18n.T("data.delete_one", data.Description)).
+		AddButtons([]string{i18n.T("button.delete"), i18n.T("button.cancel")}).
+		SetDoneFunc(func(buttonIndex int, _ string) {
+			tuiService.pages.RemovePage(router.ConfirmPage)
+			if buttonIndex != 0 {
+				return
+			}
+
+			done()
+			tuiService.eventBus.Next(&event.Event{
+				Name: event.ClientEventDeleteData,
+				Data: data,
+			})
+		})
+
+	tuiService.pages.AddPage(router.ConfirmPage, modal, true, true)
+}
+
+// vimNavigation - перевести клавиши j, k, g, G в стрелки, Home и End
+func vimNavigation(key *tcell.EventKey) *tcell.EventKey {
+	if key.Key() != tcell.KeyRune || key.Modifiers()&(tcell.ModCtrl|tcell.ModAlt) != 0 {
+		return key
+	}
+
+	switch key.Rune() {
+	case 'j':
+		return tcell.NewEventKey(tcell.KeyDown, 0, tcell.ModNone)
+	case 'k':
+		return tcell.NewEventKey(tcell.KeyUp, 0, tcell.ModNone)
+	case 'g':
+		return tcell.NewEventKey(tcell.KeyHome, 0, tcell.ModNone)
+	case 'G':
+		return tcell.NewEventKey(tcell.KeyEnd, 0, tcell.ModNone)
+	}
+
+	return key
+}
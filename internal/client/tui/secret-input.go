@@ -0,0 +1,121 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// RevealKey - горячая клавиша, показывающая и скрывающая секрет в поле под курсором
+const RevealKey = tcell.KeyCtrlR
+
+// secretInput - поле секрета. Значение скрыто, пока пользователь не покажет его клавишей RevealKey,
+// и снова скрывается через revealTimeout. У номеров в скрытом виде видны последние 4 символа,
+// такое поле можно изменить только после показа.
+type secretInput struct {
+	*tview.InputField
+	tuiService *TUIService
+	value      string
+	partial    bool
+	revealed   bool
+	timer      *time.Timer
+	changed    func(text string)
+}
+
+// SetRevealTimeout - через сколько показанный секрет скрывается снова, 0 - не скрывать
+func (tuiService *TUIService) SetRevealTimeout(timeout time.Duration) {
+	tuiService.revealTimeout = timeout
+}
+
+// newSecretInput - создать поле секрета. partial - показывать в скрытом виде последние 4 символа.
+func (tuiService *TUIService) newSecretInput(label string, value string, partial bool, changed func(text string)) *secretInput {
+	input := &secretInput{
+		InputField: tview.NewInputField().SetLabel(label).SetFieldWidth(50),
+		tuiService: tuiService,
+		value:      value,
+		partial:    partial,
+		changed:    changed,
+	}
+	// Текст поля до первой отрисовки задается один раз: tview заменяет текст только в отрисованном поле
+	if partial {
+		input.InputField.SetText(records.MaskNumber(value))
+	} else {
+		input.InputField.SetText(value).SetMaskCharacter('*')
+	}
+	input.InputField.SetChangedFunc(func(text string) {
+		// В скрытом поле с частичной маской отображается маска, а не значение
+		if input.partial && !input.revealed {
+			return
+		}
+		input.value = text
+		input.changed(text)
+	})
+	input.InputField.SetInputCapture(input.inputCapture)
+
+	return input
+}
+
+// inputCapture - переключить видимость секрета; скрытое поле с частичной маской доступно только для перехода
+func (input *secretInput) inputCapture(key *tcell.EventKey) *tcell.EventKey {
+	if key.Key() == RevealKey {
+		if input.revealed {
+			input.hide()
+		} else {
+			input.reveal()
+		}
+		return nil
+	}
+
+	if input.partial && !input.revealed {
+		switch key.Key() {
+		case tcell.KeyTab, tcell.KeyBacktab, tcell.KeyEnter, tcell.KeyEscape, tcell.KeyUp, tcell.KeyDown:
+			return key
+		}
+		return nil
+	}
+
+	return key
+}
+
+// reveal - показать секрет
+func (input *secretInput) reveal() {
+	input.revealed = true
+	input.SetMaskCharacter(0)
+	input.InputField.SetText(input.value)
+
+	if input.tuiService.revealTimeout > 0 {
+		input.timer = time.AfterFunc(input.tuiService.revealTimeout, func() {
+			input.tuiService.application.QueueUpdateDraw(input.hide)
+		})
+	}
+}
+
+// hide - скрыть секрет
+func (input *secretInput) hide() {
+	if input.timer != nil {
+		input.timer.Stop()
+		input.timer = nil
+	}
+	input.revealed = false
+
+	if input.partial {
+		input.SetMaskCharacter(0)
+		input.InputField.SetText(records.MaskNumber(input.value))
+		return
+	}
+	input.SetMaskCharacter('*')
+}
+
+// SetValue - заменить значение, например сгенерированным паролем
+func (input *secretInput) SetValue(text string) {
+	if input.partial && !input.revealed {
+		input.value = text
+		input.InputField.SetText(records.MaskNumber(text))
+		input.changed(text)
+		return
+	}
+
+	input.InputField.SetText(text)
+}
@@ -0,0 +1,57 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// drawInput - отрисовать поле: tview заменяет текст только в отрисованном поле
+func drawInput(t *testing.T, input *secretInput) {
+	t.Helper()
+
+	screen := tcell.NewSimulationScreen("UTF-8")
+	require.NoError(t, screen.Init())
+	screen.SetSize(80, 1)
+	input.SetRect(0, 0, 80, 1)
+	input.Draw(screen)
+}
+
+func TestSecretInput(t *testing.T) {
+	tuiService := &TUIService{}
+	value := ""
+	input := tuiService.newSecretInput("Номер карты", "4111 1111 1111 1234", true, func(text string) {
+		value = text
+	})
+	drawInput(t, input)
+
+	// В скрытом виде видны последние 4 символа, изменить номер нельзя
+	assert.Equal(t, "•••• 1234", input.GetText())
+	assert.Nil(t, input.inputCapture(tcell.NewEventKey(tcell.KeyRune, '5', tcell.ModNone)))
+	assert.NotNil(t, input.inputCapture(tcell.NewEventKey(tcell.KeyTab, 0, tcell.ModNone)))
+	assert.Empty(t, value)
+
+	assert.Nil(t, input.inputCapture(tcell.NewEventKey(RevealKey, 0, tcell.ModCtrl)))
+	assert.Equal(t, "4111 1111 1111 1234", input.GetText())
+	input.SetText("4111 1111 1111 9876")
+	assert.Equal(t, "4111 1111 1111 9876", value)
+
+	input.inputCapture(tcell.NewEventKey(RevealKey, 0, tcell.ModCtrl))
+	assert.Equal(t, "•••• 9876", input.GetText())
+
+	input.SetValue("5555 4444")
+	assert.Equal(t, "5555 4444", value)
+	assert.Equal(t, "•••• 4444", input.GetText())
+
+	// Пароль в скрытом виде можно изменить, текст поля - само значение
+	password := tuiService.newSecretInput("Пароль", "secret", false, func(text string) {
+		value = text
+	})
+	drawInput(t, password)
+	assert.Equal(t, "secret", password.GetText())
+	assert.False(t, password.revealed)
+	password.SetValue("generated")
+	assert.Equal(t, "generated", value)
+}
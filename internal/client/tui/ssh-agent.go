@@ -0,0 +1,46 @@
+package tui
+
+import (
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/router"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/sshagent"
+	"github.com/rivo/tview"
+)
+
+// sshConfirmTimeout - сколько ждать ответа пользователя на запрос подписи
+const sshConfirmTimeout = 30 * time.Second
+
+// ConfirmSSHSign - спросить пользователя, разрешить ли подпись SSH ключом.
+// Вызывается агентом из своей горутины, без ответа за sshConfirmTimeout подпись отклоняется.
+func (tuiService *TUIService) ConfirmSSHSign(key sshagent.Key) bool {
+	if !tuiService.running {
+		return false
+	}
+
+	answer := make(chan bool, 1)
+	tuiService.application.QueueUpdateDraw(func() {
+		modal := tview.NewModal().
+			SetText(i18n.T("ssh.confirm_sign", key.Comment, key.Fingerprint)).
+			AddButtons([]string{i18n.T("button.allow"), i18n.T("button.deny")}).
+			SetDoneFunc(func(buttonIndex int, _ string) {
+				tuiService.pages.RemovePage(router.SSHConfirmPage)
+				answer <- buttonIndex == 0
+			})
+
+		// Окно показывается поверх текущей страницы, после ответа она остается на месте
+		tuiService.pages.AddPage(router.SSHConfirmPage, modal, true, true)
+		tuiService.application.SetFocus(modal)
+	})
+
+	select {
+	case allowed := <-answer:
+		return allowed
+	case <-time.After(sshConfirmTimeout):
+		tuiService.application.QueueUpdateDraw(func() {
+			tuiService.pages.RemovePage(router.SSHConfirmPage)
+		})
+		return false
+	}
+}
@@ -1,11 +1,15 @@
 package tui
 
 import (
-	"encoding/base64"
-	"encoding/json"
 	"fmt"
+	"slices"
+	"time"
 
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/breach"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/clipboard"
 	"github.com/ShukinDmitriy/GophKeeper/internal/client/event"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/records"
 	"github.com/ShukinDmitriy/GophKeeper/internal/client/router"
 	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
 	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
@@ -24,6 +28,26 @@ type TUIService struct {
 	eventBus    *event.Observable
 	pages       *tview.Pages
 	running     bool
+	listData    []models.DataInfo
+	selected    map[uint]bool
+	usageView   *tview.TextView
+	searchList  *tview.List
+	focusID     uint
+	editID      uint
+	pickerDir   string
+	maxItemSize int64
+	profiles    []string
+	profile     string
+	login       string
+
+	clipboard        clipboard.ClipboardInterface
+	clipboardTimeout time.Duration
+	copiedSum        string
+	revealTimeout    time.Duration
+
+	breachList breach.ListInterface
+
+	lock lockState
 }
 
 // NewTUIService конструктор для TUIService
@@ -35,7 +59,7 @@ func NewTUIService(
 	pages := tview.NewPages()
 
 	pages.SetBorder(true).
-		SetTitle(router.ApplicationName).
+		SetTitle(clientTitle()).
 		SetTitleAlign(tview.AlignLeft)
 
 	application := tview.NewApplication().
@@ -44,12 +68,20 @@ func NewTUIService(
 		SetFocus(pages).
 		EnableMouse(true)
 
-	return &TUIService{
+	tuiService := &TUIService{
 		application: application,
 		appLog:      appLog,
 		eventBus:    eventBus,
 		pages:       pages,
 	}
+	application.
+		SetInputCapture(tuiService.lockInputCapture).
+		SetMouseCapture(func(event *tcell.EventMouse, action tview.MouseAction) (*tcell.EventMouse, tview.MouseAction) {
+			tuiService.touch()
+			return event, action
+		})
+
+	return tuiService
 }
 
 // errorPage - отобразить страницу ошибки
@@ -57,8 +89,8 @@ func (tuiService *TUIService) errorPage(err string, returnToPage string) {
 	tuiService.appLog.Debug("Create error page")
 
 	form := tview.NewForm().
-		AddTextView("Ошибка", err, 50, 5, true, true).
-		AddButton("Понятно", func() {
+		AddTextView(i18n.T("error.title"), err, 50, 5, true, true).
+		AddButton(i18n.T("button.ok"), func() {
 			tuiService.pages.SwitchToPage(returnToPage)
 		})
 
@@ -71,47 +103,19 @@ func (tuiService *TUIService) errorPage(err string, returnToPage string) {
 
 // dataToBase64 - преобразовать данные в base64
 func (tuiService *TUIService) dataToBase64(data interface{}) string {
-	jsonData, err := json.Marshal(data)
+	base64Data, err := models.EncodeValue(data)
 	if err != nil {
 		tuiService.appLog.Error(fmt.Sprintf("error data to json: %v", err))
 		return ""
 	}
 
-	return base64.StdEncoding.EncodeToString(jsonData)
+	return base64Data
 }
 
 // drawDataTypes - отрисовать типы данных
 func (tuiService *TUIService) drawDataTypes() {
-	type dataTypeStruct struct {
-		dataType models.DataType
-		title    string
-		shortcut rune
-	}
-	dataTypes := []dataTypeStruct{
-		{
-			dataType: models.DataTypeCredentials,
-			title:    "Учетные данные",
-			shortcut: 0,
-		},
-		{
-			dataType: models.DataTypeText,
-			title:    "Текстовые данные",
-			shortcut: 0,
-		},
-		{
-			dataType: models.DataTypeBinary,
-			title:    "Бинарные данные",
-			shortcut: 0,
-		},
-		{
-			dataType: models.DataTypeBankCard,
-			title:    "Данные банковских карт",
-			shortcut: 0,
-		},
-	}
-
-	for _, dataType := range dataTypes {
-		tuiService.dataTypes.AddItem(dataType.title, fmt.Sprintf("%v", dataType.dataType), dataType.shortcut, func() {
+	for _, typeInfo := range records.Types {
+		tuiService.dataTypes.AddItem(records.Title(typeInfo.Type), fmt.Sprintf("%v", typeInfo.Type), 0, func() {
 			tuiService.application.SetFocus(tuiService.dataList)
 		})
 	}
@@ -119,28 +123,19 @@ func (tuiService *TUIService) drawDataTypes() {
 	tuiService.dataTypes.SetChangedFunc(func(index int, mainText string, secondaryText string, shortcut rune) {
 		tuiService.eventBus.Next(&event.Event{
 			Name: event.ClientEventSelectDataType,
-			Data: dataTypes[index].dataType,
+			Data: records.Types[index].Type,
 		})
 	})
 
 	tuiService.eventBus.Next(&event.Event{
 		Name: event.ClientEventSelectDataType,
-		Data: dataTypes[0].dataType,
+		Data: records.Types[0].Type,
 	})
 }
 
-// drawDataRowCredentials - отрисовать форму просмотра "Учетные данные"
-func (tuiService *TUIService) drawDataRowCredentials(data models.DataInfo) {
-	decodedValue, err := base64.StdEncoding.DecodeString(data.Value)
-	if err != nil {
-		tuiService.appLog.Error(fmt.Sprintf("error decode value: %v", err))
-		return
-	}
-	value := &struct {
-		Login    string
-		Password string
-	}{}
-	err = json.Unmarshal(decodedValue, value)
+// drawDataRowForm - отрисовать форму просмотра записи, drawValue добавляет поля значения
+func (tuiService *TUIService) drawDataRowForm(data models.DataInfo, value interface{}, drawValue func(form *tview.Form)) {
+	err := models.DecodeValue(data.Value, value)
 	if err != nil {
 		tuiService.appLog.Error(fmt.Sprintf("error decode value: %v", err))
 		return
@@ -148,214 +143,235 @@ func (tuiService *TUIService) drawDataRowCredentials(data models.DataInfo) {
 
 	tuiService.dataForm.Clear(true)
 
-	tuiService.dataForm.SetTitle("Подробно")
+	tuiService.dataForm.SetTitle(i18n.T("data.title_secret"))
 
 	tuiService.dataForm.
-		AddTextView("Идентификатор", fmt.Sprintf("%d", data.ID), 50, 1, true, true).
-		AddTextView("Тип", "Учетные данные", 50, 1, true, true).
-		AddInputField("Описание", data.Description, 50, nil, func(text string) {
+		AddTextView(i18n.T("field.id"), fmt.Sprintf("%d", data.ID), 50, 1, true, true).
+		AddTextView(i18n.T("field.type"), records.Title(data.Type), 50, 1, true, true).
+		AddInputField(i18n.T("field.description"), data.Description, 50, nil, func(text string) {
 			data.Description = text
-		}).
-		AddInputField("Логин", value.Login, 50, nil, func(text string) {
-			value.Login = text
-		}).
-		AddInputField("Пароль", value.Password, 50, nil, func(text string) {
-			value.Password = text
-		}).
-		AddButton("Изменить", func() {
-			base64Data := tuiService.dataToBase64(value)
-			if base64Data == "" {
-				return
-			}
-			data.Value = base64Data
+		})
 
-			tuiService.eventBus.Next(&event.Event{
-				Name: event.ClientEventUpdateData,
-				Data: data,
-			})
+	drawValue(tuiService.dataForm)
+
+	tuiService.dataForm.
+		AddButton(i18n.T("button.update"), func() {
+			tuiService.updateData(data, value)
 		}).
-		AddButton("Удалить", func() {
+		AddButton(i18n.T("button.delete"), func() {
 			tuiService.eventBus.Next(&event.Event{
 				Name: event.ClientEventDeleteData,
 				Data: data,
 			})
 		})
 
+	tuiService.drawDataMeta(&data, value)
+
 	if tuiService.running {
 		tuiService.application.Draw()
 	}
 }
 
+// drawDataRowCredentials - отрисовать форму просмотра "Учетные данные"
+func (tuiService *TUIService) drawDataRowCredentials(data models.DataInfo) {
+	value := &models.CredentialsValue{}
+	tuiService.drawDataRowForm(data, value, func(form *tview.Form) {
+		form.
+			AddInputField(i18n.T("field.login"), value.Login, 50, nil, func(text string) {
+				value.Login = text
+			}).
+			AddFormItem(tuiService.newSecretInput(i18n.T("field.password"), value.Password, false, func(text string) {
+				value.Password = text
+			}))
+		tuiService.addBreachWarning(form, value.Password)
+		tuiService.addGenerateButton(form, i18n.T("field.password"))
+	})
+}
+
 // drawDataRowText - отрисовать форму просмотра "Текстовые данные"
 func (tuiService *TUIService) drawDataRowText(data models.DataInfo) {
-	decodedValue, err := base64.StdEncoding.DecodeString(data.Value)
-	if err != nil {
-		tuiService.appLog.Error(fmt.Sprintf("error decode value: %v", err))
-		return
-	}
-	value := &struct {
-		Text string
-	}{}
-	err = json.Unmarshal(decodedValue, value)
-	if err != nil {
-		tuiService.appLog.Error(fmt.Sprintf("error decode value: %v", err))
-		return
-	}
-
-	tuiService.dataForm.Clear(true)
-
-	tuiService.dataForm.SetTitle("Подробно")
-
-	tuiService.dataForm.
-		AddTextView("Идентификатор", fmt.Sprintf("%d", data.ID), 50, 1, true, true).
-		AddTextView("Тип", "Текстовые данные", 50, 1, true, true).
-		AddInputField("Описание", data.Description, 50, nil, func(text string) {
-			data.Description = text
-		}).
-		AddInputField("Текст", value.Text, 50, nil, func(text string) {
+	value := &models.TextValue{}
+	tuiService.drawDataRowForm(data, value, func(form *tview.Form) {
+		form.AddInputField(i18n.T("field.text"), value.Text, 50, nil, func(text string) {
 			value.Text = text
-		}).
-		AddButton("Изменить", func() {
-			base64Data := tuiService.dataToBase64(value)
-			if base64Data == "" {
-				return
-			}
-			data.Value = base64Data
-
-			tuiService.eventBus.Next(&event.Event{
-				Name: event.ClientEventUpdateData,
-				Data: data,
-			})
-		}).
-		AddButton("Удалить", func() {
-			tuiService.eventBus.Next(&event.Event{
-				Name: event.ClientEventDeleteData,
-				Data: data,
-			})
 		})
-
-	if tuiService.running {
-		tuiService.application.Draw()
-	}
+	})
 }
 
 // drawDataRowBinary - отрисовать форму просмотра "Бинарные данные"
 func (tuiService *TUIService) drawDataRowBinary(data models.DataInfo) {
-	decodedValue, err := base64.StdEncoding.DecodeString(data.Value)
-	if err != nil {
-		tuiService.appLog.Error(fmt.Sprintf("error decode value: %v", err))
-		return
-	}
-	value := &struct {
-		Binary string
-	}{}
-	err = json.Unmarshal(decodedValue, value)
-	if err != nil {
-		tuiService.appLog.Error(fmt.Sprintf("error decode value: %v", err))
-		return
-	}
-
-	tuiService.dataForm.Clear(true)
-
-	tuiService.dataForm.SetTitle("Подробно")
-
-	tuiService.dataForm.
-		AddTextView("Идентификатор", fmt.Sprintf("%d", data.ID), 50, 1, true, true).
-		AddTextView("Тип", "Текстовые данные", 50, 1, true, true).
-		AddInputField("Описание", data.Description, 50, nil, func(text string) {
-			data.Description = text
-		}).
-		AddInputField("Данные", value.Binary, 50, nil, func(text string) {
-			value.Binary = text
-		}).
-		AddButton("Изменить", func() {
-			base64Data := tuiService.dataToBase64(value)
-			if base64Data == "" {
+	value := &models.BinaryValue{}
+	tuiService.drawDataRowForm(data, value, func(form *tview.Form) {
+		info := tview.NewTextView().SetLabel(i18n.T("field.file")).SetSize(1, 60)
+		preview := tview.NewTextView().SetLabel(i18n.T("field.preview")).SetSize(8, 60).SetScrollable(true)
+		show := func() {
+			content, err := value.Content()
+			if err != nil {
+				tuiService.appLog.Error(fmt.Sprintf("error decode binary: %v", err))
+				info.SetText(i18n.T("binary.unreadable"))
+				preview.SetText("")
 				return
 			}
-			data.Value = base64Data
-
-			tuiService.eventBus.Next(&event.Event{
-				Name: event.ClientEventUpdateData,
-				Data: data,
+			info.SetText(binaryInfo(*value, len(content)))
+			preview.SetText(binaryPreview(content))
+		}
+		show()
+
+		form.AddFormItem(info)
+		// Текст без кодировки можно править как раньше, содержимое файла заменяется только файлом
+		var input *tview.InputField
+		if value.Encoding == "" {
+			input = tview.NewInputField().SetLabel(i18n.T("field.data")).SetText(value.Binary).SetFieldWidth(50)
+			input.SetChangedFunc(func(text string) {
+				value.Binary = text
+				show()
 			})
-		}).
-		AddButton("Удалить", func() {
-			tuiService.eventBus.Next(&event.Event{
-				Name: event.ClientEventDeleteData,
-				Data: data,
+			form.AddFormItem(input)
+		}
+		form.
+			AddFormItem(preview).
+			AddButton(i18n.T("button.save_to"), func() {
+				content, err := value.Content()
+				if err != nil {
+					tuiService.DataError(err.Error())
+					return
+				}
+				tuiService.saveBinaryFile(content, value.FileName)
+			}).
+			AddButton(i18n.T("button.load_file"), func() {
+				tuiService.pickBinaryFile(func(file models.BinaryValue) {
+					if input != nil {
+						input.SetChangedFunc(nil).SetDisabled(true)
+					}
+					*value = file
+					show()
+				})
 			})
-		})
-
-	if tuiService.running {
-		tuiService.application.Draw()
-	}
+	})
 }
 
 // drawDataRowBank - отрисовать форму просмотра "Банковские данные"
 func (tuiService *TUIService) drawDataRowBank(data models.DataInfo) {
-	decodedValue, err := base64.StdEncoding.DecodeString(data.Value)
-	if err != nil {
-		tuiService.appLog.Error(fmt.Sprintf("error decode value: %v", err))
-		return
-	}
-	value := &struct {
-		Number string
-		Date   string
-		Secure string
-	}{}
-	err = json.Unmarshal(decodedValue, value)
-	if err != nil {
-		tuiService.appLog.Error(fmt.Sprintf("error decode value: %v", err))
-		return
-	}
-
-	tuiService.dataForm.Clear(true)
+	value := &models.BankCardValue{}
+	tuiService.drawDataRowForm(data, value, func(form *tview.Form) {
+		form.
+			AddFormItem(tuiService.newSecretInput(i18n.T("field.card_number"), value.Number, true, func(text string) {
+				value.Number = text
+			})).
+			AddInputField(i18n.T("field.card_date"), value.Date, 50, nil, func(text string) {
+				value.Date = text
+			}).
+			AddFormItem(tuiService.newSecretInput(i18n.T("field.card_secure"), value.Secure, false, func(text string) {
+				value.Secure = text
+			}))
+	})
+}
 
-	tuiService.dataForm.SetTitle("Подробно")
+// drawDataRowSSHKey - отрисовать форму просмотра "SSH ключи"
+func (tuiService *TUIService) drawDataRowSSHKey(data models.DataInfo) {
+	value := &models.SSHKeyValue{}
+	tuiService.drawDataRowForm(data, value, func(form *tview.Form) {
+		fingerprint, err := records.SSHFingerprint(*value)
+		if err != nil {
+			tuiService.appLog.Debug(fmt.Sprintf("can't get ssh fingerprint: %v", err))
+			fingerprint = i18n.T("ssh.fingerprint_failed")
+		}
 
-	tuiService.dataForm.
-		AddTextView("Идентификатор", fmt.Sprintf("%d", data.ID), 50, 1, true, true).
-		AddTextView("Тип", "Текстовые данные", 50, 1, true, true).
-		AddInputField("Описание", data.Description, 50, nil, func(text string) {
-			data.Description = text
-		}).
-		AddInputField("Номер карты", value.Number, 50, nil, func(text string) {
-			value.Number = text
-		}).
-		AddInputField("Срок действия", value.Date, 50, nil, func(text string) {
-			value.Date = text
-		}).
-		AddInputField("Секретный код", value.Secure, 50, nil, func(text string) {
-			value.Secure = text
-		}).
-		AddButton("Изменить", func() {
-			base64Data := tuiService.dataToBase64(value)
-			if base64Data == "" {
-				return
-			}
-			data.Value = base64Data
+		form.
+			AddTextView(i18n.T("field.fingerprint"), fingerprint, 60, 1, false, false).
+			AddTextArea(i18n.T("field.private_key"), value.PrivateKey, 60, 5, 0, func(text string) {
+				value.PrivateKey = text
+			}).
+			AddTextArea(i18n.T("field.public_key"), value.PublicKey, 60, 2, 0, func(text string) {
+				value.PublicKey = text
+			}).
+			AddFormItem(tuiService.newSecretInput(i18n.T("field.passphrase"), value.Passphrase, false, func(text string) {
+				value.Passphrase = text
+			}))
+	})
+}
 
-			tuiService.eventBus.Next(&event.Event{
-				Name: event.ClientEventUpdateData,
-				Data: data,
+// drawDataRowIdentity - отрисовать форму просмотра "Документы"
+func (tuiService *TUIService) drawDataRowIdentity(data models.DataInfo) {
+	value := &models.IdentityValue{}
+	tuiService.drawDataRowForm(data, value, func(form *tview.Form) {
+		form.
+			AddInputField(i18n.T("field.document_kind"), value.Kind, 50, nil, func(text string) {
+				value.Kind = text
+			}).
+			AddFormItem(tuiService.newSecretInput(i18n.T("field.number"), value.Number, true, func(text string) {
+				value.Number = text
+			})).
+			AddInputField(i18n.T("field.full_name"), value.FullName, 50, nil, func(text string) {
+				value.FullName = text
+			}).
+			AddInputField(i18n.T("field.issued_by"), value.IssuedBy, 50, nil, func(text string) {
+				value.IssuedBy = text
+			}).
+			AddInputField(i18n.T("field.issue_date"), value.IssueDate, 50, nil, func(text string) {
+				value.IssueDate = text
+			}).
+			AddInputField(i18n.T("field.valid_until"), value.ExpiryDate, 50, nil, func(text string) {
+				value.ExpiryDate = text
 			})
-		}).
-		AddButton("Удалить", func() {
-			tuiService.eventBus.Next(&event.Event{
-				Name: event.ClientEventDeleteData,
-				Data: data,
+	})
+}
+
+// drawDataRowNote - отрисовать форму просмотра "Заметки"
+func (tuiService *TUIService) drawDataRowNote(data models.DataInfo) {
+	value := &models.NoteValue{}
+	tuiService.drawDataRowForm(data, value, func(form *tview.Form) {
+		form.
+			AddTextView(i18n.T("field.preview"), renderMarkdown(value.Markdown), 60, 10, true, true).
+			AddTextArea("Markdown", value.Markdown, 60, 6, 0, func(text string) {
+				value.Markdown = text
 			})
-		})
+	})
+}
 
-	if tuiService.running {
-		tuiService.application.Draw()
-	}
+// drawDataRowWiFi - отрисовать форму просмотра "Wi-Fi"
+func (tuiService *TUIService) drawDataRowWiFi(data models.DataInfo) {
+	value := &models.WiFiValue{}
+	tuiService.drawDataRowForm(data, value, func(form *tview.Form) {
+		form.
+			AddInputField(i18n.T("field.ssid"), value.SSID, 50, nil, func(text string) {
+				value.SSID = text
+			}).
+			AddFormItem(tuiService.newSecretInput(i18n.T("field.password"), value.Password, false, func(text string) {
+				value.Password = text
+			})).
+			AddDropDown(i18n.T("field.security"), records.WiFiSecurityTypes, max(slices.Index(records.WiFiSecurityTypes, value.Security), 0), func(option string, _ int) {
+				value.Security = option
+			}).
+			AddTextView(i18n.T("field.wifi_uri"), tview.Escape(records.WiFiConnectionString(*value)), 60, 1, false, true)
+		tuiService.addGenerateButton(form, i18n.T("field.password"))
+	})
+}
+
+// drawDataRowAPIKey - отрисовать форму просмотра "API ключи"
+func (tuiService *TUIService) drawDataRowAPIKey(data models.DataInfo) {
+	value := &models.APIKeyValue{}
+	tuiService.drawDataRowForm(data, value, func(form *tview.Form) {
+		form.
+			AddInputField(i18n.T("field.provider"), value.Provider, 50, nil, func(text string) {
+				value.Provider = text
+			}).
+			AddInputField(i18n.T("field.key_id"), value.KeyID, 50, nil, func(text string) {
+				value.KeyID = text
+			}).
+			AddFormItem(tuiService.newSecretInput(i18n.T("field.secret"), value.Secret, true, func(text string) {
+				value.Secret = text
+			})).
+			AddInputField(i18n.T("field.endpoint"), value.Endpoint, 50, nil, func(text string) {
+				value.Endpoint = text
+			})
+	})
 }
 
 // Run - запустить консольное приложение
 func (tuiService *TUIService) Run() error {
 	tuiService.running = true
+	go tuiService.watchIdle()
 	err := tuiService.application.Run()
 	if err != nil {
 		return err
@@ -368,28 +384,30 @@ func (tuiService *TUIService) Run() error {
 func (tuiService *TUIService) LoginPage() {
 	if !tuiService.pages.HasPage(router.LoginPage) {
 		tuiService.appLog.Debug("Create login page")
-		data := commonRequests.UserLogin{}
-		form := tview.NewForm().
-			AddInputField("Логин", "", 20, nil, func(text string) {
+		data := commonRequests.UserLogin{Login: tuiService.login}
+		form := tview.NewForm()
+		tuiService.addProfileDropDown(form)
+		form.
+			AddInputField(i18n.T("field.login"), tuiService.login, 20, nil, func(text string) {
 				data.Login = text
 			}).
-			AddPasswordField("Пароль", "", 20, '*', func(text string) {
+			AddPasswordField(i18n.T("field.password"), "", 20, '*', func(text string) {
 				data.Password = text
 			}).
-			AddButton("Авторизоваться", func() {
+			AddButton(i18n.T("button.login"), func() {
 				tuiService.appLog.Debug("Press Login button")
 				tuiService.eventBus.Next(&event.Event{
 					Name: event.ClientEventPressLoginButton,
 					Data: data,
 				})
 			}).
-			AddButton("Перейти к регистрации", func() {
+			AddButton(i18n.T("button.to_register"), func() {
 				tuiService.appLog.Debug("Press Register button")
 				tuiService.eventBus.Next(&event.Event{
 					Name: event.ClientEventPressToRegisterButton,
 				})
 			}).
-			AddButton("Закончить", func() {
+			AddButton(i18n.T("button.quit"), func() {
 				tuiService.appLog.Debug("Press Stop button")
 				tuiService.application.Stop()
 			})
@@ -415,26 +433,26 @@ func (tuiService *TUIService) RegisterPage() {
 		tuiService.appLog.Debug("Create register page")
 		data := commonRequests.UserRegister{}
 		form := tview.NewForm().
-			AddInputField("Логин", "", 20, nil, func(text string) {
+			AddInputField(i18n.T("field.login"), "", 20, nil, func(text string) {
 				data.Login = text
 			}).
-			AddPasswordField("Пароль", "", 20, '*', func(text string) {
+			AddPasswordField(i18n.T("field.password"), "", 20, '*', func(text string) {
 				data.Password = text
 			}).
-			AddButton("Зарегистрироваться", func() {
+			AddButton(i18n.T("button.register"), func() {
 				tuiService.appLog.Debug("Press Register button")
 				tuiService.eventBus.Next(&event.Event{
 					Name: event.ClientEventPressRegisterButton,
 					Data: data,
 				})
 			}).
-			AddButton("Перейти к авторизации", func() {
+			AddButton(i18n.T("button.to_login"), func() {
 				tuiService.appLog.Debug("Press \"To login\" button")
 				tuiService.eventBus.Next(&event.Event{
 					Name: event.ClientEventPressToLoginButton,
 				})
 			}).
-			AddButton("Закончить", func() {
+			AddButton(i18n.T("button.quit"), func() {
 				tuiService.appLog.Debug("Press Stop button")
 				tuiService.Stop()
 			})
@@ -460,23 +478,34 @@ func (tuiService *TUIService) DataPage() {
 		tuiService.appLog.Debug("Create data page")
 
 		tuiService.dataTypes = tview.NewList().ShowSecondaryText(false)
-		tuiService.dataTypes.SetBorder(true).SetTitle("Типы данных")
+		tuiService.dataTypes.SetBorder(true).SetTitle(i18n.T("data.types"))
 
-		form := tview.NewForm().AddButton("Создать", func() {
-			tuiService.eventBus.Next(&event.Event{
-				Name: event.ClientEventPressToCreateFormButton,
+		form := tview.NewForm().
+			AddButton(i18n.T("button.create"), func() {
+				tuiService.eventBus.Next(&event.Event{
+					Name: event.ClientEventPressToCreateFormButton,
+				})
+			}).
+			AddButton(i18n.T("button.audit"), func() {
+				tuiService.eventBus.Next(&event.Event{
+					Name: event.ClientEventPressAuditButton,
+				})
 			})
-		})
+
+		tuiService.usageView = tview.NewTextView().SetDynamicColors(true)
+		tuiService.usageView.SetBorder(true).SetTitle(i18n.T("data.storage"))
 
 		mainMenu := tview.NewFlex().SetDirection(tview.FlexRow).
 			AddItem(tuiService.dataTypes, 0, 3, true).
-			AddItem(form, 0, 1, false)
+			AddItem(form, 0, 1, false).
+			AddItem(tuiService.usageView, 4, 0, false)
 
 		tuiService.dataList = tview.NewList().ShowSecondaryText(false)
-		tuiService.dataList.SetBorder(true).SetTitle("Записи")
+		tuiService.dataList.SetBorder(true).SetTitle(i18n.T("data.records"))
+		tuiService.dataList.SetInputCapture(tuiService.dataListInputCapture)
 
 		tuiService.dataForm = tview.NewForm()
-		tuiService.dataForm.SetBorder(true).SetTitle("Подробно")
+		tuiService.dataForm.SetBorder(true).SetTitle(i18n.T("data.title"))
 
 		flex := tview.NewFlex().
 			AddItem(mainMenu, 0, 1, true).
@@ -484,6 +513,13 @@ func (tuiService *TUIService) DataPage() {
 			AddItem(tuiService.dataForm, 0, 3, false)
 
 		flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+			if !tuiService.dataForm.HasFocus() {
+				event = tuiService.dataPageShortcut(event)
+				if event == nil {
+					return nil
+				}
+			}
+
 			switch event.Key() {
 			case tcell.KeyLeft:
 				if tuiService.dataList.HasFocus() {
@@ -520,6 +556,8 @@ func (tuiService *TUIService) DataPage() {
 	}
 
 	tuiService.pages.SwitchToPage(router.DataPage)
+	tuiService.touch()
+	tuiService.lock.unlocked.Store(true)
 
 	if tuiService.running {
 		tuiService.application.Draw()
@@ -528,25 +566,29 @@ func (tuiService *TUIService) DataPage() {
 
 // DrawDataList - отрисовать список записей по типу
 func (tuiService *TUIService) DrawDataList(dataType models.DataType, dataList []models.DataInfo) {
-	switch dataType {
-	case models.DataTypeCredentials:
-		tuiService.dataTypes.SetCurrentItem(0)
-	case models.DataTypeText:
-		tuiService.dataTypes.SetCurrentItem(1)
-	case models.DataTypeBinary:
-		tuiService.dataTypes.SetCurrentItem(2)
-	case models.DataTypeBankCard:
-		tuiService.dataTypes.SetCurrentItem(3)
+	if index := records.Index(dataType); index >= 0 {
+		tuiService.dataTypes.SetCurrentItem(index)
 	}
 	tuiService.dataList.Clear()
+	tuiService.resetSelection(dataList)
 	tuiService.dataForm.Clear(true)
-	tuiService.dataForm.SetTitle("Подробно")
+	tuiService.dataForm.SetTitle(i18n.T("data.title"))
 
-	for _, data := range dataList {
-		tuiService.dataList.AddItem(fmt.Sprintf("%d. %s", data.ID, data.Description), "", 0, func() {
+	// Запись, открытая из поиска, выбирается вместо первой
+	current := 0
+	now := time.Now()
+	for i, data := range dataList {
+		tuiService.dataList.AddItem(dataListTitle(data, now), "", 0, func() {
 			tuiService.application.SetFocus(tuiService.dataForm)
 		})
+		if tuiService.focusID != 0 && data.ID == tuiService.focusID {
+			current = i
+		}
 	}
+	focusList := tuiService.focusID != 0
+	tuiService.focusID = 0
+	tuiService.dataList.SetChangedFunc(nil)
+	tuiService.dataList.SetCurrentItem(current)
 
 	if len(dataList) > 0 {
 		tuiService.dataList.SetChangedFunc(func(index int, mainText string, secondaryText string, shortcut rune) {
@@ -564,16 +606,20 @@ func (tuiService *TUIService) DrawDataList(dataType models.DataType, dataList []
 	if len(dataList) > 0 {
 		tuiService.eventBus.Next(&event.Event{
 			Name: event.ClientEventSelectDataRow,
-			Data: dataList[0],
+			Data: dataList[current],
 		})
 	}
 
+	if focusList && len(dataList) > 0 {
+		tuiService.application.SetFocus(tuiService.dataList)
+		return
+	}
 	tuiService.application.SetFocus(tuiService.dataTypes)
 }
 
 // DrawDataRow - отрисовать конкретную запись
 func (tuiService *TUIService) DrawDataRow(data models.DataInfo) {
-	tuiService.dataForm.SetTitle("Подробно")
+	tuiService.dataForm.SetTitle(i18n.T("data.title"))
 
 	switch data.Type {
 	case models.DataTypeCredentials:
@@ -584,146 +630,80 @@ func (tuiService *TUIService) DrawDataRow(data models.DataInfo) {
 		tuiService.drawDataRowBinary(data)
 	case models.DataTypeBankCard:
 		tuiService.drawDataRowBank(data)
+	case models.DataTypeSSHKey:
+		tuiService.drawDataRowSSHKey(data)
+	case models.DataTypeIdentity:
+		tuiService.drawDataRowIdentity(data)
+	case models.DataTypeNote:
+		tuiService.drawDataRowNote(data)
+	case models.DataTypeWiFi:
+		tuiService.drawDataRowWiFi(data)
+	case models.DataTypeAPIKey:
+		tuiService.drawDataRowAPIKey(data)
 	}
 
-	if tuiService.running {
-		tuiService.application.Draw()
+	// Запись, открытая из поиска по e, сразу переводит фокус в форму
+	if tuiService.editID != 0 && data.ID == tuiService.editID {
+		tuiService.editID = 0
+		tuiService.application.SetFocus(tuiService.dataForm)
 	}
-}
-
-// DrawCreateForm - отрисовать форму создания записи
-func (tuiService *TUIService) DrawCreateForm() {
-	tuiService.dataForm.Clear(true)
-
-	tuiService.dataForm.SetTitle("Создание записи")
-	tuiService.application.SetFocus(tuiService.dataForm)
-
-	dropdown := tview.NewDropDown().
-		SetLabel("Выберите тип данных: ").
-		SetOptions([]string{"Учетные данные", "Текстовые данные", "Бинарные данные", "Данные банковских карт"}, func(text string, index int) {
-			tuiService.eventBus.Next(&event.Event{
-				Name: event.ClientEventSelectCreateDataType,
-				Data: text,
-			})
-		})
-	tuiService.dataForm.AddFormItem(dropdown)
-
-	tuiService.dataForm.SetFocus(0)
 
 	if tuiService.running {
 		tuiService.application.Draw()
 	}
 }
 
-// DrawCreateCredentialsForm - отрисовать форму создания учетных данных
-func (tuiService *TUIService) DrawCreateCredentialsForm() {
-	tuiService.dataForm.Clear(true)
-
-	tuiService.dataForm.SetTitle("Создание записи")
-	tuiService.application.SetFocus(tuiService.dataForm)
-
+// createDataTypeDropDown - выпадающий список выбора типа создаваемой записи
+func (tuiService *TUIService) createDataTypeDropDown(current models.DataType) *tview.DropDown {
 	dropdown := tview.NewDropDown().
-		SetLabel("Выберите тип данных: ").
-		SetOptions([]string{"Учетные данные", "Текстовые данные", "Бинарные данные", "Данные банковских карт"}, func(text string, index int) {
-			if text == "Учетные данные" {
-				return
-			}
+		SetLabel(i18n.T("create.type")).
+		SetOptions(records.Titles(), nil)
 
-			tuiService.eventBus.Next(&event.Event{
-				Name: event.ClientEventSelectCreateDataType,
-				Data: text,
-			})
-		}).
-		SetCurrentOption(0)
-
-	data := struct {
-		Login    string
-		Password string
-	}{}
-	var description string
-	descriptionInput := tview.NewInputField().
-		SetLabel("Описание").
-		SetChangedFunc(func(text string) {
-			description = text
-		})
-	loginInput := tview.NewInputField().
-		SetLabel("Логин").
-		SetChangedFunc(func(text string) {
-			data.Login = text
-		})
-	passwordInput := tview.NewInputField().
-		SetLabel("Пароль").
-		SetChangedFunc(func(text string) {
-			data.Password = text
-		})
+	if index := records.Index(current); index >= 0 {
+		dropdown.SetCurrentOption(index)
+	}
 
-	tuiService.dataForm.AddFormItem(dropdown)
-	tuiService.dataForm.AddFormItem(descriptionInput)
-	tuiService.dataForm.AddFormItem(loginInput)
-	tuiService.dataForm.AddFormItem(passwordInput)
-	tuiService.dataForm.AddButton("Сохранить", func() {
-		base64Data := tuiService.dataToBase64(data)
-		if base64Data == "" {
+	dropdown.SetSelectedFunc(func(text string, index int) {
+		if records.Types[index].Type == current {
 			return
 		}
 
 		tuiService.eventBus.Next(&event.Event{
-			Name: event.ClientEventCreateData,
-			Data: commonRequests.DataModel{
-				Type:        models.DataTypeCredentials,
-				Description: description,
-				Value:       base64Data,
-			},
+			Name: event.ClientEventSelectCreateDataType,
+			Data: records.Types[index].Type,
 		})
 	})
-	tuiService.dataForm.SetFocus(0)
 
-	if tuiService.running {
-		tuiService.application.Draw()
-	}
+	return dropdown
 }
 
-// DrawCreateTextForm - отрисовать форму создания текстовых данных
-func (tuiService *TUIService) DrawCreateTextForm() {
+// drawCreateValueForm - отрисовать форму создания записи с полями значения
+func (tuiService *TUIService) drawCreateValueForm(dataType models.DataType, value interface{}, items ...tview.FormItem) {
 	tuiService.dataForm.Clear(true)
 
-	tuiService.dataForm.SetTitle("Создание записи")
+	tuiService.dataForm.SetTitle(i18n.T("create.title"))
 	tuiService.application.SetFocus(tuiService.dataForm)
 
-	dropdown := tview.NewDropDown().
-		SetLabel("Выберите тип данных: ").
-		SetOptions([]string{"Учетные данные", "Текстовые данные", "Бинарные данные", "Данные банковских карт"}, func(text string, index int) {
-			if text == "Текстовые данные" {
-				return
-			}
-
-			tuiService.eventBus.Next(&event.Event{
-				Name: event.ClientEventSelectCreateDataType,
-				Data: text,
-			})
-		}).
-		SetCurrentOption(1)
-
-	data := struct {
-		Text string
-	}{}
-	var description string
+	var (
+		description string
+		expiresAt   *time.Time
+		rotateAt    *time.Time
+	)
 	descriptionInput := tview.NewInputField().
-		SetLabel("Описание").
+		SetLabel(i18n.T("field.description")).
 		SetChangedFunc(func(text string) {
 			description = text
 		})
-	textInput := tview.NewInputField().
-		SetLabel("Текст").
-		SetChangedFunc(func(text string) {
-			data.Text = text
-		})
 
-	tuiService.dataForm.AddFormItem(dropdown)
+	tuiService.dataForm.AddFormItem(tuiService.createDataTypeDropDown(dataType))
 	tuiService.dataForm.AddFormItem(descriptionInput)
-	tuiService.dataForm.AddFormItem(textInput)
-	tuiService.dataForm.AddButton("Сохранить", func() {
-		base64Data := tuiService.dataToBase64(data)
+	for _, item := range items {
+		tuiService.dataForm.AddFormItem(item)
+	}
+	tuiService.dataForm.AddFormItem(newDateInputField(i18n.T("field.rotate_at"), &rotateAt))
+	tuiService.dataForm.AddFormItem(newDateInputField(i18n.T("field.expires_at"), &expiresAt))
+	tuiService.dataForm.AddButton(i18n.T("button.save"), func() {
+		base64Data := tuiService.dataToBase64(value)
 		if base64Data == "" {
 			return
 		}
@@ -731,9 +711,11 @@ func (tuiService *TUIService) DrawCreateTextForm() {
 		tuiService.eventBus.Next(&event.Event{
 			Name: event.ClientEventCreateData,
 			Data: commonRequests.DataModel{
-				Type:        models.DataTypeText,
+				Type:        dataType,
 				Description: description,
 				Value:       base64Data,
+				ExpiresAt:   expiresAt,
+				RotateAt:    rotateAt,
 			},
 		})
 	})
@@ -744,60 +726,15 @@ func (tuiService *TUIService) DrawCreateTextForm() {
 	}
 }
 
-// DrawCreateBinaryForm - отрисовать форму создания бинарных данных
-func (tuiService *TUIService) DrawCreateBinaryForm() {
+// DrawCreateForm - отрисовать форму создания записи
+func (tuiService *TUIService) DrawCreateForm() {
 	tuiService.dataForm.Clear(true)
 
-	tuiService.dataForm.SetTitle("Создание записи")
+	tuiService.dataForm.SetTitle(i18n.T("create.title"))
 	tuiService.application.SetFocus(tuiService.dataForm)
 
-	dropdown := tview.NewDropDown().
-		SetLabel("Выберите тип данных: ").
-		SetOptions([]string{"Учетные данные", "Текстовые данные", "Бинарные данные", "Данные банковских карт"}, func(text string, index int) {
-			if text == "Бинарные данные" {
-				return
-			}
-
-			tuiService.eventBus.Next(&event.Event{
-				Name: event.ClientEventSelectCreateDataType,
-				Data: text,
-			})
-		}).
-		SetCurrentOption(2)
-
-	data := struct {
-		Binary string
-	}{}
-	var description string
-	descriptionInput := tview.NewInputField().
-		SetLabel("Описание").
-		SetChangedFunc(func(text string) {
-			description = text
-		})
-	binaryInput := tview.NewInputField().
-		SetLabel("Данные").
-		SetChangedFunc(func(text string) {
-			data.Binary = text
-		})
-
-	tuiService.dataForm.AddFormItem(dropdown)
-	tuiService.dataForm.AddFormItem(descriptionInput)
-	tuiService.dataForm.AddFormItem(binaryInput)
-	tuiService.dataForm.AddButton("Сохранить", func() {
-		base64Data := tuiService.dataToBase64(data)
-		if base64Data == "" {
-			return
-		}
+	tuiService.dataForm.AddFormItem(tuiService.createDataTypeDropDown(models.DataTypeUnknown))
 
-		tuiService.eventBus.Next(&event.Event{
-			Name: event.ClientEventCreateData,
-			Data: commonRequests.DataModel{
-				Type:        models.DataTypeBinary,
-				Description: description,
-				Value:       base64Data,
-			},
-		})
-	})
 	tuiService.dataForm.SetFocus(0)
 
 	if tuiService.running {
@@ -805,79 +742,227 @@ func (tuiService *TUIService) DrawCreateBinaryForm() {
 	}
 }
 
-// DrawCreateBankForm - отрисовать форму создания данных банковских карт
-func (tuiService *TUIService) DrawCreateBankForm() {
-	tuiService.dataForm.Clear(true)
-
-	tuiService.dataForm.SetTitle("Создание записи")
-	tuiService.application.SetFocus(tuiService.dataForm)
+// DrawCreateCredentialsForm - отрисовать форму создания учетных данных
+func (tuiService *TUIService) DrawCreateCredentialsForm() {
+	data := &models.CredentialsValue{}
+	tuiService.drawCreateValueForm(
+		models.DataTypeCredentials,
+		data,
+		tview.NewInputField().
+			SetLabel(i18n.T("field.login")).
+			SetChangedFunc(func(text string) {
+				data.Login = text
+			}),
+		tview.NewInputField().
+			SetLabel(i18n.T("field.password")).
+			SetChangedFunc(func(text string) {
+				data.Password = text
+			}),
+	)
+	tuiService.addGenerateButton(tuiService.dataForm, i18n.T("field.password"))
+}
 
-	dropdown := tview.NewDropDown().
-		SetLabel("Выберите тип данных: ").
-		SetOptions([]string{"Учетные данные", "Текстовые данные", "Бинарные данные", "Данные банковских карт"}, func(text string, index int) {
-			if text == "Данные банковских карт" {
-				return
-			}
+// DrawCreateTextForm - отрисовать форму создания текстовых данных
+func (tuiService *TUIService) DrawCreateTextForm() {
+	data := &models.TextValue{}
+	tuiService.drawCreateValueForm(
+		models.DataTypeText,
+		data,
+		tview.NewInputField().
+			SetLabel(i18n.T("field.text")).
+			SetChangedFunc(func(text string) {
+				data.Text = text
+			}),
+	)
+}
 
-			tuiService.eventBus.Next(&event.Event{
-				Name: event.ClientEventSelectCreateDataType,
-				Data: text,
-			})
-		}).
-		SetCurrentOption(3)
-
-	data := struct {
-		Number string
-		Date   string
-		Secure string
-	}{}
-	var description string
-	descriptionInput := tview.NewInputField().
-		SetLabel("Описание").
-		SetChangedFunc(func(text string) {
-			description = text
-		})
-	numberInput := tview.NewInputField().
-		SetLabel("Номер карты").
-		SetChangedFunc(func(text string) {
-			data.Number = text
-		})
-	dateInput := tview.NewInputField().
-		SetLabel("Срок действия").
+// DrawCreateBinaryForm - отрисовать форму создания бинарных данных
+func (tuiService *TUIService) DrawCreateBinaryForm() {
+	data := &models.BinaryValue{}
+	info := tview.NewTextView().SetLabel(i18n.T("field.file")).SetText(i18n.T("binary.none")).SetSize(1, 60)
+	input := tview.NewInputField().
+		SetLabel(i18n.T("field.data")).
 		SetChangedFunc(func(text string) {
-			data.Date = text
+			data.Binary = text
 		})
-	secureInput := tview.NewInputField().
-		SetLabel("Секретный код").
-		SetChangedFunc(func(text string) {
-			data.Secure = text
+	tuiService.drawCreateValueForm(
+		models.DataTypeBinary,
+		data,
+		info,
+		input,
+	)
+	tuiService.dataForm.AddButton(i18n.T("button.choose_file"), func() {
+		tuiService.pickBinaryFile(func(file models.BinaryValue) {
+			content, _ := file.Content()
+			input.SetChangedFunc(nil).SetDisabled(true)
+			*data = file
+			info.SetText(binaryInfo(file, len(content)))
 		})
+	})
+}
 
-	tuiService.dataForm.AddFormItem(dropdown)
-	tuiService.dataForm.AddFormItem(descriptionInput)
-	tuiService.dataForm.AddFormItem(numberInput)
-	tuiService.dataForm.AddFormItem(dateInput)
-	tuiService.dataForm.AddFormItem(secureInput)
-	tuiService.dataForm.AddButton("Сохранить", func() {
-		base64Data := tuiService.dataToBase64(data)
-		if base64Data == "" {
-			return
-		}
+// DrawCreateBankForm - отрисовать форму создания данных банковских карт
+func (tuiService *TUIService) DrawCreateBankForm() {
+	data := &models.BankCardValue{}
+	tuiService.drawCreateValueForm(
+		models.DataTypeBankCard,
+		data,
+		tview.NewInputField().
+			SetLabel(i18n.T("field.card_number")).
+			SetChangedFunc(func(text string) {
+				data.Number = text
+			}),
+		tview.NewInputField().
+			SetLabel(i18n.T("field.card_date")).
+			SetChangedFunc(func(text string) {
+				data.Date = text
+			}),
+		tview.NewInputField().
+			SetLabel(i18n.T("field.card_secure")).
+			SetChangedFunc(func(text string) {
+				data.Secure = text
+			}),
+	)
+}
 
-		tuiService.eventBus.Next(&event.Event{
-			Name: event.ClientEventCreateData,
-			Data: commonRequests.DataModel{
-				Type:        models.DataTypeBankCard,
-				Description: description,
-				Value:       base64Data,
-			},
-		})
+// DrawCreateSSHKeyForm - отрисовать форму создания SSH ключа
+func (tuiService *TUIService) DrawCreateSSHKeyForm() {
+	data := &models.SSHKeyValue{}
+	privateKey := tview.NewTextArea().
+		SetLabel(i18n.T("field.private_key")).
+		SetSize(5, 60)
+	privateKey.SetChangedFunc(func() {
+		data.PrivateKey = privateKey.GetText()
+	})
+	publicKey := tview.NewTextArea().
+		SetLabel(i18n.T("field.public_key")).
+		SetSize(2, 60)
+	publicKey.SetChangedFunc(func() {
+		data.PublicKey = publicKey.GetText()
 	})
-	tuiService.dataForm.SetFocus(0)
 
-	if tuiService.running {
-		tuiService.application.Draw()
-	}
+	tuiService.drawCreateValueForm(
+		models.DataTypeSSHKey,
+		data,
+		privateKey,
+		publicKey,
+		tview.NewInputField().
+			SetLabel(i18n.T("field.passphrase")).
+			SetMaskCharacter('*').
+			SetChangedFunc(func(text string) {
+				data.Passphrase = text
+			}),
+	)
+}
+
+// DrawCreateIdentityForm - отрисовать форму создания документа
+func (tuiService *TUIService) DrawCreateIdentityForm() {
+	data := &models.IdentityValue{}
+	tuiService.drawCreateValueForm(
+		models.DataTypeIdentity,
+		data,
+		tview.NewInputField().
+			SetLabel(i18n.T("field.document_kind")).
+			SetChangedFunc(func(text string) {
+				data.Kind = text
+			}),
+		tview.NewInputField().
+			SetLabel(i18n.T("field.number")).
+			SetMaskCharacter('*').
+			SetChangedFunc(func(text string) {
+				data.Number = text
+			}),
+		tview.NewInputField().
+			SetLabel(i18n.T("field.full_name")).
+			SetChangedFunc(func(text string) {
+				data.FullName = text
+			}),
+		tview.NewInputField().
+			SetLabel(i18n.T("field.issued_by")).
+			SetChangedFunc(func(text string) {
+				data.IssuedBy = text
+			}),
+		tview.NewInputField().
+			SetLabel(i18n.T("field.issue_date")).
+			SetChangedFunc(func(text string) {
+				data.IssueDate = text
+			}),
+		tview.NewInputField().
+			SetLabel(i18n.T("field.valid_until")).
+			SetChangedFunc(func(text string) {
+				data.ExpiryDate = text
+			}),
+	)
+}
+
+// DrawCreateNoteForm - отрисовать форму создания заметки
+func (tuiService *TUIService) DrawCreateNoteForm() {
+	data := &models.NoteValue{}
+	markdown := tview.NewTextArea().
+		SetLabel("Markdown").
+		SetSize(10, 60)
+	markdown.SetChangedFunc(func() {
+		data.Markdown = markdown.GetText()
+	})
+
+	tuiService.drawCreateValueForm(models.DataTypeNote, data, markdown)
+}
+
+// DrawCreateWiFiForm - отрисовать форму создания Wi-Fi сети
+func (tuiService *TUIService) DrawCreateWiFiForm() {
+	data := &models.WiFiValue{Security: records.WiFiSecurityTypes[0]}
+	tuiService.drawCreateValueForm(
+		models.DataTypeWiFi,
+		data,
+		tview.NewInputField().
+			SetLabel(i18n.T("field.ssid")).
+			SetChangedFunc(func(text string) {
+				data.SSID = text
+			}),
+		tview.NewInputField().
+			SetLabel(i18n.T("field.password")).
+			SetMaskCharacter('*').
+			SetChangedFunc(func(text string) {
+				data.Password = text
+			}),
+		tview.NewDropDown().
+			SetLabel(i18n.T("field.security")).
+			SetOptions(records.WiFiSecurityTypes, func(text string, _ int) {
+				data.Security = text
+			}).
+			SetCurrentOption(0),
+	)
+	tuiService.addGenerateButton(tuiService.dataForm, i18n.T("field.password"))
+}
+
+// DrawCreateAPIKeyForm - отрисовать форму создания API ключа
+func (tuiService *TUIService) DrawCreateAPIKeyForm() {
+	data := &models.APIKeyValue{}
+	tuiService.drawCreateValueForm(
+		models.DataTypeAPIKey,
+		data,
+		tview.NewInputField().
+			SetLabel(i18n.T("field.provider")).
+			SetChangedFunc(func(text string) {
+				data.Provider = text
+			}),
+		tview.NewInputField().
+			SetLabel(i18n.T("field.key_id")).
+			SetChangedFunc(func(text string) {
+				data.KeyID = text
+			}),
+		tview.NewInputField().
+			SetLabel(i18n.T("field.secret")).
+			SetMaskCharacter('*').
+			SetChangedFunc(func(text string) {
+				data.Secret = text
+			}),
+		tview.NewInputField().
+			SetLabel(i18n.T("field.endpoint")).
+			SetChangedFunc(func(text string) {
+				data.Endpoint = text
+			}),
+	)
 }
 
 // DataError - отобразить ошибку получения данных
@@ -894,6 +979,7 @@ func (tuiService *TUIService) GetCurrentPage() string {
 
 // Stop - остановить консольное приложение
 func (tuiService *TUIService) Stop() {
+	tuiService.clearClipboard()
 	tuiService.application.Stop()
 	tuiService.running = false
 }
@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"fmt"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
+
+// formatBytes - размер в удобных для чтения единицах
+func formatBytes(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return i18n.T("size.bytes", size)
+	}
+
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return i18n.T("size.scaled", float64(size)/float64(div), []rune(i18n.T("size.prefixes"))[exp])
+}
+
+// formatUsage - строка использования с лимитом, нулевой лимит - без ограничений
+func formatUsage(used int64, limit int64, format func(int64) string) string {
+	if limit <= 0 {
+		return format(used)
+	}
+
+	text := i18n.T("usage.of", format(used), format(limit))
+	if used*10 >= limit*9 {
+		return "[red]" + text + "[-]"
+	}
+
+	return text
+}
+
+// DrawUsage - отобразить использование хранилища
+func (tuiService *TUIService) DrawUsage(usage models.UserUsage) {
+	tuiService.maxItemSize = usage.MaxItemSize
+	if tuiService.usageView == nil {
+		return
+	}
+
+	count := func(n int64) string {
+		return fmt.Sprintf("%d", n)
+	}
+	tuiService.usageView.SetText(i18n.T(
+		"usage.summary",
+		formatUsage(usage.Items, usage.MaxItems, count),
+		formatUsage(usage.Bytes, usage.MaxBytes, formatBytes),
+	))
+
+	if tuiService.running {
+		tuiService.application.Draw()
+	}
+}
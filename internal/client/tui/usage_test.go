@@ -0,0 +1,20 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "512 Б", formatBytes(512))
+	assert.Equal(t, "1.5 КБ", formatBytes(1536))
+	assert.Equal(t, "100.0 МБ", formatBytes(100<<20))
+	assert.Equal(t, "2.0 ГБ", formatBytes(2<<30))
+}
+
+func TestFormatUsage(t *testing.T) {
+	assert.Equal(t, "1.0 КБ", formatUsage(1024, 0, formatBytes))
+	assert.Equal(t, "1.0 КБ из 1.0 МБ", formatUsage(1024, 1<<20, formatBytes))
+	assert.Equal(t, "[red]950 Б из 1000 Б[-]", formatUsage(950, 1000, formatBytes))
+}
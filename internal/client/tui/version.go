@@ -0,0 +1,32 @@
+package tui
+
+import (
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/i18n"
+	"github.com/ShukinDmitriy/GophKeeper/internal/client/router"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/version"
+	"github.com/rivo/tview"
+)
+
+// clientTitle - заголовок окна со сведениями о сборке клиента: версия, коммит и время сборки
+func clientTitle() string {
+	return router.ApplicationName + " " + tview.Escape(version.Get().String())
+}
+
+// versionTitle - заголовок окна с версией клиента и предупреждением о несовместимом сервере
+func versionTitle(server version.Info) string {
+	title := clientTitle()
+	if server.Compatible() {
+		return title
+	}
+
+	return title + " [red]" + i18n.T("version.incompatible", server.Version, server.APIVersion, version.APIVersion) + "[-]"
+}
+
+// VersionWarning - показать в заголовке окна, что версия API сервера несовместима с клиентом
+func (tuiService *TUIService) VersionWarning(server version.Info) {
+	tuiService.pages.SetTitle(versionTitle(server))
+
+	if tuiService.running {
+		tuiService.application.Draw()
+	}
+}
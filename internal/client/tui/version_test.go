@@ -0,0 +1,17 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/version"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionTitle(t *testing.T) {
+	// В заголовке полные сведения о сборке клиента
+	assert.Equal(t, "GophKeeper dev (commit none, built unknown, api v1)", versionTitle(version.Info{APIVersion: version.APIVersion}))
+	assert.Equal(t,
+		"GophKeeper dev (commit none, built unknown, api v1) [red](сервер v2.0.0: API v2, клиент поддерживает v1)[-]",
+		versionTitle(version.Info{Version: "v2.0.0", APIVersion: 2}),
+	)
+}
@@ -0,0 +1,24 @@
+package models
+
+// DataBatchOperationType - тип операции пакетной обработки
+type DataBatchOperationType string
+
+const (
+	DataBatchCreate DataBatchOperationType = "create"
+	DataBatchUpdate DataBatchOperationType = "update"
+	DataBatchDelete DataBatchOperationType = "delete"
+)
+
+// DataBatchResult - результат выполнения одной операции пакета.
+// Status совпадает с кодом ответа соответствующего одиночного запроса, Code - код ошибки операции,
+// как в ErrorResponse, клиент показывает по нему текст на своем языке.
+type DataBatchResult struct {
+	Index  int                    `json:"index"`
+	Op     DataBatchOperationType `json:"op"`
+	ID     uint                   `json:"id,omitempty"`
+	Status int                    `json:"status"`
+	Code   ErrorCode              `json:"code,omitempty"`
+	// Fields - поля операции, не прошедшие проверку, и нарушенное правило
+	Fields map[string]string `json:"fields,omitempty"`
+	Data   *DataInfo         `json:"data,omitempty"`
+}
@@ -0,0 +1,61 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// FieldType - тип пользовательского поля записи
+type FieldType int
+
+const (
+	FieldTypeText FieldType = iota
+	FieldTypeHidden
+	FieldTypeURL
+	FieldTypeDate
+	FieldTypeBoolean
+)
+
+// DataField - пользовательское поле записи (сайт, банк, одноразовые коды и т.п.)
+type DataField struct {
+	Name  string    `json:"name" validate:"required"`
+	Type  FieldType `json:"type" validate:"min=0,max=4"`
+	Value string    `json:"value"`
+}
+
+// DataFields - список пользовательских полей, хранится в БД в виде jsonb
+type DataFields []DataField
+
+// Value - сериализовать поля для записи в БД
+func (f DataFields) Value() (driver.Value, error) {
+	if f == nil {
+		return "[]", nil
+	}
+
+	res, err := json.Marshal(f)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(res), nil
+}
+
+// Scan - прочитать поля из БД
+func (f *DataFields) Scan(src interface{}) error {
+	return scanJSON(src, f)
+}
+
+// scanJSON - разобрать jsonb значение из БД
+func scanJSON(src interface{}, dst interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, dst)
+	case string:
+		return json.Unmarshal([]byte(v), dst)
+	default:
+		return fmt.Errorf("unsupported jsonb value type %T", src)
+	}
+}
@@ -0,0 +1,127 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"net"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/publicsuffix"
+)
+
+// URLMatch - правило сопоставления URL записи с адресом
+type URLMatch int
+
+const (
+	// URLMatchDomain - совпадает регистрируемый домен (example.com и login.example.com,
+	// но не example.co.uk и attacker.co.uk)
+	URLMatchDomain URLMatch = iota
+	// URLMatchHost - совпадает хост и порт
+	URLMatchHost
+	// URLMatchStartsWith - адрес начинается с URL записи
+	URLMatchStartsWith
+	// URLMatchExact - полное совпадение
+	URLMatchExact
+	// URLMatchRegexp - адрес соответствует регулярному выражению
+	URLMatchRegexp
+	// URLMatchNever - никогда не сопоставлять
+	URLMatchNever
+)
+
+// DataURL - адрес, к которому относятся учетные данные
+type DataURL struct {
+	URL   string   `json:"url" validate:"required"`
+	Match URLMatch `json:"match" validate:"min=0,max=5"`
+}
+
+// DataURLs - список адресов записи, хранится в БД в виде jsonb
+type DataURLs []DataURL
+
+// Value - сериализовать адреса для записи в БД
+func (u DataURLs) Value() (driver.Value, error) {
+	if u == nil {
+		return "[]", nil
+	}
+
+	res, err := json.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(res), nil
+}
+
+// Scan - прочитать адреса из БД
+func (u *DataURLs) Scan(src interface{}) error {
+	return scanJSON(src, u)
+}
+
+// Matches - проверить, подходит ли адрес target под правило
+func (u DataURL) Matches(target string) bool {
+	switch u.Match {
+	case URLMatchNever:
+		return false
+	case URLMatchExact:
+		return u.URL == target
+	case URLMatchStartsWith:
+		return strings.HasPrefix(target, u.URL)
+	case URLMatchRegexp:
+		matched, err := regexp.MatchString(u.URL, target)
+		return err == nil && matched
+	}
+
+	ruleURL := parseURL(u.URL)
+	targetURL := parseURL(target)
+	if ruleURL == nil || targetURL == nil || ruleURL.Hostname() == "" {
+		return false
+	}
+
+	if u.Match == URLMatchHost {
+		return strings.EqualFold(ruleURL.Host, targetURL.Host)
+	}
+
+	return strings.EqualFold(baseDomain(ruleURL.Hostname()), baseDomain(targetURL.Hostname()))
+}
+
+// Matches - проверить, подходит ли адрес target хотя бы под одно правило
+func (u DataURLs) Matches(target string) bool {
+	for _, dataURL := range u {
+		if dataURL.Matches(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseURL - разобрать адрес, допуская отсутствие схемы
+func parseURL(raw string) *url.URL {
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+
+	res, err := url.Parse(raw)
+	if err != nil {
+		return nil
+	}
+
+	return res
+}
+
+// baseDomain - получить регистрируемый домен по списку публичных суффиксов
+func baseDomain(host string) string {
+	host = strings.ToLower(strings.TrimSuffix(host, "."))
+	if net.ParseIP(host) != nil {
+		return host
+	}
+
+	domain, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		// Сам хост является публичным суффиксом или не содержит точек (localhost)
+		return host
+	}
+
+	return domain
+}
@@ -0,0 +1,114 @@
+package models_test
+
+import (
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataURLMatches(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   models.DataURL
+		target string
+		want   bool
+	}{
+		{
+			name:   "domain matches subdomain",
+			rule:   models.DataURL{URL: "example.com", Match: models.URLMatchDomain},
+			target: "https://login.example.com/auth",
+			want:   true,
+		},
+		{
+			name:   "domain does not match other domain",
+			rule:   models.DataURL{URL: "https://example.com", Match: models.URLMatchDomain},
+			target: "https://example.org",
+			want:   false,
+		},
+		{
+			name:   "domain does not match other domain in public suffix",
+			rule:   models.DataURL{URL: "https://example.co.uk", Match: models.URLMatchDomain},
+			target: "https://attacker.co.uk",
+			want:   false,
+		},
+		{
+			name:   "domain matches subdomain in public suffix",
+			rule:   models.DataURL{URL: "https://example.com.au", Match: models.URLMatchDomain},
+			target: "https://login.example.com.au",
+			want:   true,
+		},
+		{
+			name:   "domain matches single label host",
+			rule:   models.DataURL{URL: "http://localhost:8080", Match: models.URLMatchDomain},
+			target: "http://localhost:8080/app",
+			want:   true,
+		},
+		{
+			name:   "host matches with port",
+			rule:   models.DataURL{URL: "https://git.example.com:8443", Match: models.URLMatchHost},
+			target: "https://git.example.com:8443/repo.git",
+			want:   true,
+		},
+		{
+			name:   "host does not match subdomain",
+			rule:   models.DataURL{URL: "example.com", Match: models.URLMatchHost},
+			target: "https://login.example.com",
+			want:   false,
+		},
+		{
+			name:   "starts with",
+			rule:   models.DataURL{URL: "https://example.com/app", Match: models.URLMatchStartsWith},
+			target: "https://example.com/app/settings",
+			want:   true,
+		},
+		{
+			name:   "exact",
+			rule:   models.DataURL{URL: "https://example.com/app", Match: models.URLMatchExact},
+			target: "https://example.com/app/settings",
+			want:   false,
+		},
+		{
+			name:   "regexp",
+			rule:   models.DataURL{URL: `^https://[a-z]+\.example\.com/`, Match: models.URLMatchRegexp},
+			target: "https://api.example.com/v1",
+			want:   true,
+		},
+		{
+			name:   "invalid regexp",
+			rule:   models.DataURL{URL: `(`, Match: models.URLMatchRegexp},
+			target: "(",
+			want:   false,
+		},
+		{
+			name:   "never",
+			rule:   models.DataURL{URL: "example.com", Match: models.URLMatchNever},
+			target: "https://example.com",
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rule.Matches(tt.target))
+		})
+	}
+}
+
+func TestDataFieldsScan(t *testing.T) {
+	fields := models.DataFields{
+		{Name: "site", Type: models.FieldTypeURL, Value: "https://example.com"},
+		{Name: "2fa", Type: models.FieldTypeBoolean, Value: "true"},
+	}
+
+	value, err := fields.Value()
+	assert.Nil(t, err)
+
+	var scanned models.DataFields
+	assert.Nil(t, scanned.Scan([]byte(value.(string))))
+	assert.Equal(t, fields, scanned)
+
+	var empty models.DataFields
+	value, err = empty.Value()
+	assert.Nil(t, err)
+	assert.Equal(t, "[]", value)
+}
@@ -0,0 +1,139 @@
+package models
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// CredentialsValue - значение записи "Учетные данные"
+type CredentialsValue struct {
+	Login    string
+	Password string
+}
+
+// TextValue - значение записи "Текстовые данные"
+type TextValue struct {
+	Text string
+}
+
+// BinaryEncodingBase64 - в Binary хранится содержимое файла в base64
+const BinaryEncodingBase64 = "base64"
+
+// BinaryValue - значение записи "Бинарные данные"
+type BinaryValue struct {
+	Binary string
+	// FileName, MIMEType - имя и тип загруженного файла
+	FileName string `json:",omitempty"`
+	MIMEType string `json:",omitempty"`
+	// Encoding - кодировка Binary: пусто - текст как есть, BinaryEncodingBase64 - содержимое файла
+	Encoding string `json:",omitempty"`
+}
+
+// NewBinaryFile - значение с содержимым файла
+func NewBinaryFile(name string, mimeType string, content []byte) BinaryValue {
+	return BinaryValue{
+		Binary:   base64.StdEncoding.EncodeToString(content),
+		FileName: name,
+		MIMEType: mimeType,
+		Encoding: BinaryEncodingBase64,
+	}
+}
+
+// Content - содержимое значения в байтах
+func (v BinaryValue) Content() ([]byte, error) {
+	if v.Encoding == BinaryEncodingBase64 {
+		return base64.StdEncoding.DecodeString(v.Binary)
+	}
+
+	return []byte(v.Binary), nil
+}
+
+// BankCardValue - значение записи "Данные банковских карт"
+type BankCardValue struct {
+	Number string
+	Date   string
+	Secure string
+}
+
+// SSHKeyValue - значение записи "SSH ключ"
+type SSHKeyValue struct {
+	PrivateKey string
+	PublicKey  string
+	Passphrase string
+}
+
+// IdentityValue - значение записи "Документ"
+type IdentityValue struct {
+	Kind       string
+	Number     string
+	FullName   string
+	IssuedBy   string
+	IssueDate  string
+	ExpiryDate string
+}
+
+// NoteValue - значение записи "Заметка" в формате Markdown
+type NoteValue struct {
+	Markdown string
+}
+
+// WiFiValue - значение записи "Wi-Fi"
+type WiFiValue struct {
+	SSID     string
+	Password string
+	Security string
+}
+
+// APIKeyValue - значение записи "API ключ"
+type APIKeyValue struct {
+	Provider string
+	KeyID    string
+	Secret   string
+	Endpoint string
+}
+
+// NewValue - создать пустое значение для типа данных
+func NewValue(dataType DataType) interface{} {
+	switch dataType {
+	case DataTypeCredentials:
+		return &CredentialsValue{}
+	case DataTypeText:
+		return &TextValue{}
+	case DataTypeBinary:
+		return &BinaryValue{}
+	case DataTypeBankCard:
+		return &BankCardValue{}
+	case DataTypeSSHKey:
+		return &SSHKeyValue{}
+	case DataTypeIdentity:
+		return &IdentityValue{}
+	case DataTypeNote:
+		return &NoteValue{}
+	case DataTypeWiFi:
+		return &WiFiValue{}
+	case DataTypeAPIKey:
+		return &APIKeyValue{}
+	}
+
+	return nil
+}
+
+// EncodeValue - закодировать значение записи (json в base64)
+func EncodeValue(value interface{}) (string, error) {
+	jsonData, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(jsonData), nil
+}
+
+// DecodeValue - раскодировать значение записи (base64 в json)
+func DecodeValue(data string, value interface{}) error {
+	decodedValue, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(decodedValue, value)
+}
@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type DataType int
 
 const (
@@ -8,11 +10,44 @@ const (
 	DataTypeText
 	DataTypeBinary
 	DataTypeBankCard
+	DataTypeSSHKey
+	DataTypeIdentity
+	DataTypeNote
+	DataTypeWiFi
+	DataTypeAPIKey
 )
 
 type DataInfo struct {
-	ID          uint     `json:"id"`
-	Type        DataType `json:"type" validate:"required"`
-	Description string   `json:"description"`
-	Value       string   `json:"value" validate:"required"`
+	ID          uint       `json:"id"`
+	Type        DataType   `json:"type" validate:"required"`
+	Description string     `json:"description"`
+	Value       string     `json:"value" validate:"required"`
+	Fields      DataFields `json:"fields,omitempty" validate:"dive"`
+	URLs        DataURLs   `json:"urls,omitempty" validate:"dive"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	RotateAt    *time.Time `json:"rotate_at,omitempty"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+	// PasswordChangedAt - когда последний раз менялось значение записи (сам секрет)
+	PasswordChangedAt *time.Time `json:"password_changed_at,omitempty"`
+}
+
+// PasswordChanged - когда последний раз менялся секрет записи. Сервер старой версии
+// не отдает это время, тогда используется время любого изменения записи.
+func (d DataInfo) PasswordChanged() time.Time {
+	if d.PasswordChangedAt != nil {
+		return *d.PasswordChangedAt
+	}
+
+	return d.UpdatedAt
+}
+
+// Expired - запись истекла или ее пора сменить
+func (d DataInfo) Expired(now time.Time) bool {
+	return (d.ExpiresAt != nil && !d.ExpiresAt.After(now)) ||
+		(d.RotateAt != nil && !d.RotateAt.After(now))
+}
+
+// ExpiresWithin - запись истечет или ее нужно будет сменить в ближайшие days дней
+func (d DataInfo) ExpiresWithin(now time.Time, days int) bool {
+	return d.Expired(now.AddDate(0, 0, days))
 }
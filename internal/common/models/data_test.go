@@ -0,0 +1,53 @@
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataInfoExpired(t *testing.T) {
+	now := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	yesterday := now.AddDate(0, 0, -1)
+	nextWeek := now.AddDate(0, 0, 7)
+
+	tests := []struct {
+		name    string
+		data    models.DataInfo
+		expired bool
+		soon    bool
+	}{
+		{name: "no dates", data: models.DataInfo{}, expired: false, soon: false},
+		{name: "expired", data: models.DataInfo{ExpiresAt: &yesterday}, expired: true, soon: true},
+		{name: "rotate next week", data: models.DataInfo{RotateAt: &nextWeek}, expired: false, soon: true},
+		{name: "expires at now", data: models.DataInfo{ExpiresAt: &now}, expired: true, soon: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expired, tt.data.Expired(now))
+			assert.Equal(t, tt.soon, tt.data.ExpiresWithin(now, 14))
+		})
+	}
+}
+
+func TestBinaryValueContent(t *testing.T) {
+	content := []byte{0x00, 0xff, 'G', 'K'}
+	value := models.NewBinaryFile("key.bin", "application/octet-stream", content)
+
+	encoded, err := models.EncodeValue(value)
+	assert.NoError(t, err)
+	decoded := models.BinaryValue{}
+	assert.NoError(t, models.DecodeValue(encoded, &decoded))
+	assert.Equal(t, value, decoded)
+
+	res, err := decoded.Content()
+	assert.NoError(t, err)
+	assert.Equal(t, content, res)
+
+	// Значения, введенные текстом, возвращаются как есть
+	res, err = models.BinaryValue{Binary: "plain text"}.Content()
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("plain text"), res)
+}
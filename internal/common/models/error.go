@@ -0,0 +1,52 @@
+package models
+
+import "net/http"
+
+// ErrorCode - машиночитаемый код ошибки API, не меняется между версиями
+type ErrorCode string
+
+const (
+	ErrorCodeBadRequest         ErrorCode = "bad_request"
+	ErrorCodeValidation         ErrorCode = "validation_failed"
+	ErrorCodeUnauthorized       ErrorCode = "unauthorized"
+	ErrorCodeInvalidCredentials ErrorCode = "invalid_credentials"
+	ErrorCodeUserExists         ErrorCode = "user_exists"
+	ErrorCodeForbidden          ErrorCode = "forbidden"
+	ErrorCodeNotFound           ErrorCode = "not_found"
+	ErrorCodeItemTooLarge       ErrorCode = "item_too_large"
+	ErrorCodeQuotaExceeded      ErrorCode = "quota_exceeded"
+	ErrorCodeInternal           ErrorCode = "internal"
+	ErrorCodeBatchRolledBack    ErrorCode = "batch_rolled_back"
+)
+
+// ErrorResponse - тело ответа сервера с ошибкой. Message - описание для журналов,
+// клиент показывает пользователю текст по Code на своем языке.
+type ErrorResponse struct {
+	Code    ErrorCode `json:"code"`
+	Message string    `json:"message"`
+	// Fields - поля запроса, не прошедшие проверку, и нарушенное правило
+	Fields map[string]string `json:"fields,omitempty"`
+	// RequestID - идентификатор запроса для поиска в журнале сервера
+	RequestID string `json:"request_id,omitempty"`
+}
+
+// ErrorCodeByStatus - код ошибки по HTTP статусу, если в ответе его нет
+func ErrorCodeByStatus(status int) ErrorCode {
+	switch status {
+	case http.StatusUnauthorized:
+		return ErrorCodeUnauthorized
+	case http.StatusForbidden:
+		return ErrorCodeForbidden
+	case http.StatusNotFound:
+		return ErrorCodeNotFound
+	case http.StatusRequestEntityTooLarge:
+		return ErrorCodeItemTooLarge
+	case http.StatusInsufficientStorage:
+		return ErrorCodeQuotaExceeded
+	}
+	if status >= http.StatusInternalServerError {
+		return ErrorCodeInternal
+	}
+
+	return ErrorCodeBadRequest
+}
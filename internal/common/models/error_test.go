@@ -0,0 +1,18 @@
+package models_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorCodeByStatus(t *testing.T) {
+	assert.Equal(t, models.ErrorCodeUnauthorized, models.ErrorCodeByStatus(http.StatusUnauthorized))
+	assert.Equal(t, models.ErrorCodeForbidden, models.ErrorCodeByStatus(http.StatusForbidden))
+	assert.Equal(t, models.ErrorCodeNotFound, models.ErrorCodeByStatus(http.StatusNotFound))
+	assert.Equal(t, models.ErrorCodeQuotaExceeded, models.ErrorCodeByStatus(http.StatusInsufficientStorage))
+	assert.Equal(t, models.ErrorCodeBadRequest, models.ErrorCodeByStatus(http.StatusMethodNotAllowed))
+	assert.Equal(t, models.ErrorCodeInternal, models.ErrorCodeByStatus(http.StatusBadGateway))
+}
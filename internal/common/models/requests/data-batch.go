@@ -0,0 +1,30 @@
+package requests
+
+import (
+	"fmt"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/go-playground/validator/v10"
+)
+
+// DataBatchMaxOperations - максимальное количество операций в одном пакете
+const DataBatchMaxOperations = 1000
+
+// RegisterValidations - зарегистрировать правила проверки, которые зависят от констант пакета.
+// Правило batch_max раскрывается в max=DataBatchMaxOperations.
+func RegisterValidations(validate *validator.Validate) {
+	validate.RegisterAlias("batch_max", fmt.Sprintf("max=%d", DataBatchMaxOperations))
+}
+
+type DataBatch struct {
+	Atomic bool `json:"atomic"`
+	// Operations - операции пакета, не больше DataBatchMaxOperations
+	Operations []DataBatchOperation `json:"operations" validate:"required,min=1,batch_max"`
+	UserID     uint                 `json:"user_id" validate:"required"`
+}
+
+type DataBatchOperation struct {
+	Op   models.DataBatchOperationType `json:"op" validate:"required,oneof=create update delete"`
+	ID   uint                          `json:"id" validate:"required_unless=Op create"`
+	Data *DataModel                    `json:"data,omitempty" validate:"required_unless=Op delete,omitempty"`
+}
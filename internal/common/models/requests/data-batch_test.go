@@ -0,0 +1,33 @@
+package requests_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+	"github.com/go-playground/validator/v10"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataBatchValidation(t *testing.T) {
+	validate := validator.New(validator.WithRequiredStructEnabled())
+	requests.RegisterValidations(validate)
+
+	operations := make([]requests.DataBatchOperation, requests.DataBatchMaxOperations+1)
+	for i := range operations {
+		operations[i] = requests.DataBatchOperation{Op: models.DataBatchDelete, ID: uint(i + 1)}
+	}
+
+	assert.NoError(t, validate.Struct(requests.DataBatch{Operations: operations[:requests.DataBatchMaxOperations], UserID: 1}))
+
+	err := validate.Struct(requests.DataBatch{Operations: operations, UserID: 1})
+	var validationErrors validator.ValidationErrors
+	require.True(t, errors.As(err, &validationErrors))
+	assert.Equal(t, "max", validationErrors[0].ActualTag())
+
+	err = validate.Struct(requests.DataBatch{UserID: 1})
+	require.True(t, errors.As(err, &validationErrors))
+	assert.Equal(t, "required", validationErrors[0].ActualTag())
+}
@@ -0,0 +1,6 @@
+package requests
+
+type DataExpiring struct {
+	Days   int  `json:"days" query:"days" validate:"min=0,max=3650"`
+	UserID uint `json:"user_id" query:"user_id" validate:"required"`
+}
@@ -1,10 +1,35 @@
 package requests
 
-import "github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+import (
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+)
 
 type DataModel struct {
-	Type        models.DataType `json:"type"`
-	Description string          `json:"description"`
-	Value       string          `json:"value" validate:"required"`
-	UserID      uint            `json:"user_id" validate:"required"`
+	Type        models.DataType   `json:"type"`
+	Description string            `json:"description"`
+	Value       string            `json:"value" validate:"required"`
+	Fields      models.DataFields `json:"fields,omitempty" validate:"dive"`
+	URLs        models.DataURLs   `json:"urls,omitempty" validate:"dive"`
+	ExpiresAt   *time.Time        `json:"expires_at,omitempty"`
+	RotateAt    *time.Time        `json:"rotate_at,omitempty"`
+	UserID      uint              `json:"user_id" validate:"required"`
+}
+
+// Size - размер записи в байтах, который учитывается в квоте пользователя: значение,
+// описание, имена и значения полей и адреса. Так же размер считает миграция 000005
+// для существующих записей, поэтому формулы нужно менять вместе.
+func (d DataModel) Size() int64 {
+	size := int64(len(d.Value) + len(d.Description))
+
+	for _, field := range d.Fields {
+		size += int64(len(field.Name) + len(field.Value))
+	}
+
+	for _, dataURL := range d.URLs {
+		size += int64(len(dataURL.URL))
+	}
+
+	return size
 }
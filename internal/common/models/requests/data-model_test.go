@@ -0,0 +1,26 @@
+package requests_test
+
+import (
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataModelSize(t *testing.T) {
+	dataModel := requests.DataModel{
+		Value:       "dmFsdWU=",
+		Description: "почта",
+		Fields: models.DataFields{
+			{Name: "pin", Type: models.FieldTypeHidden, Value: "<1234>"},
+		},
+		URLs: models.DataURLs{
+			{URL: "https://mail.example.com", Match: models.URLMatchHost},
+		},
+	}
+
+	// Считаются байты строк без разметки JSON, как в миграции 000005
+	assert.Equal(t, int64(8+10+3+6+24), dataModel.Size())
+	assert.Equal(t, int64(0), requests.DataModel{}.Size())
+}
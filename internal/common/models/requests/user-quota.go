@@ -0,0 +1,8 @@
+package requests
+
+// UserQuota - индивидуальные лимиты пользователя, пустое значение - лимит по умолчанию
+type UserQuota struct {
+	MaxItems    *int64 `json:"max_items" validate:"omitempty,min=0"`
+	MaxBytes    *int64 `json:"max_bytes" validate:"omitempty,min=0"`
+	MaxItemSize *int64 `json:"max_item_size" validate:"omitempty,min=0"`
+}
@@ -0,0 +1,11 @@
+package models
+
+// UserUsage - использование хранилища пользователем и действующие лимиты.
+// Нулевой лимит означает отсутствие ограничения.
+type UserUsage struct {
+	Items       int64 `json:"items"`
+	Bytes       int64 `json:"bytes"`
+	MaxItems    int64 `json:"max_items"`
+	MaxBytes    int64 `json:"max_bytes"`
+	MaxItemSize int64 `json:"max_item_size"`
+}
@@ -1,11 +1,18 @@
 package router
 
 const (
-	ApiLoginPath      = "/api/user/login"
-	ApiRegisterPath   = "/api/user/register"
-	ApiDataListPath   = "/api/data"
-	ApiDataCreatePath = "/api/data"
-	ApiDataReadPath   = "/api/data/:id"
-	ApiDataUpdatePath = "/api/data/:id"
-	ApiDataDeletePath = "/api/data/:id"
+	ApiVersionPath      = "/api/version"
+	ApiLoginPath        = "/api/user/login"
+	ApiRegisterPath     = "/api/user/register"
+	ApiRefreshPath      = "/api/user/refresh"
+	ApiUserUsagePath    = "/api/user/usage"
+	ApiDataListPath     = "/api/data"
+	ApiDataCreatePath   = "/api/data"
+	ApiDataExpiringPath = "/api/data/expiring"
+	ApiDataBatchPath    = "/api/data/batch"
+	ApiDataReadPath     = "/api/data/:id"
+	ApiDataUpdatePath   = "/api/data/:id"
+	ApiDataDeletePath   = "/api/data/:id"
+
+	ApiAdminUserQuotaPath = "/api/admin/users/:id/quota"
 )
@@ -0,0 +1,47 @@
+// Package version содержит сведения о сборке клиента и сервера
+package version
+
+import "fmt"
+
+// Значения подставляются при сборке:
+//
+//	go build -ldflags "-X github.com/ShukinDmitriy/GophKeeper/internal/common/version.Version=v1.0.0"
+var (
+	// Version - версия сборки
+	Version = "dev"
+	// Commit - хеш коммита, из которого собрана программа
+	Commit = "none"
+	// BuildTime - дата и время сборки
+	BuildTime = "unknown"
+)
+
+// APIVersion - версия API. Увеличивается при несовместимых изменениях протокола.
+const APIVersion = 1
+
+// Info - сведения о сборке
+type Info struct {
+	Version    string `json:"version"`
+	Commit     string `json:"commit"`
+	BuildTime  string `json:"build_time"`
+	APIVersion int    `json:"api_version"`
+}
+
+// Get - сведения о текущей сборке
+func Get() Info {
+	return Info{
+		Version:    Version,
+		Commit:     Commit,
+		BuildTime:  BuildTime,
+		APIVersion: APIVersion,
+	}
+}
+
+// String - сведения о сборке в одну строку
+func (i Info) String() string {
+	return fmt.Sprintf("%s (commit %s, built %s, api v%d)", i.Version, i.Commit, i.BuildTime, i.APIVersion)
+}
+
+// Compatible - совместима ли версия API другой стороны с текущей сборкой
+func (i Info) Compatible() bool {
+	return i.APIVersion == APIVersion
+}
@@ -0,0 +1,17 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInfo(t *testing.T) {
+	info := Get()
+
+	assert.Equal(t, Version, info.Version)
+	assert.Equal(t, APIVersion, info.APIVersion)
+	assert.True(t, info.Compatible())
+	assert.False(t, Info{APIVersion: APIVersion + 1}.Compatible())
+	assert.Equal(t, "dev (commit none, built unknown, api v1)", info.String())
+}
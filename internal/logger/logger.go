@@ -45,7 +45,7 @@ func NewLogger(
 	if err == nil {
 		logger.SetOutput(file)
 	} else {
-		logger.Info("Не удалось открыть файл логов, используется стандартный stderr")
+		logger.Info("Failed to open log file, using standard stderr")
 	}
 
 	return logger
@@ -0,0 +1,128 @@
+// Package apierror содержит ошибки API и единый обработчик, который отдает их клиенту в виде models.ErrorResponse
+package apierror
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// Error - ошибка API: HTTP статус, код и описание для ответа клиенту.
+// Err - исходная ошибка, попадает только в журнал.
+type Error struct {
+	Status  int
+	Code    models.ErrorCode
+	Message string
+	Fields  map[string]string
+	Err     error
+}
+
+// New - ошибка API со статусом status и кодом code
+func New(status int, code models.ErrorCode, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+// BadRequest - тело или параметры запроса не разобраны
+func BadRequest(err error) *Error {
+	return &Error{Status: http.StatusBadRequest, Code: models.ErrorCodeBadRequest, Message: "bad request", Err: err}
+}
+
+// Validation - запрос не прошел проверку, в Fields правило, которое нарушило каждое поле
+func Validation(err error) *Error {
+	fields := map[string]string{}
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		for _, fieldErr := range validationErrors {
+			fields[strings.ToLower(fieldErr.Field())] = fieldErr.ActualTag()
+		}
+	}
+
+	return &Error{
+		Status:  http.StatusBadRequest,
+		Code:    models.ErrorCodeValidation,
+		Message: "validation failed",
+		Fields:  fields,
+		Err:     err,
+	}
+}
+
+// NotFound - запись не найдена
+func NotFound(err error) *Error {
+	return &Error{Status: http.StatusNotFound, Code: models.ErrorCodeNotFound, Message: "not found", Err: err}
+}
+
+// Internal - внутренняя ошибка сервера, подробности клиенту не отдаются
+func Internal(err error) *Error {
+	return &Error{Status: http.StatusInternalServerError, Code: models.ErrorCodeInternal, Message: "internal GophKeeper error", Err: err}
+}
+
+// Error - текст ошибки для журнала
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Code, e.Message, e.Err)
+	}
+
+	return fmt.Sprintf("%s: %s", e.Code, e.Message)
+}
+
+// Unwrap - исходная ошибка
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Handler - обработчик ошибок echo: любая ошибка отдается клиенту как models.ErrorResponse
+// с идентификатором запроса из заголовка X-Request-ID
+func Handler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	apiErr := From(err)
+	if apiErr.Status >= http.StatusInternalServerError {
+		Log(c, err)
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(apiErr.Status)
+	} else {
+		err = c.JSON(apiErr.Status, models.ErrorResponse{
+			Code:      apiErr.Code,
+			Message:   apiErr.Message,
+			Fields:    apiErr.Fields,
+			RequestID: c.Response().Header().Get(echo.HeaderXRequestID),
+		})
+	}
+	if err != nil {
+		Log(c, err)
+	}
+}
+
+// Log - записать ошибку в журнал с идентификатором запроса, который получил клиент
+func Log(c echo.Context, err error) {
+	c.Logger().Errorf("request_id=%s %s %s: %v", c.Response().Header().Get(echo.HeaderXRequestID), c.Request().Method, c.Path(), err)
+}
+
+// From - ошибка API из любой ошибки: ошибки echo (неизвестный путь, метод, размер тела)
+// получают код по статусу, остальные считаются внутренними
+func From(err error) *Error {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		message, ok := httpErr.Message.(string)
+		if !ok {
+			message = http.StatusText(httpErr.Code)
+		}
+		return &Error{Status: httpErr.Code, Code: models.ErrorCodeByStatus(httpErr.Code), Message: message, Err: err}
+	}
+
+	return Internal(err)
+}
@@ -0,0 +1,116 @@
+package apierror_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/apierror"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	e := echo.New()
+	e.HTTPErrorHandler = apierror.Handler
+	e.Use(middleware.RequestID())
+
+	type request struct {
+		Login string `validate:"required"`
+	}
+	e.GET("/validation", func(c echo.Context) error {
+		return apierror.Validation(validator.New().Struct(request{}))
+	})
+	e.GET("/conflict", func(c echo.Context) error {
+		return apierror.New(http.StatusConflict, models.ErrorCodeUserExists, "login already exist")
+	})
+	e.GET("/internal", func(c echo.Context) error {
+		return errors.New("database is down")
+	})
+
+	tests := []struct {
+		path   string
+		status int
+		want   models.ErrorResponse
+	}{
+		{
+			path:   "/validation",
+			status: http.StatusBadRequest,
+			want: models.ErrorResponse{
+				Code:    models.ErrorCodeValidation,
+				Message: "validation failed",
+				Fields:  map[string]string{"login": "required"},
+			},
+		},
+		{
+			path:   "/conflict",
+			status: http.StatusConflict,
+			want:   models.ErrorResponse{Code: models.ErrorCodeUserExists, Message: "login already exist"},
+		},
+		{
+			// Подробности внутренней ошибки клиенту не отдаются
+			path:   "/internal",
+			status: http.StatusInternalServerError,
+			want:   models.ErrorResponse{Code: models.ErrorCodeInternal, Message: "internal GophKeeper error"},
+		},
+		{
+			path:   "/unknown",
+			status: http.StatusNotFound,
+			want:   models.ErrorResponse{Code: models.ErrorCodeNotFound, Message: "Not Found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			assert.Equal(t, tt.status, rec.Code)
+			var got models.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+			assert.NotEmpty(t, got.RequestID)
+			assert.Equal(t, rec.Header().Get(echo.HeaderXRequestID), got.RequestID)
+
+			got.RequestID = ""
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestFrom(t *testing.T) {
+	cause := errors.New("no rows")
+	wrapped := apierror.NotFound(cause)
+	assert.Same(t, wrapped, apierror.From(wrapped))
+	assert.ErrorIs(t, wrapped, cause)
+
+	httpErr := apierror.From(echo.NewHTTPError(http.StatusRequestEntityTooLarge, "Request Entity Too Large"))
+	assert.Equal(t, http.StatusRequestEntityTooLarge, httpErr.Status)
+	assert.Equal(t, models.ErrorCodeItemTooLarge, httpErr.Code)
+
+	assert.Equal(t, models.ErrorCodeInternal, apierror.From(cause).Code)
+}
+
+func TestHandlerLogsRequestID(t *testing.T) {
+	e := echo.New()
+	e.HTTPErrorHandler = apierror.Handler
+	e.Use(middleware.RequestID())
+	logs := &bytes.Buffer{}
+	e.Logger.SetOutput(logs)
+	e.GET("/internal", func(c echo.Context) error {
+		return errors.New("database is down")
+	})
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/internal", nil))
+
+	// По идентификатору из ответа ошибку можно найти в журнале
+	assert.Contains(t, logs.String(), "request_id="+rec.Header().Get(echo.HeaderXRequestID))
+	assert.Contains(t, logs.String(), "database is down")
+}
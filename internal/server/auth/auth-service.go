@@ -1,11 +1,15 @@
 package auth
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"os"
 	"strconv"
 	"time"
 
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/apierror"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/models/responses"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
@@ -18,6 +22,19 @@ const (
 	refreshTokenCookieName = "refresh-token"
 )
 
+// Типы токенов в claim typ: access токен нельзя использовать вместо refresh и наоборот
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
+var (
+	// ErrInvalidRefreshToken - refresh токен отсутствует, истек или подделан
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+	// ErrWrongTokenType - токен другого типа
+	ErrWrongTokenType = errors.New("wrong token type")
+)
+
 // GetAccessTokenCookieName get access token name
 func GetAccessTokenCookieName() string {
 	return accessTokenCookieName
@@ -28,11 +45,36 @@ func GetSigningMethod() *jwt.SigningMethodHMAC {
 	return jwt.SigningMethodHS256
 }
 
+// Claims - claims access токена
 type Claims struct {
-	ID uint `json:"id"`
+	ID   uint   `json:"id"`
+	Type string `json:"typ"`
 	jwt.RegisteredClaims
 }
 
+// Validate - принять только access токен, вызывается jwt после проверки подписи и срока
+func (c *Claims) Validate() error {
+	if c.Type != TokenTypeAccess {
+		return ErrWrongTokenType
+	}
+
+	return nil
+}
+
+// RefreshClaims - claims refresh токена
+type RefreshClaims struct {
+	Claims
+}
+
+// Validate - принять только refresh токен
+func (c *RefreshClaims) Validate() error {
+	if c.Type != TokenTypeRefresh {
+		return ErrWrongTokenType
+	}
+
+	return nil
+}
+
 type AuthService struct {
 	authUser AuthUser
 }
@@ -61,7 +103,7 @@ func (authService *AuthService) GetRefreshTokenCookieName() string {
 func (authService *AuthService) BeforeFunc(c echo.Context) {
 	accessTokenCookie, err := c.Cookie(authService.GetAccessTokenCookieName())
 	if err == nil {
-		accessUserID, _ := authService.authUser.getUserIDByCookie(c, accessTokenCookie)
+		accessUserID, _ := authService.authUser.getUserIDByCookie(c, accessTokenCookie, &Claims{})
 		if accessUserID != nil {
 			return
 		}
@@ -73,7 +115,7 @@ func (authService *AuthService) BeforeFunc(c echo.Context) {
 	}
 
 	if accessTokenCookie == nil && refreshTokenCookie != nil {
-		userID, err := authService.authUser.getUserIDByCookie(c, refreshTokenCookie)
+		userID, err := authService.authUser.getUserIDByCookie(c, refreshTokenCookie, &RefreshClaims{})
 		if err != nil {
 			return
 		}
@@ -106,6 +148,34 @@ func (authService *AuthService) GenerateTokensAndSetCookies(c echo.Context, user
 	return nil
 }
 
+// RefreshTokens - выпустить новую пару токенов по действительному refresh токену
+func (authService *AuthService) RefreshTokens(c echo.Context) (*responses.UserInfo, error) {
+	cookie, err := c.Cookie(refreshTokenCookieName)
+	if err != nil {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	claims := &RefreshClaims{}
+	_, err = jwt.ParseWithClaims(cookie.Value, claims, func(token *jwt.Token) (interface{}, error) {
+		return []byte(GetJWTSecret()), nil
+	}, jwt.WithValidMethods([]string{GetSigningMethod().Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRefreshToken, err)
+	}
+
+	user := authService.authUser.getUserByID(c, claims.ID)
+	if user == nil {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	err = authService.GenerateTokensAndSetCookies(c, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
 func (authService *AuthService) GetUserID(c echo.Context) uint {
 	if c.Get("user") == nil {
 		return 0
@@ -131,24 +201,25 @@ func (authService *AuthService) JWTErrorChecker(_ echo.Context, err error) error
 		)
 	}
 
-	return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	return apierror.New(http.StatusUnauthorized, models.ErrorCodeUnauthorized, "Unauthorized")
 }
 
 func (authService *AuthService) generateAccessToken(user *responses.UserInfo) (*jwt.Token, string, time.Time, error) {
 	expirationTime := time.Now().Add(24 * time.Hour)
 
-	return authService.generateToken(user, expirationTime, []byte(GetJWTSecret()))
+	return authService.generateToken(user, TokenTypeAccess, expirationTime, []byte(GetJWTSecret()))
 }
 
 func (authService *AuthService) generateRefreshToken(user *responses.UserInfo) (*jwt.Token, string, time.Time, error) {
 	expirationTime := time.Now().Add(30 * 24 * time.Hour)
 
-	return authService.generateToken(user, expirationTime, []byte(GetJWTSecret()))
+	return authService.generateToken(user, TokenTypeRefresh, expirationTime, []byte(GetJWTSecret()))
 }
 
-func (authService *AuthService) generateToken(user *responses.UserInfo, expirationTime time.Time, secret []byte) (*jwt.Token, string, time.Time, error) {
+func (authService *AuthService) generateToken(user *responses.UserInfo, tokenType string, expirationTime time.Time, secret []byte) (*jwt.Token, string, time.Time, error) {
 	claims := &Claims{
-		ID: user.ID,
+		ID:   user.ID,
+		Type: tokenType,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(expirationTime),
 		},
@@ -8,4 +8,5 @@ import (
 type AuthServiceInterface interface {
 	GetUserID(c echo.Context) uint
 	GenerateTokensAndSetCookies(c echo.Context, user *responses.UserInfo) error
+	RefreshTokens(c echo.Context) (*responses.UserInfo, error)
 }
@@ -6,6 +6,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/config"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/models/responses"
@@ -147,3 +148,77 @@ func TestGenerateTokensAndSetCookies(t *testing.T) {
 		})
 	}
 }
+
+func TestRefreshTokens(t *testing.T) {
+	_, err := config.NewConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user := &responses.UserInfo{ID: 123, Login: "login"}
+	userRepo := mockRepositories.NewUserRepositoryInterface(t)
+	userRepo.EXPECT().Find(user.ID).Return(user, nil).Once()
+	authService := auth.NewAuthService(*auth.NewAuthUser(userRepo))
+
+	newToken := func(tokenType string, expiresAt time.Time) string {
+		token, err := jwt.NewWithClaims(auth.GetSigningMethod(), &auth.Claims{
+			ID:   user.ID,
+			Type: tokenType,
+			RegisteredClaims: jwt.RegisteredClaims{
+				ExpiresAt: jwt.NewNumericDate(expiresAt),
+			},
+		}).SignedString([]byte(auth.GetJWTSecret()))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return token
+	}
+
+	tests := []struct {
+		name    string
+		cookie  *http.Cookie
+		wantErr bool
+	}{
+		{
+			name:   "valid refresh token",
+			cookie: &http.Cookie{Name: "refresh-token", Value: newToken(auth.TokenTypeRefresh, time.Now().Add(time.Hour))},
+		},
+		{
+			name:    "expired refresh token",
+			cookie:  &http.Cookie{Name: "refresh-token", Value: newToken(auth.TokenTypeRefresh, time.Now().Add(-time.Hour))},
+			wantErr: true,
+		},
+		{
+			name:    "access token as refresh token",
+			cookie:  &http.Cookie{Name: "refresh-token", Value: newToken(auth.TokenTypeAccess, time.Now().Add(time.Hour))},
+			wantErr: true,
+		},
+		{
+			name:    "no refresh token",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tt.cookie != nil {
+				req.AddCookie(tt.cookie)
+			}
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			res, err := authService.RefreshTokens(c)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, auth.ErrInvalidRefreshToken)
+				assert.Empty(t, rec.Header().Values("Set-Cookie"))
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, user, res)
+			assert.NotEmpty(t, rec.Header().Values("Set-Cookie"))
+		})
+	}
+}
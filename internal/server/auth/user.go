@@ -10,6 +10,16 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+// userClaims - claims токена с идентификатором пользователя
+type userClaims interface {
+	jwt.Claims
+	userID() uint
+}
+
+func (c *Claims) userID() uint {
+	return c.ID
+}
+
 type AuthUser struct {
 	userRepository repositories.UserRepositoryInterface
 }
@@ -20,17 +30,22 @@ func NewAuthUser(userRepository repositories.UserRepositoryInterface) *AuthUser
 	}
 }
 
-func (aUser *AuthUser) getUserIDByCookie(c echo.Context, cookie *http.Cookie) (*uint, error) {
-	claims := &Claims{}
+// getUserIDByCookie - идентификатор пользователя из токена в cookie.
+// claims - &Claims{} или &RefreshClaims{}, токен другого типа отклоняется.
+func (aUser *AuthUser) getUserIDByCookie(c echo.Context, cookie *http.Cookie, claims userClaims) (*uint, error) {
 	_, err := jwt.ParseWithClaims(cookie.Value, claims, func(token *jwt.Token) (interface{}, error) {
 		return []byte(GetJWTSecret()), nil
-	})
-	if errors.Is(err, jwt.ErrSignatureInvalid) {
-		c.Logger().Error(err)
+	}, jwt.WithValidMethods([]string{GetSigningMethod().Alg()}))
+	if err != nil {
+		if errors.Is(err, jwt.ErrSignatureInvalid) || errors.Is(err, ErrWrongTokenType) {
+			c.Logger().Error(err)
+		}
 		return nil, err
 	}
 
-	return &claims.ID, nil
+	id := claims.userID()
+
+	return &id, nil
 }
 
 func (aUser *AuthUser) getUserByID(c echo.Context, id uint) *responses.UserInfo {
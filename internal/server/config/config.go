@@ -1,10 +1,13 @@
 package config
 
 import (
+	"crypto/tls"
 	"flag"
+	"fmt"
 	"os"
 	"path"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/joho/godotenv"
@@ -18,10 +21,49 @@ type Config struct {
 	LogLevel     log.Lvl `env:"LOG_LEVEL"`
 	LogPath      string  `env:"LOG_PATH"`
 	EnableHTTPS  bool    `env:"ENABLE_HTTPS"`
+	// Сертификат и ключ сервера, минимальная версия TLS и CA клиентских сертификатов (mTLS)
+	TLSCert       string `env:"TLS_CERT"`
+	TLSKey        string `env:"TLS_KEY"`
+	TLSMinVersion uint16 `env:"TLS_MIN_VERSION"`
+	TLSClientCA   string `env:"TLS_CLIENT_CA"`
+	// Лимиты пользователя по умолчанию, 0 - без ограничений
+	QuotaMaxItems    int64    `env:"QUOTA_MAX_ITEMS"`
+	QuotaMaxBytes    int64    `env:"QUOTA_MAX_BYTES"`
+	QuotaMaxItemSize int64    `env:"QUOTA_MAX_ITEM_SIZE"`
+	AdminLogins      []string `env:"ADMIN_LOGINS"`
 }
 
+// Настройки TLS по умолчанию
+const (
+	defaultTLSCert = "ssl/localhost.crt"
+	defaultTLSKey  = "ssl/device.key"
+)
+
+// tlsVersions - поддерживаемые значения TLS_MIN_VERSION
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+// Лимиты по умолчанию, если они не заданы в окружении
+const (
+	defaultQuotaMaxItems    = 10000
+	defaultQuotaMaxBytes    = 100 << 20
+	defaultQuotaMaxItemSize = 1 << 20
+)
+
+// bodyOverhead - запас на поля запроса помимо значения записи
+const bodyOverhead = 64 << 10
+
 func NewConfig() (*Config, error) {
-	config := &Config{}
+	config := &Config{
+		TLSCert:          defaultTLSCert,
+		TLSKey:           defaultTLSKey,
+		TLSMinVersion:    tls.VersionTLS12,
+		QuotaMaxItems:    defaultQuotaMaxItems,
+		QuotaMaxBytes:    defaultQuotaMaxBytes,
+		QuotaMaxItemSize: defaultQuotaMaxItemSize,
+	}
 
 	currentDir, _ := os.Getwd()
 	paths := strings.Split(currentDir, "/")
@@ -87,6 +129,51 @@ func NewConfig() (*Config, error) {
 		config.EnableHTTPS = enableHTTPS == "1"
 	}
 
+	for env, value := range map[string]*string{
+		"TLS_CERT":      &config.TLSCert,
+		"TLS_KEY":       &config.TLSKey,
+		"TLS_CLIENT_CA": &config.TLSClientCA,
+	} {
+		if envValue, exists := os.LookupEnv(env); exists {
+			*value = envValue
+		}
+	}
+
+	tlsMinVersion, exists := os.LookupEnv("TLS_MIN_VERSION")
+	if exists {
+		version, ok := tlsVersions[tlsMinVersion]
+		if !ok {
+			return nil, fmt.Errorf("parse TLS_MIN_VERSION: unsupported version %q, use 1.2 or 1.3", tlsMinVersion)
+		}
+		config.TLSMinVersion = version
+	}
+
+	for env, value := range map[string]*int64{
+		"QUOTA_MAX_ITEMS":     &config.QuotaMaxItems,
+		"QUOTA_MAX_BYTES":     &config.QuotaMaxBytes,
+		"QUOTA_MAX_ITEM_SIZE": &config.QuotaMaxItemSize,
+	} {
+		envValue, exists := os.LookupEnv(env)
+		if !exists {
+			continue
+		}
+
+		quota, err := strconv.ParseInt(envValue, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", env, err)
+		}
+		*value = quota
+	}
+
+	adminLogins, exists := os.LookupEnv("ADMIN_LOGINS")
+	if exists {
+		for _, login := range strings.Split(adminLogins, ",") {
+			if login = strings.TrimSpace(login); login != "" {
+				config.AdminLogins = append(config.AdminLogins, login)
+			}
+		}
+	}
+
 	switch strings.ToUpper(logLevel) {
 	case "DEBUG":
 		config.LogLevel = log.DEBUG
@@ -102,3 +189,30 @@ func NewConfig() (*Config, error) {
 
 	return config, nil
 }
+
+// BodyLimit - максимальный размер тела запроса без записей пользователя, 0 - без ограничения.
+// Записи ограничиваются по лимитам пользователя: ItemBodyLimit и BatchBodyLimit.
+func (c *Config) BodyLimit() int64 {
+	return ItemBodyLimit(c.QuotaMaxItemSize)
+}
+
+// ItemBodyLimit - максимальный размер тела запроса с одной записью при лимите записи
+// maxItemSize, 0 - без ограничения. Значение в base64 и экранирование JSON увеличивают
+// запись не более чем вдвое.
+func ItemBodyLimit(maxItemSize int64) int64 {
+	if maxItemSize <= 0 {
+		return 0
+	}
+
+	return 2*maxItemSize + bodyOverhead
+}
+
+// BatchBodyLimit - максимальный размер тела пакетного запроса, 0 - без ограничения.
+// Пакет не может быть больше квоты пользователя по объему, но не меньше одной записи.
+func BatchBodyLimit(maxBytes int64, maxItemSize int64) int64 {
+	if maxBytes <= 0 || maxItemSize <= 0 {
+		return 0
+	}
+
+	return max(2*maxBytes+bodyOverhead, ItemBodyLimit(maxItemSize))
+}
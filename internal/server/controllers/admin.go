@@ -0,0 +1,156 @@
+package controllers
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/apierror"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/auth"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/config"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/quota"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/repositories"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type AdminController struct {
+	conf            *config.Config
+	authService     auth.AuthServiceInterface
+	userRepository  repositories.UserRepositoryInterface
+	quotaRepository repositories.QuotaRepositoryInterface
+	quotaService    quota.QuotaServiceInterface
+}
+
+func NewAdminController(
+	conf *config.Config,
+	authService auth.AuthServiceInterface,
+	userRepository repositories.UserRepositoryInterface,
+	quotaRepository repositories.QuotaRepositoryInterface,
+	quotaService quota.QuotaServiceInterface,
+) *AdminController {
+	return &AdminController{
+		conf:            conf,
+		authService:     authService,
+		userRepository:  userRepository,
+		quotaRepository: quotaRepository,
+		quotaService:    quotaService,
+	}
+}
+
+// AdminOnly - middleware, пропускающий только администраторов (ADMIN_LOGINS)
+func (controller *AdminController) AdminOnly() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			user, err := controller.userRepository.Find(controller.authService.GetUserID(c))
+			if err != nil {
+				return apierror.Internal(err)
+			}
+
+			if user == nil || user.ID == 0 || !slices.Contains(controller.conf.AdminLogins, user.Login) {
+				return apierror.New(http.StatusForbidden, models.ErrorCodeForbidden, "forbidden")
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// findUserID - получить идентификатор существующего пользователя из пути
+func (controller *AdminController) findUserID(c echo.Context) (uint, error) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil || id <= 0 {
+		return 0, apierror.BadRequest(err)
+	}
+
+	user, err := controller.userRepository.Find(uint(id))
+	if err != nil {
+		return 0, apierror.Internal(err)
+	}
+	if user == nil || user.ID == 0 {
+		return 0, apierror.NotFound(nil)
+	}
+
+	return user.ID, nil
+}
+
+// AdminUserQuota
+// @Title AdminUserQuota
+// @Description Использование хранилища и действующие лимиты пользователя
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path number true "id"
+// @Success 200 {object} models.UserUsage
+// @Failure 400 "BadRequest"
+// @Failure 401 "Unauthorized"
+// @Failure 403 "Forbidden"
+// @Failure 404 "Not found"
+// @Failure 500 "Internal server error"
+// @Router /admin/users/{id}/quota [get]
+func (controller *AdminController) AdminUserQuota() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		userID, err := controller.findUserID(c)
+		if err != nil {
+			return err
+		}
+
+		usage, err := controller.quotaService.Usage(userID)
+		if err != nil {
+			return apierror.Internal(err)
+		}
+
+		return c.JSON(http.StatusOK, usage)
+	}
+}
+
+// AdminUpdateUserQuota
+// @Title AdminUpdateUserQuota
+// @Description Задать индивидуальные лимиты пользователя, пустое значение - лимит по умолчанию
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param id path number true "id"
+// @Param data body requests.UserQuota true "data"
+// @Success 200 {object} models.UserUsage
+// @Failure 400 "BadRequest"
+// @Failure 401 "Unauthorized"
+// @Failure 403 "Forbidden"
+// @Failure 404 "Not found"
+// @Failure 500 "Internal server error"
+// @Router /admin/users/{id}/quota [put]
+func (controller *AdminController) AdminUpdateUserQuota() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var userQuota commonRequests.UserQuota
+		err := c.Bind(&userQuota)
+		if err != nil {
+			c.Logger().Error(err)
+			return apierror.BadRequest(err)
+		}
+
+		validate := validator.New(validator.WithRequiredStructEnabled())
+		err = validate.Struct(userQuota)
+		if err != nil {
+			return apierror.Validation(err)
+		}
+
+		userID, err := controller.findUserID(c)
+		if err != nil {
+			return err
+		}
+
+		_, err = controller.quotaRepository.SaveQuota(userID, userQuota)
+		if err != nil {
+			return apierror.Internal(err)
+		}
+
+		usage, err := controller.quotaService.Usage(userID)
+		if err != nil {
+			return apierror.Internal(err)
+		}
+
+		return c.JSON(http.StatusOK, usage)
+	}
+}
@@ -5,29 +5,109 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
 	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
-	"github.com/ShukinDmitriy/GophKeeper/internal/helpers"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/apierror"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/auth"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/quota"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/repositories"
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
 )
 
 type DataController struct {
 	authService    auth.AuthServiceInterface
 	dataRepository repositories.DataRepositoryInterface
+	quotaService   quota.QuotaServiceInterface
 }
 
 func NewDataController(
 	authService auth.AuthServiceInterface,
 	dataRepository repositories.DataRepositoryInterface,
+	quotaService quota.QuotaServiceInterface,
 ) *DataController {
 	return &DataController{
 		authService:    authService,
 		dataRepository: dataRepository,
+		quotaService:   quotaService,
 	}
 }
 
+// quotaError - ошибка API для ошибки записи: превышение квоты - 413/507, остальные ошибки - 500
+func quotaError(err error) error {
+	switch {
+	case errors.Is(err, quota.ErrItemTooLarge):
+		return apierror.New(http.StatusRequestEntityTooLarge, models.ErrorCodeItemTooLarge, err.Error())
+	case errors.Is(err, quota.ErrQuotaExceeded):
+		return apierror.New(http.StatusInsufficientStorage, models.ErrorCodeQuotaExceeded, err.Error())
+	}
+
+	return apierror.Internal(err)
+}
+
+// BodyLimit - middleware, ограничивающий тело запроса на запись по лимитам пользователя.
+// Индивидуальный лимит записи может быть больше общего, поэтому ограничение применяется
+// после аутентификации. batch - пакетный запрос.
+func (controller *DataController) BodyLimit(batch bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			limit, err := controller.quotaService.BodyLimit(controller.authService.GetUserID(c), batch)
+			if err != nil {
+				return apierror.Internal(err)
+			}
+			if limit <= 0 {
+				return next(c)
+			}
+
+			return middleware.BodyLimit(strconv.FormatInt(limit, 10))(next)(c)
+		}
+	}
+}
+
+// batchStatuses - статус успешной операции пакета, как у соответствующего одиночного запроса
+var batchStatuses = map[models.DataBatchOperationType]int{
+	models.DataBatchCreate: http.StatusCreated,
+	models.DataBatchUpdate: http.StatusOK,
+	models.DataBatchDelete: http.StatusAccepted,
+}
+
+// batchResult - результат операции пакета для ответа: ошибка операции переводится
+// в статус и код так же, как для одиночного запроса
+func batchResult(c echo.Context, index int, result repositories.BatchResult) models.DataBatchResult {
+	dataBatchResult := models.DataBatchResult{
+		Index:  index,
+		Op:     result.Op,
+		ID:     result.ID,
+		Status: batchStatuses[result.Op],
+		Data:   result.Data,
+	}
+	if result.Err == nil {
+		return dataBatchResult
+	}
+
+	var apiErr *apierror.Error
+	errNotFound := &repositories.NotFoundError{}
+	switch {
+	case errors.As(result.Err, &errNotFound):
+		apiErr = apierror.NotFound(result.Err)
+	case errors.Is(result.Err, repositories.ErrBatchRolledBack):
+		apiErr = apierror.New(http.StatusFailedDependency, models.ErrorCodeBatchRolledBack, result.Err.Error())
+	default:
+		apiErr = apierror.From(result.Err)
+	}
+	if apiErr.Status >= http.StatusInternalServerError {
+		apierror.Log(c, result.Err)
+	}
+
+	dataBatchResult.Status = apiErr.Status
+	dataBatchResult.Code = apiErr.Code
+	dataBatchResult.Fields = apiErr.Fields
+	dataBatchResult.Data = nil
+
+	return dataBatchResult
+}
+
 // DataIndex
 // @Title DataIndex
 // @Description Получение списка данных
@@ -46,7 +126,7 @@ func (controller *DataController) DataIndex() echo.HandlerFunc {
 		err := c.Bind(&dataListRequest)
 		if err != nil {
 			c.Logger().Error(err)
-			return c.JSON(http.StatusBadRequest, nil)
+			return apierror.BadRequest(err)
 		}
 
 		dataListRequest.UserID = controller.authService.GetUserID(c)
@@ -54,13 +134,50 @@ func (controller *DataController) DataIndex() echo.HandlerFunc {
 		validate := validator.New(validator.WithRequiredStructEnabled())
 		err = validate.Struct(dataListRequest)
 		if err != nil {
-			return c.JSON(http.StatusBadRequest, helpers.ExtractErrors(err))
+			return apierror.Validation(err)
 		}
 
 		dataInfos, err := controller.dataRepository.List(dataListRequest)
+		if err != nil {
+			return apierror.Internal(err)
+		}
+
+		return c.JSON(http.StatusOK, dataInfos)
+	}
+}
+
+// DataExpiring
+// @Title DataExpiring
+// @Description Получение списка данных, которые истекли или истекут в ближайшие days дней
+// @Tags Data
+// @Accept json
+// @Produce json
+// @Param data query requests.DataExpiring true "data"
+// @Success 200 {array} []models.DataInfo
+// @Failure 400 "BadRequest"
+// @Failure 401 "Unauthorized"
+// @Failure 500 "Internal server error"
+// @Router /data/expiring [get]
+func (controller *DataController) DataExpiring() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var dataExpiringRequest commonRequests.DataExpiring
+		err := c.Bind(&dataExpiringRequest)
 		if err != nil {
 			c.Logger().Error(err)
-			return c.JSON(http.StatusInternalServerError, "internal GophKeeper error")
+			return apierror.BadRequest(err)
+		}
+
+		dataExpiringRequest.UserID = controller.authService.GetUserID(c)
+
+		validate := validator.New(validator.WithRequiredStructEnabled())
+		err = validate.Struct(dataExpiringRequest)
+		if err != nil {
+			return apierror.Validation(err)
+		}
+
+		dataInfos, err := controller.dataRepository.Expiring(dataExpiringRequest)
+		if err != nil {
+			return apierror.Internal(err)
 		}
 
 		return c.JSON(http.StatusOK, dataInfos)
@@ -77,7 +194,9 @@ func (controller *DataController) DataIndex() echo.HandlerFunc {
 // @Success 201 {object} models.DataInfo
 // @Failure 400 "BadRequest"
 // @Failure 401 "Unauthorized"
+// @Failure 413 "Item too large"
 // @Failure 500 "Internal server error"
+// @Failure 507 "Quota exceeded"
 // @Router /data [post]
 func (controller *DataController) DataCreate() echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -85,7 +204,7 @@ func (controller *DataController) DataCreate() echo.HandlerFunc {
 		err := c.Bind(&dataModel)
 		if err != nil {
 			c.Logger().Error(err)
-			return c.JSON(http.StatusBadRequest, nil)
+			return apierror.BadRequest(err)
 		}
 
 		dataModel.UserID = controller.authService.GetUserID(c)
@@ -93,13 +212,13 @@ func (controller *DataController) DataCreate() echo.HandlerFunc {
 		validate := validator.New(validator.WithRequiredStructEnabled())
 		err = validate.Struct(dataModel)
 		if err != nil {
-			return c.JSON(http.StatusBadRequest, helpers.ExtractErrors(err))
+			return apierror.Validation(err)
 		}
 
-		dataInfo, err := controller.dataRepository.Create(dataModel)
+		guard := controller.quotaService.Guard(dataModel.UserID, []quota.Change{{Size: dataModel.Size()}})
+		dataInfo, err := controller.dataRepository.Create(dataModel, guard)
 		if err != nil {
-			c.Logger().Error(err)
-			return c.JSON(http.StatusInternalServerError, "internal GophKeeper error")
+			return quotaError(err)
 		}
 
 		return c.JSON(http.StatusCreated, dataInfo)
@@ -123,7 +242,7 @@ func (controller *DataController) DataRead() echo.HandlerFunc {
 		id, err := strconv.Atoi(c.Param("id"))
 		if err != nil {
 			c.Logger().Error(err)
-			return c.JSON(http.StatusBadRequest, nil)
+			return apierror.BadRequest(err)
 		}
 		userID := controller.authService.GetUserID(c)
 
@@ -131,11 +250,10 @@ func (controller *DataController) DataRead() echo.HandlerFunc {
 		if err != nil {
 			errNotFound := &repositories.NotFoundError{}
 			if errors.As(err, &errNotFound) {
-				return c.JSON(http.StatusNotFound, "not found")
+				return apierror.NotFound(err)
 			}
 
-			c.Logger().Error(err)
-			return c.JSON(http.StatusInternalServerError, "internal GophKeeper error")
+			return apierror.Internal(err)
 		}
 
 		return c.JSON(http.StatusOK, dataInfo)
@@ -153,7 +271,9 @@ func (controller *DataController) DataRead() echo.HandlerFunc {
 // @Success 200 {object} models.DataInfo
 // @Failure 400 "BadRequest"
 // @Failure 401 "Unauthorized"
+// @Failure 413 "Item too large"
 // @Failure 500 "Internal server error"
+// @Failure 507 "Quota exceeded"
 // @Router /data/{id} [put]
 func (controller *DataController) DataUpdate() echo.HandlerFunc {
 	return func(c echo.Context) error {
@@ -161,26 +281,140 @@ func (controller *DataController) DataUpdate() echo.HandlerFunc {
 		err := c.Bind(&dataModel)
 		if err != nil {
 			c.Logger().Error(err)
-			return c.JSON(http.StatusBadRequest, nil)
+			return apierror.BadRequest(err)
 		}
 
 		id, err := strconv.Atoi(c.Param("id"))
 		if err != nil {
 			c.Logger().Error(err)
-			return c.JSON(http.StatusBadRequest, nil)
+			return apierror.BadRequest(err)
 		}
 		dataModel.UserID = controller.authService.GetUserID(c)
 
-		dataInfo, err := controller.dataRepository.Update(uint(id), dataModel)
+		validate := validator.New(validator.WithRequiredStructEnabled())
+		err = validate.Struct(dataModel)
 		if err != nil {
-			c.Logger().Error(err)
-			return c.JSON(http.StatusInternalServerError, "internal GophKeeper error")
+			return apierror.Validation(err)
+		}
+
+		guard := controller.quotaService.Guard(dataModel.UserID, []quota.Change{{ID: uint(id), Size: dataModel.Size()}})
+		dataInfo, err := controller.dataRepository.Update(uint(id), dataModel, guard)
+		if err != nil {
+			return quotaError(err)
 		}
 
 		return c.JSON(http.StatusOK, dataInfo)
 	}
 }
 
+// DataBatch
+// @Title DataBatch
+// @Description Выполнить пакет операций создания, изменения и удаления данных в одной транзакции.
+// @Description В атомарном режиме (atomic) ошибка любой операции откатывает весь пакет.
+// @Tags Data
+// @Accept json
+// @Produce json
+// @Param data body requests.DataBatch true "data"
+// @Success 200 {array} models.DataBatchResult "Все операции выполнены"
+// @Success 207 {array} models.DataBatchResult "Часть операций завершилась ошибкой"
+// @Failure 400 "BadRequest"
+// @Failure 401 "Unauthorized"
+// @Failure 413 "Item too large"
+// @Failure 422 {array} models.DataBatchResult "Пакет откачен"
+// @Failure 500 "Internal server error"
+// @Failure 507 "Quota exceeded"
+// @Router /data/batch [post]
+func (controller *DataController) DataBatch() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		var dataBatch commonRequests.DataBatch
+		err := c.Bind(&dataBatch)
+		if err != nil {
+			c.Logger().Error(err)
+			return apierror.BadRequest(err)
+		}
+
+		dataBatch.UserID = controller.authService.GetUserID(c)
+
+		validate := validator.New(validator.WithRequiredStructEnabled())
+		commonRequests.RegisterValidations(validate)
+		err = validate.Struct(dataBatch)
+		if err != nil {
+			return apierror.Validation(err)
+		}
+
+		// Некорректные операции не отправляются в БД, их результат сразу 400
+		results := make([]models.DataBatchResult, len(dataBatch.Operations))
+		valid := make([]commonRequests.DataBatchOperation, 0, len(dataBatch.Operations))
+		validIndexes := make([]int, 0, len(dataBatch.Operations))
+		for i, operation := range dataBatch.Operations {
+			if operation.Data != nil {
+				operation.Data.UserID = dataBatch.UserID
+			}
+
+			err = validate.Struct(operation)
+			if err != nil {
+				results[i] = batchResult(c, i, repositories.BatchResult{
+					Op:  operation.Op,
+					ID:  operation.ID,
+					Err: apierror.Validation(err),
+				})
+				continue
+			}
+
+			valid = append(valid, operation)
+			validIndexes = append(validIndexes, i)
+		}
+
+		if len(valid) < len(dataBatch.Operations) && dataBatch.Atomic {
+			for i, operation := range dataBatch.Operations {
+				if results[i].Status == 0 {
+					results[i] = batchResult(c, i, repositories.BatchResult{
+						Op:  operation.Op,
+						ID:  operation.ID,
+						Err: repositories.ErrBatchRolledBack,
+					})
+				}
+			}
+
+			return c.JSON(http.StatusUnprocessableEntity, results)
+		}
+
+		changes := make([]quota.Change, 0, len(valid))
+		for _, operation := range valid {
+			change := quota.Change{ID: operation.ID, Delete: operation.Op == models.DataBatchDelete}
+			if operation.Op == models.DataBatchCreate {
+				change.ID = 0
+			}
+			if operation.Data != nil && !change.Delete {
+				change.Size = operation.Data.Size()
+			}
+			changes = append(changes, change)
+		}
+		dataBatch.Operations = valid
+		batchResults, err := controller.dataRepository.Batch(dataBatch, controller.quotaService.Guard(dataBatch.UserID, changes))
+		if err != nil && !errors.Is(err, repositories.ErrBatchRolledBack) {
+			return quotaError(err)
+		}
+
+		failed := len(dataBatch.Operations) < len(results)
+		for i, result := range batchResults {
+			results[validIndexes[i]] = batchResult(c, validIndexes[i], result)
+			if result.Err != nil {
+				failed = true
+			}
+		}
+
+		switch {
+		case errors.Is(err, repositories.ErrBatchRolledBack):
+			return c.JSON(http.StatusUnprocessableEntity, results)
+		case failed:
+			return c.JSON(http.StatusMultiStatus, results)
+		}
+
+		return c.JSON(http.StatusOK, results)
+	}
+}
+
 // DataDelete
 // @Title DataDelete
 // @Description Удалить данные
@@ -197,14 +431,13 @@ func (controller *DataController) DataDelete() echo.HandlerFunc {
 		id, err := strconv.Atoi(c.Param("id"))
 		if err != nil {
 			c.Logger().Error(err)
-			return c.JSON(http.StatusBadRequest, nil)
+			return apierror.BadRequest(err)
 		}
 		userID := controller.authService.GetUserID(c)
 
 		err = controller.dataRepository.Delete(uint(id), userID)
 		if err != nil {
-			c.Logger().Error(err)
-			return c.JSON(http.StatusInternalServerError, "internal GophKeeper error")
+			return apierror.Internal(err)
 		}
 
 		return c.JSON(http.StatusAccepted, http.NoBody)
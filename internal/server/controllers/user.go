@@ -1,14 +1,17 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
 	commonRequests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
 
-	"github.com/ShukinDmitriy/GophKeeper/internal/helpers"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/apierror"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/auth"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/models/data"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/models/responses"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/quota"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/repositories"
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
@@ -18,15 +21,18 @@ import (
 type UserController struct {
 	authService    auth.AuthServiceInterface
 	userRepository repositories.UserRepositoryInterface
+	quotaService   quota.QuotaServiceInterface
 }
 
 func NewUserController(
 	authService auth.AuthServiceInterface,
 	userRepository repositories.UserRepositoryInterface,
+	quotaService quota.QuotaServiceInterface,
 ) *UserController {
 	return &UserController{
 		authService:    authService,
 		userRepository: userRepository,
+		quotaService:   quotaService,
 	}
 }
 
@@ -48,35 +54,32 @@ func (controller *UserController) UserRegister() echo.HandlerFunc {
 		err := c.Bind(&userRegisterRequest)
 		if err != nil {
 			c.Logger().Error(err)
-			return c.JSON(http.StatusBadRequest, nil)
+			return apierror.BadRequest(err)
 		}
 
 		validate := validator.New(validator.WithRequiredStructEnabled())
 		err = validate.Struct(userRegisterRequest)
 		if err != nil {
-			return c.JSON(http.StatusBadRequest, helpers.ExtractErrors(err))
+			return apierror.Validation(err)
 		}
 
 		existUser, err := controller.userRepository.FindBy(data.UserSearch{Login: userRegisterRequest.Login})
 		if err != nil {
-			c.Logger().Error(err)
-			return c.JSON(http.StatusInternalServerError, "internal GophKeeper error")
+			return apierror.Internal(err)
 		}
 		if existUser != nil {
 			c.Logger().Error("login already exist")
-			return c.JSON(http.StatusConflict, "login already exist")
+			return apierror.New(http.StatusConflict, models.ErrorCodeUserExists, "login already exist")
 		}
 
 		user, err := controller.userRepository.Create(userRegisterRequest)
 		if err != nil {
-			c.Logger().Error(err)
-			return c.JSON(http.StatusInternalServerError, "internal GophKeeper error")
+			return apierror.Internal(err)
 		}
 
 		err = controller.authService.GenerateTokensAndSetCookies(c, user)
 		if err != nil {
-			c.Logger().Error(err)
-			return c.JSON(http.StatusInternalServerError, "internal GophKeeper error")
+			return apierror.Internal(err)
 		}
 
 		return c.JSON(http.StatusOK, user)
@@ -101,28 +104,27 @@ func (controller *UserController) UserLogin() echo.HandlerFunc {
 		err := c.Bind(&userLoginRequest)
 		if err != nil {
 			c.Logger().Error(err)
-			return c.JSON(http.StatusBadRequest, nil)
+			return apierror.BadRequest(err)
 		}
 
 		validate := validator.New(validator.WithRequiredStructEnabled())
 		err = validate.Struct(userLoginRequest)
 		if err != nil {
-			return c.JSON(http.StatusBadRequest, helpers.ExtractErrors(err))
+			return apierror.Validation(err)
 		}
 
 		existUser, err := controller.userRepository.FindBy(data.UserSearch{Login: userLoginRequest.Login})
 		if err != nil {
-			c.Logger().Error(err)
-			return c.JSON(http.StatusInternalServerError, "internal GophKeeper error")
+			return apierror.Internal(err)
 		}
 		if existUser == nil {
 			c.Logger().Error("user not exist")
-			return c.JSON(http.StatusUnauthorized, "user not exist")
+			return apierror.New(http.StatusUnauthorized, models.ErrorCodeInvalidCredentials, "user not exist")
 		}
 
 		if bcrypt.CompareHashAndPassword([]byte(existUser.Password), []byte(userLoginRequest.Password)) != nil {
 			c.Logger().Error("invalid password")
-			return c.JSON(http.StatusUnauthorized, "invalid password")
+			return apierror.New(http.StatusUnauthorized, models.ErrorCodeInvalidCredentials, "invalid password")
 		}
 		existUser.Password = ""
 
@@ -131,10 +133,54 @@ func (controller *UserController) UserLogin() echo.HandlerFunc {
 			Login: existUser.Login,
 		})
 		if err != nil {
-			c.Logger().Error(err)
-			return c.JSON(http.StatusInternalServerError, "internal GophKeeper error")
+			return apierror.Internal(err)
 		}
 
 		return c.JSON(http.StatusOK, existUser)
 	}
 }
+
+// UserRefresh
+// @Title UserRefresh
+// @Description Обновление токенов по refresh токену
+// @Tags User
+// @Produce json
+// @Success 200 {object} responses.UserInfo
+// @Failure 401 "Unauthorized"
+// @Failure 500 "Internal server error"
+// @Router /user/refresh [post]
+func (controller *UserController) UserRefresh() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		user, err := controller.authService.RefreshTokens(c)
+		if errors.Is(err, auth.ErrInvalidRefreshToken) {
+			c.Logger().Error(err)
+			return apierror.New(http.StatusUnauthorized, models.ErrorCodeUnauthorized, "invalid refresh token")
+		}
+		if err != nil {
+			return apierror.Internal(err)
+		}
+
+		return c.JSON(http.StatusOK, user)
+	}
+}
+
+// UserUsage
+// @Title UserUsage
+// @Description Использование хранилища пользователем и его лимиты
+// @Tags User
+// @Accept json
+// @Produce json
+// @Success 200 {object} models.UserUsage
+// @Failure 401 "Unauthorized"
+// @Failure 500 "Internal server error"
+// @Router /user/usage [get]
+func (controller *UserController) UserUsage() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		usage, err := controller.quotaService.Usage(controller.authService.GetUserID(c))
+		if err != nil {
+			return apierror.Internal(err)
+		}
+
+		return c.JSON(http.StatusOK, usage)
+	}
+}
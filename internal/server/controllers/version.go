@@ -0,0 +1,27 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/version"
+	"github.com/labstack/echo/v4"
+)
+
+type VersionController struct{}
+
+func NewVersionController() *VersionController {
+	return &VersionController{}
+}
+
+// Version
+// @Title Version
+// @Description Версия сервера и API
+// @Tags Version
+// @Produce json
+// @Success 200 {object} version.Info
+// @Router /version [get]
+func (controller *VersionController) Version() echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return c.JSON(http.StatusOK, version.Get())
+	}
+}
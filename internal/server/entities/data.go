@@ -1,17 +1,26 @@
 package entities
 
 import (
+	"time"
+
 	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
 	"gorm.io/gorm"
 )
 
 type Data struct {
 	gorm.Model
-	UserID      uint            `gorm:"type:bigint;not null"`
-	Users       User            `gorm:"foreignKey:UserID;references:ID"`
-	Type        models.DataType `json:"type" gorm:"type:integer;not null"`
-	Value       string          `json:"value" gorm:"type:varchar;not null"`
-	Description string          `json:"description" gorm:"type:varchar"`
+	UserID      uint              `gorm:"type:bigint;not null"`
+	Users       User              `gorm:"foreignKey:UserID;references:ID"`
+	Type        models.DataType   `json:"type" gorm:"type:integer;not null"`
+	Value       string            `json:"value" gorm:"type:varchar;not null"`
+	Description string            `json:"description" gorm:"type:varchar"`
+	Fields      models.DataFields `json:"fields" gorm:"type:jsonb;not null;default:'[]'"`
+	URLs        models.DataURLs   `json:"urls" gorm:"column:urls;type:jsonb;not null;default:'[]'"`
+	ExpiresAt   *time.Time        `json:"expires_at" gorm:"type:timestamptz"`
+	RotateAt    *time.Time        `json:"rotate_at" gorm:"type:timestamptz"`
+	Size        int64             `json:"size" gorm:"type:bigint;not null;default:0"`
+	// PasswordChangedAt - время изменения значения, меняется только при новом value
+	PasswordChangedAt *time.Time `json:"password_changed_at" gorm:"type:timestamptz"`
 }
 
 func (d *Data) TableName() string {
@@ -0,0 +1,17 @@
+package entities
+
+import "time"
+
+type UserQuota struct {
+	UserID      uint   `gorm:"primaryKey;type:bigint"`
+	Users       User   `gorm:"foreignKey:UserID;references:ID"`
+	MaxItems    *int64 `json:"max_items" gorm:"type:bigint"`
+	MaxBytes    *int64 `json:"max_bytes" gorm:"type:bigint"`
+	MaxItemSize *int64 `json:"max_item_size" gorm:"type:bigint"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (q *UserQuota) TableName() string {
+	return "user_quotas"
+}
@@ -0,0 +1,8 @@
+package responses
+
+type UserQuota struct {
+	UserID      uint   `json:"user_id"`
+	MaxItems    *int64 `json:"max_items"`
+	MaxBytes    *int64 `json:"max_bytes"`
+	MaxItemSize *int64 `json:"max_item_size"`
+}
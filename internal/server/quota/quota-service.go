@@ -0,0 +1,164 @@
+package quota
+
+import (
+	"errors"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/config"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/repositories"
+)
+
+var (
+	// ErrItemTooLarge - размер записи превышает допустимый (413)
+	ErrItemTooLarge = errors.New("item too large")
+	// ErrQuotaExceeded - превышена квота пользователя (507)
+	ErrQuotaExceeded = errors.New("quota exceeded")
+)
+
+// Change - изменение данных пользователя, которое нужно проверить на соответствие квоте.
+// ID = 0 - создание записи, Delete - удаление записи ID, иначе изменение записи ID.
+type Change struct {
+	ID     uint
+	Size   int64
+	Delete bool
+}
+
+type QuotaService struct {
+	conf            *config.Config
+	quotaRepository repositories.QuotaRepositoryInterface
+}
+
+func NewQuotaService(
+	conf *config.Config,
+	quotaRepository repositories.QuotaRepositoryInterface,
+) *QuotaService {
+	return &QuotaService{
+		conf:            conf,
+		quotaRepository: quotaRepository,
+	}
+}
+
+// Usage - использование хранилища пользователем с учетом индивидуальных лимитов
+func (s *QuotaService) Usage(userID uint) (*models.UserUsage, error) {
+	return s.usage(s.quotaRepository, userID)
+}
+
+// BodyLimit - максимальный размер тела запроса на запись данных пользователя,
+// 0 - без ограничения. batch - пакетный запрос.
+func (s *QuotaService) BodyLimit(userID uint, batch bool) (int64, error) {
+	limits, err := s.limits(s.quotaRepository, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if batch {
+		return config.BatchBodyLimit(limits.MaxBytes, limits.MaxItemSize), nil
+	}
+
+	return config.ItemBodyLimit(limits.MaxItemSize), nil
+}
+
+// usage - использование хранилища по данным quotaRepository (соединения или транзакции)
+func (s *QuotaService) usage(quotaRepository repositories.QuotaRepositoryInterface, userID uint) (*models.UserUsage, error) {
+	usage, err := s.limits(quotaRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	usage.Items, usage.Bytes, err = quotaRepository.Usage(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// limits - лимиты пользователя: общие лимиты с учетом индивидуальных, без использования
+func (s *QuotaService) limits(quotaRepository repositories.QuotaRepositoryInterface, userID uint) (*models.UserUsage, error) {
+	usage := &models.UserUsage{
+		MaxItems:    s.conf.QuotaMaxItems,
+		MaxBytes:    s.conf.QuotaMaxBytes,
+		MaxItemSize: s.conf.QuotaMaxItemSize,
+	}
+
+	userQuota, err := quotaRepository.FindQuota(userID)
+	if err != nil {
+		return nil, err
+	}
+	if userQuota != nil {
+		if userQuota.MaxItems != nil {
+			usage.MaxItems = *userQuota.MaxItems
+		}
+		if userQuota.MaxBytes != nil {
+			usage.MaxBytes = *userQuota.MaxBytes
+		}
+		if userQuota.MaxItemSize != nil {
+			usage.MaxItemSize = *userQuota.MaxItemSize
+		}
+	}
+
+	return usage, nil
+}
+
+// Check - проверить, что после изменений пользователь не выйдет за лимиты.
+// Изменения, которые не увеличивают использование, разрешены даже при превышенной квоте.
+func (s *QuotaService) Check(userID uint, changes []Change) error {
+	return s.check(s.quotaRepository, userID, changes)
+}
+
+// Guard - проверка изменений для DataRepository, которая выполняется в транзакции записи.
+// В отличие от Check, параллельные запросы пользователя не могут вместе превысить лимиты.
+func (s *QuotaService) Guard(userID uint, changes []Change) repositories.QuotaGuard {
+	return func(quotaRepository repositories.QuotaRepositoryInterface) error {
+		return s.check(quotaRepository, userID, changes)
+	}
+}
+
+// check - проверить изменения по данным quotaRepository
+func (s *QuotaService) check(quotaRepository repositories.QuotaRepositoryInterface, userID uint, changes []Change) error {
+	usage, err := s.usage(quotaRepository, userID)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]uint, 0, len(changes))
+	for _, change := range changes {
+		if !change.Delete && usage.MaxItemSize > 0 && change.Size > usage.MaxItemSize {
+			return ErrItemTooLarge
+		}
+		if change.ID != 0 {
+			ids = append(ids, change.ID)
+		}
+	}
+
+	sizes, err := quotaRepository.Sizes(userID, ids)
+	if err != nil {
+		return err
+	}
+
+	items, bytes := usage.Items, usage.Bytes
+	for _, change := range changes {
+		oldSize, exists := sizes[change.ID]
+		switch {
+		case change.ID == 0:
+			items++
+			bytes += change.Size
+		case !exists:
+			// Запись не найдена, операция завершится ошибкой и квоту не изменит
+		case change.Delete:
+			items--
+			bytes -= oldSize
+			delete(sizes, change.ID)
+		default:
+			bytes += change.Size - oldSize
+			sizes[change.ID] = change.Size
+		}
+	}
+
+	if (usage.MaxItems > 0 && items > usage.MaxItems && items > usage.Items) ||
+		(usage.MaxBytes > 0 && bytes > usage.MaxBytes && bytes > usage.Bytes) {
+		return ErrQuotaExceeded
+	}
+
+	return nil
+}
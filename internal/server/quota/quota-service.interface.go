@@ -0,0 +1,13 @@
+package quota
+
+import (
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/repositories"
+)
+
+type QuotaServiceInterface interface {
+	Usage(userID uint) (*models.UserUsage, error)
+	BodyLimit(userID uint, batch bool) (int64, error)
+	Check(userID uint, changes []Change) error
+	Guard(userID uint, changes []Change) repositories.QuotaGuard
+}
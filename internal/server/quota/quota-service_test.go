@@ -0,0 +1,143 @@
+package quota_test
+
+import (
+	"testing"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/config"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/models/responses"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/quota"
+	mockRepositories "github.com/ShukinDmitriy/GophKeeper/mocks/internal_/server/repositories"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestQuotaServiceCheck(t *testing.T) {
+	conf := &config.Config{
+		QuotaMaxItems:    3,
+		QuotaMaxBytes:    100,
+		QuotaMaxItemSize: 50,
+	}
+	userMaxBytes := int64(1000)
+
+	tests := []struct {
+		name      string
+		userQuota *responses.UserQuota
+		items     int64
+		bytes     int64
+		sizes     map[uint]int64
+		changes   []quota.Change
+		wantErr   error
+	}{
+		{
+			name:    "create within quota",
+			items:   1,
+			bytes:   40,
+			changes: []quota.Change{{Size: 30}},
+		},
+		{
+			name:    "item too large",
+			changes: []quota.Change{{Size: 51}},
+			wantErr: quota.ErrItemTooLarge,
+		},
+		{
+			name:    "too many items",
+			items:   3,
+			bytes:   10,
+			changes: []quota.Change{{Size: 1}},
+			wantErr: quota.ErrQuotaExceeded,
+		},
+		{
+			name:    "too many bytes",
+			items:   1,
+			bytes:   80,
+			changes: []quota.Change{{Size: 30}},
+			wantErr: quota.ErrQuotaExceeded,
+		},
+		{
+			name:    "update replaces old size",
+			items:   2,
+			bytes:   90,
+			sizes:   map[uint]int64{7: 40},
+			changes: []quota.Change{{ID: 7, Size: 45}},
+		},
+		{
+			name:    "delete frees space for create",
+			items:   3,
+			bytes:   100,
+			sizes:   map[uint]int64{7: 40},
+			changes: []quota.Change{{ID: 7, Delete: true}, {Size: 40}},
+		},
+		{
+			name:    "shrinking is allowed over quota",
+			items:   5,
+			bytes:   500,
+			sizes:   map[uint]int64{7: 40},
+			changes: []quota.Change{{ID: 7, Size: 10}},
+		},
+		{
+			name:      "user override",
+			userQuota: &responses.UserQuota{MaxBytes: &userMaxBytes},
+			items:     1,
+			bytes:     900,
+			changes:   []quota.Change{{Size: 50}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			quotaRepository := mockRepositories.NewQuotaRepositoryInterface(t)
+			quotaRepository.EXPECT().FindQuota(uint(1)).Return(tt.userQuota, nil)
+			quotaRepository.EXPECT().Usage(uint(1)).Return(tt.items, tt.bytes, nil)
+			sizes := tt.sizes
+			if sizes == nil {
+				sizes = map[uint]int64{}
+			}
+			quotaRepository.EXPECT().Sizes(uint(1), mock.Anything).Return(sizes, nil).Maybe()
+
+			err := quota.NewQuotaService(conf, quotaRepository).Check(1, tt.changes)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestQuotaServiceGuard(t *testing.T) {
+	conf := &config.Config{
+		QuotaMaxItems: 2,
+	}
+
+	// Guard проверяет квоту по репозиторию транзакции, а не по репозиторию сервиса
+	serviceRepository := mockRepositories.NewQuotaRepositoryInterface(t)
+	txRepository := mockRepositories.NewQuotaRepositoryInterface(t)
+	txRepository.EXPECT().FindQuota(uint(1)).Return(nil, nil)
+	txRepository.EXPECT().Usage(uint(1)).Return(2, 0, nil)
+	txRepository.EXPECT().Sizes(uint(1), mock.Anything).Return(map[uint]int64{}, nil)
+
+	guard := quota.NewQuotaService(conf, serviceRepository).Guard(1, []quota.Change{{Size: 1}})
+	assert.ErrorIs(t, guard(txRepository), quota.ErrQuotaExceeded)
+}
+
+func TestQuotaServiceBodyLimit(t *testing.T) {
+	conf := &config.Config{
+		QuotaMaxBytes:    1 << 20,
+		QuotaMaxItemSize: 1 << 10,
+	}
+	userMaxItemSize := int64(4 << 20)
+
+	quotaRepository := mockRepositories.NewQuotaRepositoryInterface(t)
+	quotaRepository.EXPECT().FindQuota(uint(1)).Return(nil, nil)
+	quotaRepository.EXPECT().FindQuota(uint(2)).Return(&responses.UserQuota{MaxItemSize: &userMaxItemSize}, nil)
+	quotaService := quota.NewQuotaService(conf, quotaRepository)
+
+	limit, err := quotaService.BodyLimit(1, false)
+	assert.NoError(t, err)
+	assert.Equal(t, conf.BodyLimit(), limit)
+
+	// Индивидуальный лимит записи больше общего расширяет и лимит тела запроса
+	limit, err = quotaService.BodyLimit(2, false)
+	assert.NoError(t, err)
+	assert.Equal(t, config.ItemBodyLimit(userMaxItemSize), limit)
+	assert.Greater(t, limit, 2*userMaxItemSize)
+
+	limit, err = quotaService.BodyLimit(2, true)
+	assert.NoError(t, err)
+	assert.Equal(t, config.ItemBodyLimit(userMaxItemSize), limit)
+}
@@ -1,6 +1,8 @@
 package repositories
 
 import (
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
@@ -11,6 +13,19 @@ import (
 	"gorm.io/gorm"
 )
 
+// dataInfoColumns - колонки записи, которые отдаются клиенту
+const dataInfoColumns = `
+	   datas.id          as id,
+	   datas.type        as type,
+	   datas.value       as value,
+	   datas.description as description,
+	   datas.fields      as fields,
+	   datas.urls        as urls,
+	   datas.expires_at  as expires_at,
+	   datas.rotate_at   as rotate_at,
+	   datas.updated_at  as updated_at,
+	   datas.password_changed_at as password_changed_at`
+
 type DataRepository struct {
 	db *gorm.DB
 }
@@ -24,11 +39,7 @@ func NewDataRepository(db *gorm.DB) *DataRepository {
 func (r *DataRepository) List(request requests.DataList) ([]*models.DataInfo, error) {
 	var dataInfos []*models.DataInfo
 
-	query := r.db.Table((&entities.Data{}).TableName()).Select(`
-		   datas.id          as id,
-		   datas.type        as type,
-		   datas.value       as value,
-		   datas.description as description`).
+	query := r.db.Table((&entities.Data{}).TableName()).Select(dataInfoColumns).
 		Where("datas.deleted_at IS NULL").
 		Order("id ASC")
 
@@ -45,19 +56,59 @@ func (r *DataRepository) List(request requests.DataList) ([]*models.DataInfo, er
 	return dataInfos, err
 }
 
-func (r *DataRepository) Create(dataCreate requests.DataModel) (*models.DataInfo, error) {
+// guarded - выполнить запись в транзакции. Если задан guard, перед записью блокируется
+// строка квоты пользователя и проверяется квота, ошибка guard возвращается как есть.
+func (r *DataRepository) guarded(userID uint, guard QuotaGuard, write func(tx *gorm.DB) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if guard != nil {
+			quotaRepository := NewQuotaRepository(tx)
+			if err := quotaRepository.Lock(userID); err != nil {
+				return err
+			}
+			if err := guard(quotaRepository); err != nil {
+				return err
+			}
+		}
+
+		return write(tx)
+	})
+}
+
+// Create - создать запись, квота проверяется guard в той же транзакции
+func (r *DataRepository) Create(dataCreate requests.DataModel, guard QuotaGuard) (*models.DataInfo, error) {
+	var dataInfo *models.DataInfo
+	err := r.guarded(dataCreate.UserID, guard, func(tx *gorm.DB) error {
+		var err error
+		dataInfo, err = r.create(tx, dataCreate)
+
+		return err
+	})
+
+	return dataInfo, err
+}
+
+// create - создать запись в рамках db (соединения или транзакции)
+func (r *DataRepository) create(db *gorm.DB, dataCreate requests.DataModel) (*models.DataInfo, error) {
+	now := time.Now()
 	data := &entities.Data{
 		UserID:      dataCreate.UserID,
 		Type:        dataCreate.Type,
 		Description: dataCreate.Description,
 		Value:       dataCreate.Value,
+		Fields:      dataCreate.Fields,
+		URLs:        dataCreate.URLs,
+		ExpiresAt:   dataCreate.ExpiresAt,
+		RotateAt:    dataCreate.RotateAt,
+		Size:        dataCreate.Size(),
+
+		PasswordChangedAt: &now,
 	}
 
-	err := r.db.
+	err := db.
 		Model(data).
 		Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: "id"}},
-			DoUpdates: clause.AssignmentColumns([]string{"deleted_at", "value", "description"}),
+			DoUpdates: clause.AssignmentColumns([]string{"deleted_at", "value", "description", "fields", "urls", "expires_at", "rotate_at", "size", "password_changed_at"}),
 		}).
 		Create(data).Error
 	if err != nil {
@@ -69,6 +120,13 @@ func (r *DataRepository) Create(dataCreate requests.DataModel) (*models.DataInfo
 		Type:        data.Type,
 		Description: data.Description,
 		Value:       data.Value,
+		Fields:      data.Fields,
+		URLs:        data.URLs,
+		ExpiresAt:   data.ExpiresAt,
+		RotateAt:    data.RotateAt,
+		UpdatedAt:   data.UpdatedAt,
+
+		PasswordChangedAt: data.PasswordChangedAt,
 	}, nil
 }
 
@@ -80,7 +138,13 @@ func (r *DataRepository) Find(id uint, userID uint) (*models.DataInfo, error) {
 		Select(`id,
   					  type,
                       description,
-					  value`).
+					  value,
+					  fields,
+					  urls,
+					  expires_at,
+					  rotate_at,
+					  updated_at,
+					  password_changed_at`).
 		Where("id = ?", id).
 		Where("deleted_at is null").
 		Where("user_id = ?", userID).
@@ -98,18 +162,41 @@ func (r *DataRepository) Find(id uint, userID uint) (*models.DataInfo, error) {
 	return data, nil
 }
 
-func (r *DataRepository) Update(id uint, request requests.DataModel) (*models.DataInfo, error) {
+// Update - изменить запись, квота проверяется guard в той же транзакции
+func (r *DataRepository) Update(id uint, request requests.DataModel, guard QuotaGuard) (*models.DataInfo, error) {
+	var dataInfo *models.DataInfo
+	err := r.guarded(request.UserID, guard, func(tx *gorm.DB) error {
+		var err error
+		dataInfo, _, err = r.update(tx, id, request)
+
+		return err
+	})
+
+	return dataInfo, err
+}
+
+// update - изменить запись в рамках db, возвращает количество измененных строк.
+// Время смены пароля обновляется, только если изменилось значение записи.
+func (r *DataRepository) update(db *gorm.DB, id uint, request requests.DataModel) (*models.DataInfo, int64, error) {
+	now := time.Now()
 	newValues := map[string]interface{}{
-		"updated_at":  time.Now(),
+		"updated_at":  now,
 		"deleted_at":  nil,
 		"user_id":     request.UserID,
 		"type":        request.Type,
 		"description": request.Description,
 		"value":       request.Value,
+		"fields":      request.Fields,
+		"urls":        request.URLs,
+		"expires_at":  request.ExpiresAt,
+		"rotate_at":   request.RotateAt,
+		"size":        request.Size(),
+
+		"password_changed_at": gorm.Expr("CASE WHEN value IS DISTINCT FROM ? THEN ? ELSE password_changed_at END", request.Value, now),
 	}
 
 	data := &entities.Data{}
-	result := r.db.Model(data).
+	result := db.Model(data).
 		Where("id = ?", id).
 		Where("user_id = ?", request.UserID).
 		Clauses(clause.Returning{}).
@@ -120,14 +207,135 @@ func (r *DataRepository) Update(id uint, request requests.DataModel) (*models.Da
 		Type:        data.Type,
 		Description: data.Description,
 		Value:       data.Value,
-	}, result.Error
+		Fields:      data.Fields,
+		URLs:        data.URLs,
+		ExpiresAt:   data.ExpiresAt,
+		RotateAt:    data.RotateAt,
+		UpdatedAt:   data.UpdatedAt,
+
+		PasswordChangedAt: data.PasswordChangedAt,
+	}, result.RowsAffected, result.Error
+}
+
+// Expiring - записи пользователя, которые истекли или истекут либо которые нужно сменить
+// в ближайшие request.Days дней. Сначала идут записи с ближайшим сроком.
+func (r *DataRepository) Expiring(request requests.DataExpiring) ([]*models.DataInfo, error) {
+	var dataInfos []*models.DataInfo
+
+	deadline := time.Now().AddDate(0, 0, request.Days)
+
+	err := r.db.Table((&entities.Data{}).TableName()).Select(dataInfoColumns).
+		Where("datas.deleted_at IS NULL").
+		Where("datas.user_id=?", request.UserID).
+		Where("(datas.expires_at <= ? OR datas.rotate_at <= ?)", deadline, deadline).
+		Order("LEAST(datas.expires_at, datas.rotate_at) ASC, id ASC").
+		Find(&dataInfos).Error
+
+	return dataInfos, err
 }
 
 func (r *DataRepository) Delete(id uint, userId uint) error {
-	result := *r.db.
+	_, err := r.delete(r.db, id, userId)
+
+	return err
+}
+
+// delete - удалить запись в рамках db, возвращает количество удаленных строк
+func (r *DataRepository) delete(db *gorm.DB, id uint, userID uint) (int64, error) {
+	result := db.
 		Where("id = ?", id).
-		Where("user_id = ?", userId).
+		Where("user_id = ?", userID).
 		Delete(&entities.Data{})
 
-	return result.Error
+	return result.RowsAffected, result.Error
+}
+
+// BatchResult - результат операции пакета. Err - ошибка операции: NotFoundError,
+// ErrBatchRolledBack для операций, откаченных вместе с атомарным пакетом, или ошибка БД.
+type BatchResult struct {
+	Op   models.DataBatchOperationType
+	ID   uint
+	Data *models.DataInfo
+	Err  error
+}
+
+// Batch - выполнить пакет операций в одной транзакции.
+// В атомарном режиме ошибка любой операции откатывает весь пакет и возвращается ErrBatchRolledBack,
+// иначе неудачная операция откатывается до точки сохранения и пакет продолжается.
+// Квота проверяется guard в той же транзакции до выполнения операций.
+func (r *DataRepository) Batch(request requests.DataBatch, guard QuotaGuard) ([]BatchResult, error) {
+	results := make([]BatchResult, len(request.Operations))
+
+	err := r.guarded(request.UserID, guard, func(tx *gorm.DB) error {
+		for i, operation := range request.Operations {
+			savePoint := fmt.Sprintf("batch_%d", i)
+			if !request.Atomic {
+				if err := tx.SavePoint(savePoint).Error; err != nil {
+					return err
+				}
+			}
+
+			results[i] = r.batchOperation(tx, operation, request.UserID)
+			if results[i].Err == nil {
+				continue
+			}
+
+			if request.Atomic {
+				return ErrBatchRolledBack
+			}
+
+			if err := tx.RollbackTo(savePoint).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if errors.Is(err, ErrBatchRolledBack) {
+		// Успешные и невыполненные операции тоже откатились
+		for i := range results {
+			if results[i].Err == nil {
+				results[i] = BatchResult{
+					Op:  request.Operations[i].Op,
+					ID:  request.Operations[i].ID,
+					Err: ErrBatchRolledBack,
+				}
+			}
+		}
+	}
+
+	return results, err
+}
+
+// batchOperation - выполнить одну операцию пакета
+func (r *DataRepository) batchOperation(tx *gorm.DB, operation requests.DataBatchOperation, userID uint) BatchResult {
+	result := BatchResult{
+		Op: operation.Op,
+		ID: operation.ID,
+	}
+
+	var rowsAffected int64
+	switch operation.Op {
+	case models.DataBatchCreate:
+		result.Data, result.Err = r.create(tx, *operation.Data)
+	case models.DataBatchUpdate:
+		result.Data, rowsAffected, result.Err = r.update(tx, operation.ID, *operation.Data)
+		if result.Err == nil && rowsAffected == 0 {
+			result.Err = &NotFoundError{err: errNotFound}
+		}
+	case models.DataBatchDelete:
+		rowsAffected, result.Err = r.delete(tx, operation.ID, userID)
+		if result.Err == nil && rowsAffected == 0 {
+			result.Err = &NotFoundError{err: errNotFound}
+		}
+	}
+
+	if result.Err != nil {
+		result.Data = nil
+	} else if result.Data != nil {
+		result.ID = result.Data.ID
+	}
+
+	return result
 }
@@ -7,8 +7,10 @@ import (
 
 type DataRepositoryInterface interface {
 	List(request requests.DataList) ([]*models.DataInfo, error)
-	Create(dataCreate requests.DataModel) (*models.DataInfo, error)
+	Create(dataCreate requests.DataModel, guard QuotaGuard) (*models.DataInfo, error)
 	Find(id uint, userID uint) (*models.DataInfo, error)
-	Update(id uint, request requests.DataModel) (*models.DataInfo, error)
+	Update(id uint, request requests.DataModel, guard QuotaGuard) (*models.DataInfo, error)
 	Delete(id uint, userID uint) error
+	Expiring(request requests.DataExpiring) ([]*models.DataInfo, error)
+	Batch(request requests.DataBatch, guard QuotaGuard) ([]BatchResult, error)
 }
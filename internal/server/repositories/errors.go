@@ -4,6 +4,9 @@ import "errors"
 
 var errNotFound = errors.New("not found")
 
+// ErrBatchRolledBack - пакет операций откачен из-за ошибки одной из операций
+var ErrBatchRolledBack = errors.New("batch rolled back")
+
 type NotFoundError struct {
 	err error
 }
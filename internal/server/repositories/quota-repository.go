@@ -0,0 +1,132 @@
+package repositories
+
+import (
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/entities"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/models/responses"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type QuotaRepository struct {
+	db *gorm.DB
+}
+
+func NewQuotaRepository(db *gorm.DB) *QuotaRepository {
+	return &QuotaRepository{
+		db: db,
+	}
+}
+
+// Lock - заблокировать строку квоты пользователя до конца транзакции (SELECT ... FOR UPDATE),
+// чтобы параллельные записи проверяли квоту по очереди. Если индивидуальных лимитов нет,
+// создается пустая строка: ее лимиты не заданы и действуют лимиты по умолчанию.
+func (r *QuotaRepository) Lock(userID uint) error {
+	err := r.db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoNothing: true,
+		}).
+		Omit("Users").
+		Create(&entities.UserQuota{UserID: userID}).Error
+	if err != nil {
+		return err
+	}
+
+	return r.db.
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ?", userID).
+		Take(&entities.UserQuota{}).Error
+}
+
+// Usage - количество записей пользователя и их суммарный размер
+func (r *QuotaRepository) Usage(userID uint) (int64, int64, error) {
+	usage := struct {
+		Items int64
+		Bytes int64
+	}{}
+
+	err := r.db.Table((&entities.Data{}).TableName()).
+		Select("count(*) as items, coalesce(sum(size), 0) as bytes").
+		Where("deleted_at IS NULL").
+		Where("user_id = ?", userID).
+		Scan(&usage).Error
+
+	return usage.Items, usage.Bytes, err
+}
+
+// Sizes - размеры существующих записей пользователя по идентификаторам
+func (r *QuotaRepository) Sizes(userID uint, ids []uint) (map[uint]int64, error) {
+	sizes := make(map[uint]int64, len(ids))
+	if len(ids) == 0 {
+		return sizes, nil
+	}
+
+	var rows []struct {
+		ID   uint
+		Size int64
+	}
+	err := r.db.Table((&entities.Data{}).TableName()).
+		Select("id, size").
+		Where("deleted_at IS NULL").
+		Where("user_id = ?", userID).
+		Where("id IN ?", ids).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		sizes[row.ID] = row.Size
+	}
+
+	return sizes, nil
+}
+
+// FindQuota - индивидуальные лимиты пользователя, nil если они не заданы
+func (r *QuotaRepository) FindQuota(userID uint) (*responses.UserQuota, error) {
+	quota := &entities.UserQuota{}
+
+	result := r.db.Where("user_id = ?", userID).Limit(1).Find(quota)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, nil
+	}
+
+	return &responses.UserQuota{
+		UserID:      quota.UserID,
+		MaxItems:    quota.MaxItems,
+		MaxBytes:    quota.MaxBytes,
+		MaxItemSize: quota.MaxItemSize,
+	}, nil
+}
+
+// SaveQuota - сохранить индивидуальные лимиты пользователя
+func (r *QuotaRepository) SaveQuota(userID uint, request requests.UserQuota) (*responses.UserQuota, error) {
+	quota := &entities.UserQuota{
+		UserID:      userID,
+		MaxItems:    request.MaxItems,
+		MaxBytes:    request.MaxBytes,
+		MaxItemSize: request.MaxItemSize,
+	}
+
+	err := r.db.
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"max_items", "max_bytes", "max_item_size", "updated_at"}),
+		}).
+		Omit("Users").
+		Create(quota).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &responses.UserQuota{
+		UserID:      quota.UserID,
+		MaxItems:    quota.MaxItems,
+		MaxBytes:    quota.MaxBytes,
+		MaxItemSize: quota.MaxItemSize,
+	}, nil
+}
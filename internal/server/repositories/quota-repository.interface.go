@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/models/responses"
+)
+
+// QuotaGuard - проверка квоты, которую репозиторий данных выполняет в транзакции записи
+// после блокировки строки квоты пользователя
+type QuotaGuard func(quotaRepository QuotaRepositoryInterface) error
+
+type QuotaRepositoryInterface interface {
+	Lock(userID uint) error
+	Usage(userID uint) (items int64, bytes int64, err error)
+	Sizes(userID uint, ids []uint) (map[uint]int64, error)
+	FindQuota(userID uint) (*responses.UserQuota, error)
+	SaveQuota(userID uint, quota requests.UserQuota) (*responses.UserQuota, error)
+}
@@ -1,9 +1,12 @@
 package server
 
 import (
+	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/ShukinDmitriy/GophKeeper/internal/common/router"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/apierror"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/auth"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/config"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/controllers"
@@ -19,11 +22,15 @@ func NewHTTPServer(
 	authService *auth.AuthService,
 	userController *controllers.UserController,
 	dataController *controllers.DataController,
+	adminController *controllers.AdminController,
+	versionController *controllers.VersionController,
 ) *echo.Echo {
 	e := echo.New()
 	e.Logger.SetLevel(conf.LogLevel)
+	e.HTTPErrorHandler = apierror.Handler
 
 	// middleware
+	e.Use(middleware.RequestID())
 	e.Use(middleware.GzipWithConfig(middleware.GzipConfig{
 		Level: 5,
 		Skipper: func(c echo.Context) bool {
@@ -68,6 +75,19 @@ func NewHTTPServer(
 		},
 	}))
 
+	// body limit: тело запроса ограничено после распаковки, запросы на запись данных
+	// ограничены по лимитам пользователя после аутентификации
+	if limit := conf.BodyLimit(); limit > 0 {
+		e.Use(middleware.BodyLimitWithConfig(middleware.BodyLimitConfig{
+			Skipper: func(c echo.Context) bool {
+				method := c.Request().Method
+				return (method == http.MethodPost && (c.Path() == router.ApiDataCreatePath || c.Path() == router.ApiDataBatchPath)) ||
+					(method == http.MethodPut && c.Path() == router.ApiDataUpdatePath)
+			},
+			Limit: strconv.FormatInt(limit, 10),
+		}))
+	}
+
 	jwtMiddleware := echojwt.WithConfig(echojwt.Config{
 		BeforeFunc: authService.BeforeFunc,
 		NewClaimsFunc: func(_ echo.Context) jwt.Claims {
@@ -81,22 +101,36 @@ func NewHTTPServer(
 
 	// routes
 	// GET /swagger — swagger;
+	// GET /api/version — версия сервера и API;
 	// POST /api/user/register — регистрация пользователя;
 	// POST /api/user/login — аутентификация пользователя;
+	// POST /api/user/refresh — обновление токенов;
+	// GET /api/user/usage — использование хранилища пользователем;
 	// GET /api/data — список данных;
 	// POST /api/data — создать данные;
+	// GET /api/data/expiring — список истекающих данных;
+	// POST /api/data/batch — пакетное создание, изменение и удаление данных;
 	// GET /api/data/:id — получить данные;
 	// PUT /api/data/:id — изменить данные;
 	// DELETE /api/data/:id — удалить данные;
+	// GET /api/admin/users/:id/quota — лимиты пользователя (администратор);
+	// PUT /api/admin/users/:id/quota — изменить лимиты пользователя (администратор);
 
 	e.GET("/swagger/*", echoSwagger.WrapHandler)
+	e.GET(router.ApiVersionPath, versionController.Version())
 	e.POST(router.ApiRegisterPath, userController.UserRegister())
 	e.POST(router.ApiLoginPath, userController.UserLogin())
+	e.POST(router.ApiRefreshPath, userController.UserRefresh())
+	e.GET(router.ApiUserUsagePath, userController.UserUsage(), jwtMiddleware)
 	e.GET(router.ApiDataListPath, dataController.DataIndex(), jwtMiddleware)
-	e.POST(router.ApiDataCreatePath, dataController.DataCreate(), jwtMiddleware)
+	e.POST(router.ApiDataCreatePath, dataController.DataCreate(), jwtMiddleware, dataController.BodyLimit(false))
+	e.GET(router.ApiDataExpiringPath, dataController.DataExpiring(), jwtMiddleware)
+	e.POST(router.ApiDataBatchPath, dataController.DataBatch(), jwtMiddleware, dataController.BodyLimit(true))
 	e.GET(router.ApiDataReadPath, dataController.DataRead(), jwtMiddleware)
-	e.PUT(router.ApiDataUpdatePath, dataController.DataUpdate(), jwtMiddleware)
+	e.PUT(router.ApiDataUpdatePath, dataController.DataUpdate(), jwtMiddleware, dataController.BodyLimit(false))
 	e.DELETE(router.ApiDataDeletePath, dataController.DataDelete(), jwtMiddleware)
+	e.GET(router.ApiAdminUserQuotaPath, adminController.AdminUserQuota(), jwtMiddleware, adminController.AdminOnly())
+	e.PUT(router.ApiAdminUserQuotaPath, adminController.AdminUpdateUserQuota(), jwtMiddleware, adminController.AdminOnly())
 
 	return e
 }
@@ -9,10 +9,12 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/ShukinDmitriy/GophKeeper/internal/common/models"
 	"github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
 	"github.com/ShukinDmitriy/GophKeeper/internal/common/router"
+	"github.com/ShukinDmitriy/GophKeeper/internal/common/version"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/ShukinDmitriy/GophKeeper/internal/test-helpers"
@@ -249,6 +251,39 @@ func userRefresh(t *testing.T, conf *config.Config) {
 			t.Errorf("Expected status code %d, got %d", successStatuses, resp.StatusCode)
 		}
 	})
+
+	t.Run("Refresh tokens", func(t *testing.T) {
+		req, err = http.NewRequest("POST", test_helpers.PrepareURL(conf, router.ApiRefreshPath), nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		req.AddCookie(cookie)
+
+		resp, err = client.Do(req)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer resp.Body.Close()
+
+		hasAccessCookie := slices.ContainsFunc(resp.Cookies(), func(c *http.Cookie) bool {
+			return c.Name == "access-token"
+		})
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.True(t, hasAccessCookie)
+	})
+
+	t.Run("Refresh tokens without refresh token", func(t *testing.T) {
+		resp, err = client.Post(test_helpers.PrepareURL(conf, router.ApiRefreshPath), "application/json", nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
 }
 
 func dataCRUD(t *testing.T, conf *config.Config) {
@@ -321,12 +356,19 @@ func dataCRUD(t *testing.T, conf *config.Config) {
 
 	var lastID uint
 
+	rotateAt := time.Now().AddDate(0, 0, 7).UTC().Truncate(time.Second)
+
 	t.Run("Success create data", func(t *testing.T) {
 		// Запрос для создания данных
 		data := requests.DataModel{
 			Type:        models.DataTypeText,
 			Description: "test data",
 			Value:       "test value",
+			Fields: models.DataFields{
+				{Name: "site", Type: models.FieldTypeURL, Value: "https://example.com"},
+				{Name: "pin", Type: models.FieldTypeHidden, Value: "1234"},
+			},
+			RotateAt: &rotateAt,
 		}
 		dataJson, _ := json.Marshal(data)
 		req, err = http.NewRequest("POST", test_helpers.PrepareURL(conf, router.ApiDataCreatePath), bytes.NewReader(dataJson))
@@ -363,10 +405,117 @@ func dataCRUD(t *testing.T, conf *config.Config) {
 		assert.Equal(t, resData.Type, data.Type)
 		assert.Equal(t, resData.Description, data.Description)
 		assert.Equal(t, resData.Value, data.Value)
+		assert.Equal(t, resData.Fields, data.Fields)
+		assert.True(t, rotateAt.Equal(*resData.RotateAt))
 
 		lastID = resData.ID
 	})
 
+	t.Run("Get user usage", func(t *testing.T) {
+		req, err = http.NewRequest("GET", test_helpers.PrepareURL(conf, router.ApiUserUsagePath), nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		// Добавление cookie к запросу
+		req.AddCookie(cookie)
+
+		resp, err = client.Do(req)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer resp.Body.Close()
+
+		resBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		var usage models.UserUsage
+		err = json.Unmarshal(resBody, &usage)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int64(1), usage.Items)
+		assert.Less(t, int64(0), usage.Bytes)
+		assert.Equal(t, conf.QuotaMaxItemSize, usage.MaxItemSize)
+	})
+
+	t.Run("Create too large data", func(t *testing.T) {
+		if conf.QuotaMaxItemSize <= 0 {
+			t.Skip("max item size is not limited")
+		}
+
+		data := requests.DataModel{
+			Type:  models.DataTypeText,
+			Value: strings.Repeat("a", int(conf.QuotaMaxItemSize)+1),
+		}
+		dataJson, _ := json.Marshal(data)
+		req, err = http.NewRequest("POST", test_helpers.PrepareURL(conf, router.ApiDataCreatePath), bytes.NewReader(dataJson))
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		req.Header.Set("Content-Type", "application/json")
+		// Добавление cookie к запросу
+		req.AddCookie(cookie)
+
+		resp, err = client.Do(req)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+	})
+
+	t.Run("Get expiring data", func(t *testing.T) {
+		for _, tt := range []struct {
+			days  string
+			count int
+		}{
+			{days: "1", count: 0},
+			{days: "30", count: 1},
+		} {
+			// Запрос для получения истекающих данных
+			req, err = http.NewRequest("GET", test_helpers.PrepareURL(conf, router.ApiDataExpiringPath)+"?days="+tt.days, nil)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			req.Header.Set("Content-Type", "application/json")
+			// Добавление cookie к запросу
+			req.AddCookie(cookie)
+
+			resp, err = client.Do(req)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			resBody, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			var list []models.DataInfo
+			err = json.Unmarshal(resBody, &list)
+			if err != nil {
+				t.Error(err)
+				return
+			}
+
+			assert.Equal(t, tt.count, len(list))
+		}
+	})
+
 	t.Run("Success get data", func(t *testing.T) {
 		// Запрос для получения данных
 		url := test_helpers.PrepareURL(conf, router.ApiDataReadPath)
@@ -497,6 +646,111 @@ func dataCRUD(t *testing.T, conf *config.Config) {
 
 		assert.Equal(t, resp.StatusCode, http.StatusAccepted)
 	})
+
+	batch := func(t *testing.T, dataBatch requests.DataBatch) (int, []models.DataBatchResult) {
+		dataJson, _ := json.Marshal(dataBatch)
+		req, err = http.NewRequest("POST", test_helpers.PrepareURL(conf, router.ApiDataBatchPath), bytes.NewReader(dataJson))
+		if err != nil {
+			t.Error(err)
+			return 0, nil
+		}
+		req.Header.Set("Content-Type", "application/json")
+		// Добавление cookie к запросу
+		req.AddCookie(cookie)
+
+		resp, err = client.Do(req)
+		if err != nil {
+			t.Error(err)
+			return 0, nil
+		}
+		defer resp.Body.Close()
+
+		resBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Error(err)
+			return 0, nil
+		}
+		var results []models.DataBatchResult
+		err = json.Unmarshal(resBody, &results)
+		if err != nil {
+			t.Error(err)
+			return 0, nil
+		}
+
+		return resp.StatusCode, results
+	}
+
+	t.Run("Batch data with partial failure", func(t *testing.T) {
+		status, results := batch(t, requests.DataBatch{
+			Operations: []requests.DataBatchOperation{
+				{Op: models.DataBatchCreate, Data: &requests.DataModel{Type: models.DataTypeText, Value: "batch value 1"}},
+				{Op: models.DataBatchCreate, Data: &requests.DataModel{Type: models.DataTypeText, Value: "batch value 2"}},
+				{Op: models.DataBatchDelete, ID: lastID},
+				{Op: models.DataBatchCreate, Data: &requests.DataModel{Type: models.DataTypeText}},
+			},
+		})
+
+		assert.Equal(t, http.StatusMultiStatus, status)
+		if assert.Equal(t, 4, len(results)) {
+			assert.Equal(t, http.StatusCreated, results[0].Status)
+			assert.Equal(t, http.StatusCreated, results[1].Status)
+			assert.NotEqual(t, results[0].ID, results[1].ID)
+			assert.Equal(t, http.StatusNotFound, results[2].Status)
+			assert.Equal(t, http.StatusBadRequest, results[3].Status)
+		}
+
+		// Удаляем созданные записи одним атомарным пакетом
+		status, results = batch(t, requests.DataBatch{
+			Atomic: true,
+			Operations: []requests.DataBatchOperation{
+				{Op: models.DataBatchDelete, ID: results[0].ID},
+				{Op: models.DataBatchDelete, ID: results[1].ID},
+			},
+		})
+
+		assert.Equal(t, http.StatusOK, status)
+		assert.Equal(t, 2, len(results))
+	})
+
+	t.Run("Atomic batch data rollback", func(t *testing.T) {
+		status, results := batch(t, requests.DataBatch{
+			Atomic: true,
+			Operations: []requests.DataBatchOperation{
+				{Op: models.DataBatchCreate, Data: &requests.DataModel{Type: models.DataTypeText, Value: "rolled back"}},
+				{Op: models.DataBatchUpdate, ID: lastID, Data: &requests.DataModel{Type: models.DataTypeText, Value: "x"}},
+				{Op: models.DataBatchDelete, ID: lastID + 1000},
+			},
+		})
+
+		assert.Equal(t, http.StatusUnprocessableEntity, status)
+		if assert.Equal(t, 3, len(results)) {
+			assert.Equal(t, http.StatusFailedDependency, results[0].Status)
+			assert.Nil(t, results[0].Data)
+			assert.Equal(t, http.StatusNotFound, results[1].Status)
+			assert.Equal(t, http.StatusFailedDependency, results[2].Status)
+		}
+	})
+}
+
+func serverVersion(t *testing.T, conf *config.Config) {
+	t.Run("Get server version", func(t *testing.T) {
+		resp, err := http.Get(test_helpers.PrepareURL(conf, router.ApiVersionPath))
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer resp.Body.Close()
+
+		var info version.Info
+		err = json.NewDecoder(resp.Body).Decode(&info)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, version.Get(), info)
+	})
 }
 
 func TestServer(t *testing.T) {
@@ -504,6 +758,7 @@ func TestServer(t *testing.T) {
 	_, conf, httpServer := test_helpers.RunServer(t)
 
 	// Запуск тестов сервера
+	serverVersion(t, conf)
 	userRegister(t, conf)
 	userLogin(t, conf)
 	userRefresh(t, conf)
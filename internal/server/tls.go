@@ -0,0 +1,40 @@
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/config"
+)
+
+// NewTLSConfig - настройки TLS сервера: сертификат, минимальная версия протокола и,
+// если указан CA клиентов, обязательная проверка клиентского сертификата
+func NewTLSConfig(conf *config.Config) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(conf.TLSCert, conf.TLSKey)
+	if err != nil {
+		return nil, fmt.Errorf("load server certificate %s: %w", conf.TLSCert, err)
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   conf.TLSMinVersion,
+	}
+
+	if conf.TLSClientCA != "" {
+		caCert, err := os.ReadFile(conf.TLSClientCA)
+		if err != nil {
+			return nil, fmt.Errorf("read client CA: %w", err)
+		}
+		clientCAs := x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no certificates in %s", conf.TLSClientCA)
+		}
+
+		tlsConfig.ClientCAs = clientCAs
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsConfig, nil
+}
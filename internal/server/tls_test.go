@@ -0,0 +1,79 @@
+package server_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ShukinDmitriy/GophKeeper/internal/server"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeCertificate - создать самоподписанный сертификат и ключ в каталоге dir
+func writeCertificate(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPath := filepath.Join(dir, "server.crt")
+	keyPath := filepath.Join(dir, "server.key")
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+
+	return certPath, keyPath
+}
+
+func TestNewTLSConfig(t *testing.T) {
+	certPath, keyPath := writeCertificate(t, t.TempDir())
+
+	tlsConfig, err := server.NewTLSConfig(&config.Config{
+		TLSCert:       certPath,
+		TLSKey:        keyPath,
+		TLSMinVersion: tls.VersionTLS13,
+	})
+	require.NoError(t, err)
+	assert.Len(t, tlsConfig.Certificates, 1)
+	assert.Equal(t, uint16(tls.VersionTLS13), tlsConfig.MinVersion)
+	assert.Equal(t, tls.NoClientCert, tlsConfig.ClientAuth)
+
+	// Сертификат клиента проверяется, если указан CA клиентов
+	tlsConfig, err = server.NewTLSConfig(&config.Config{
+		TLSCert:     certPath,
+		TLSKey:      keyPath,
+		TLSClientCA: certPath,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, tls.RequireAndVerifyClientCert, tlsConfig.ClientAuth)
+	assert.NotNil(t, tlsConfig.ClientCAs)
+
+	_, err = server.NewTLSConfig(&config.Config{TLSCert: certPath, TLSKey: certPath})
+	assert.Error(t, err)
+
+	_, err = server.NewTLSConfig(&config.Config{TLSCert: certPath, TLSKey: keyPath, TLSClientCA: keyPath})
+	assert.Error(t, err)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/auth"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/config"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/controllers"
+	"github.com/ShukinDmitriy/GophKeeper/internal/server/quota"
 	"github.com/ShukinDmitriy/GophKeeper/internal/server/repositories"
 	"github.com/labstack/echo/v4"
 	"gorm.io/driver/postgres"
@@ -39,15 +40,26 @@ func createServer(t *testing.T, conf *config.Config) *echo.Echo {
 	}
 	userRepository := repositories.NewUserRepository(db)
 	dataRepository := repositories.NewDataRepository(db)
+	quotaRepository := repositories.NewQuotaRepository(db)
 	authUser := auth.NewAuthUser(userRepository)
 	authService := auth.NewAuthService(*authUser)
+	quotaService := quota.NewQuotaService(conf, quotaRepository)
 	userController := controllers.NewUserController(
 		authService,
 		userRepository,
+		quotaService,
 	)
 	dataController := controllers.NewDataController(
 		authService,
 		dataRepository,
+		quotaService,
+	)
+	adminController := controllers.NewAdminController(
+		conf,
+		authService,
+		userRepository,
+		quotaRepository,
+		quotaService,
 	)
 
 	httpServer := server.NewHTTPServer(
@@ -55,6 +67,8 @@ func createServer(t *testing.T, conf *config.Config) *echo.Echo {
 		authService,
 		userController,
 		dataController,
+		adminController,
+		controllers.NewVersionController(),
 	)
 
 	go func() {
@@ -0,0 +1,88 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package breach
+
+import mock "github.com/stretchr/testify/mock"
+
+// ListInterface is an autogenerated mock type for the ListInterface type
+type ListInterface struct {
+	mock.Mock
+}
+
+type ListInterface_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ListInterface) EXPECT() *ListInterface_Expecter {
+	return &ListInterface_Expecter{mock: &_m.Mock}
+}
+
+// Count provides a mock function with given fields: password
+func (_m *ListInterface) Count(password string) (int, error) {
+	ret := _m.Called(password)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Count")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (int, error)); ok {
+		return rf(password)
+	}
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(password)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(password)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListInterface_Count_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Count'
+type ListInterface_Count_Call struct {
+	*mock.Call
+}
+
+// Count is a helper method to define mock.On call
+//   - password string
+func (_e *ListInterface_Expecter) Count(password interface{}) *ListInterface_Count_Call {
+	return &ListInterface_Count_Call{Call: _e.mock.On("Count", password)}
+}
+
+func (_c *ListInterface_Count_Call) Run(run func(password string)) *ListInterface_Count_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *ListInterface_Count_Call) Return(_a0 int, _a1 error) *ListInterface_Count_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListInterface_Count_Call) RunAndReturn(run func(string) (int, error)) *ListInterface_Count_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewListInterface creates a new instance of ListInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewListInterface(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ListInterface {
+	mock := &ListInterface{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -9,7 +9,11 @@ import (
 
 	models "github.com/ShukinDmitriy/GophKeeper/internal/common/models"
 
+	nethttp "net/http"
+
 	requests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
+
+	version "github.com/ShukinDmitriy/GophKeeper/internal/common/version"
 )
 
 // ClientInterface is an autogenerated mock type for the ClientInterface type
@@ -25,6 +29,112 @@ func (_m *ClientInterface) EXPECT() *ClientInterface_Expecter {
 	return &ClientInterface_Expecter{mock: &_m.Mock}
 }
 
+// Batch provides a mock function with given fields: ctx, data
+func (_m *ClientInterface) Batch(ctx context.Context, data requests.DataBatch) ([]models.DataBatchResult, error) {
+	ret := _m.Called(ctx, data)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Batch")
+	}
+
+	var r0 []models.DataBatchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, requests.DataBatch) ([]models.DataBatchResult, error)); ok {
+		return rf(ctx, data)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, requests.DataBatch) []models.DataBatchResult); ok {
+		r0 = rf(ctx, data)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DataBatchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, requests.DataBatch) error); ok {
+		r1 = rf(ctx, data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientInterface_Batch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Batch'
+type ClientInterface_Batch_Call struct {
+	*mock.Call
+}
+
+// Batch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - data requests.DataBatch
+func (_e *ClientInterface_Expecter) Batch(ctx interface{}, data interface{}) *ClientInterface_Batch_Call {
+	return &ClientInterface_Batch_Call{Call: _e.mock.On("Batch", ctx, data)}
+}
+
+func (_c *ClientInterface_Batch_Call) Run(run func(ctx context.Context, data requests.DataBatch)) *ClientInterface_Batch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(requests.DataBatch))
+	})
+	return _c
+}
+
+func (_c *ClientInterface_Batch_Call) Return(_a0 []models.DataBatchResult, _a1 error) *ClientInterface_Batch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientInterface_Batch_Call) RunAndReturn(run func(context.Context, requests.DataBatch) ([]models.DataBatchResult, error)) *ClientInterface_Batch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Cookies provides a mock function with no fields
+func (_m *ClientInterface) Cookies() []*nethttp.Cookie {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cookies")
+	}
+
+	var r0 []*nethttp.Cookie
+	if rf, ok := ret.Get(0).(func() []*nethttp.Cookie); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*nethttp.Cookie)
+		}
+	}
+
+	return r0
+}
+
+// ClientInterface_Cookies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cookies'
+type ClientInterface_Cookies_Call struct {
+	*mock.Call
+}
+
+// Cookies is a helper method to define mock.On call
+func (_e *ClientInterface_Expecter) Cookies() *ClientInterface_Cookies_Call {
+	return &ClientInterface_Cookies_Call{Call: _e.mock.On("Cookies")}
+}
+
+func (_c *ClientInterface_Cookies_Call) Run(run func()) *ClientInterface_Cookies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ClientInterface_Cookies_Call) Return(_a0 []*nethttp.Cookie) *ClientInterface_Cookies_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClientInterface_Cookies_Call) RunAndReturn(run func() []*nethttp.Cookie) *ClientInterface_Cookies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateData provides a mock function with given fields: ctx, data
 func (_m *ClientInterface) CreateData(ctx context.Context, data requests.DataModel) (*models.DataInfo, error) {
 	ret := _m.Called(ctx, data)
@@ -131,6 +241,124 @@ func (_c *ClientInterface_DeleteData_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetData provides a mock function with given fields: ctx, id
+func (_m *ClientInterface) GetData(ctx context.Context, id uint) (*models.DataInfo, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetData")
+	}
+
+	var r0 *models.DataInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint) (*models.DataInfo, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint) *models.DataInfo); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.DataInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientInterface_GetData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetData'
+type ClientInterface_GetData_Call struct {
+	*mock.Call
+}
+
+// GetData is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uint
+func (_e *ClientInterface_Expecter) GetData(ctx interface{}, id interface{}) *ClientInterface_GetData_Call {
+	return &ClientInterface_GetData_Call{Call: _e.mock.On("GetData", ctx, id)}
+}
+
+func (_c *ClientInterface_GetData_Call) Run(run func(ctx context.Context, id uint)) *ClientInterface_GetData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(uint))
+	})
+	return _c
+}
+
+func (_c *ClientInterface_GetData_Call) Return(_a0 *models.DataInfo, _a1 error) *ClientInterface_GetData_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientInterface_GetData_Call) RunAndReturn(run func(context.Context, uint) (*models.DataInfo, error)) *ClientInterface_GetData_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetExpiring provides a mock function with given fields: ctx, days
+func (_m *ClientInterface) GetExpiring(ctx context.Context, days int) ([]models.DataInfo, error) {
+	ret := _m.Called(ctx, days)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetExpiring")
+	}
+
+	var r0 []models.DataInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]models.DataInfo, error)); ok {
+		return rf(ctx, days)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []models.DataInfo); ok {
+		r0 = rf(ctx, days)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DataInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientInterface_GetExpiring_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetExpiring'
+type ClientInterface_GetExpiring_Call struct {
+	*mock.Call
+}
+
+// GetExpiring is a helper method to define mock.On call
+//   - ctx context.Context
+//   - days int
+func (_e *ClientInterface_Expecter) GetExpiring(ctx interface{}, days interface{}) *ClientInterface_GetExpiring_Call {
+	return &ClientInterface_GetExpiring_Call{Call: _e.mock.On("GetExpiring", ctx, days)}
+}
+
+func (_c *ClientInterface_GetExpiring_Call) Run(run func(ctx context.Context, days int)) *ClientInterface_GetExpiring_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int))
+	})
+	return _c
+}
+
+func (_c *ClientInterface_GetExpiring_Call) Return(_a0 []models.DataInfo, _a1 error) *ClientInterface_GetExpiring_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientInterface_GetExpiring_Call) RunAndReturn(run func(context.Context, int) ([]models.DataInfo, error)) *ClientInterface_GetExpiring_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetList provides a mock function with given fields: ctx, dataType
 func (_m *ClientInterface) GetList(ctx context.Context, dataType models.DataType) ([]models.DataInfo, error) {
 	ret := _m.Called(ctx, dataType)
@@ -190,6 +418,122 @@ func (_c *ClientInterface_GetList_Call) RunAndReturn(run func(context.Context, m
 	return _c
 }
 
+// GetUsage provides a mock function with given fields: ctx
+func (_m *ClientInterface) GetUsage(ctx context.Context) (*models.UserUsage, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsage")
+	}
+
+	var r0 *models.UserUsage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*models.UserUsage, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *models.UserUsage); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.UserUsage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientInterface_GetUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsage'
+type ClientInterface_GetUsage_Call struct {
+	*mock.Call
+}
+
+// GetUsage is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ClientInterface_Expecter) GetUsage(ctx interface{}) *ClientInterface_GetUsage_Call {
+	return &ClientInterface_GetUsage_Call{Call: _e.mock.On("GetUsage", ctx)}
+}
+
+func (_c *ClientInterface_GetUsage_Call) Run(run func(ctx context.Context)) *ClientInterface_GetUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ClientInterface_GetUsage_Call) Return(_a0 *models.UserUsage, _a1 error) *ClientInterface_GetUsage_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientInterface_GetUsage_Call) RunAndReturn(run func(context.Context) (*models.UserUsage, error)) *ClientInterface_GetUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetVersion provides a mock function with given fields: ctx
+func (_m *ClientInterface) GetVersion(ctx context.Context) (*version.Info, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetVersion")
+	}
+
+	var r0 *version.Info
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (*version.Info, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) *version.Info); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*version.Info)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ClientInterface_GetVersion_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetVersion'
+type ClientInterface_GetVersion_Call struct {
+	*mock.Call
+}
+
+// GetVersion is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ClientInterface_Expecter) GetVersion(ctx interface{}) *ClientInterface_GetVersion_Call {
+	return &ClientInterface_GetVersion_Call{Call: _e.mock.On("GetVersion", ctx)}
+}
+
+func (_c *ClientInterface_GetVersion_Call) Run(run func(ctx context.Context)) *ClientInterface_GetVersion_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ClientInterface_GetVersion_Call) Return(_a0 *version.Info, _a1 error) *ClientInterface_GetVersion_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ClientInterface_GetVersion_Call) RunAndReturn(run func(context.Context) (*version.Info, error)) *ClientInterface_GetVersion_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Login provides a mock function with given fields: ctx, data
 func (_m *ClientInterface) Login(ctx context.Context, data requests.UserLogin) error {
 	ret := _m.Called(ctx, data)
@@ -237,6 +581,52 @@ func (_c *ClientInterface_Login_Call) RunAndReturn(run func(context.Context, req
 	return _c
 }
 
+// Refresh provides a mock function with given fields: ctx
+func (_m *ClientInterface) Refresh(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Refresh")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClientInterface_Refresh_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Refresh'
+type ClientInterface_Refresh_Call struct {
+	*mock.Call
+}
+
+// Refresh is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ClientInterface_Expecter) Refresh(ctx interface{}) *ClientInterface_Refresh_Call {
+	return &ClientInterface_Refresh_Call{Call: _e.mock.On("Refresh", ctx)}
+}
+
+func (_c *ClientInterface_Refresh_Call) Run(run func(ctx context.Context)) *ClientInterface_Refresh_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *ClientInterface_Refresh_Call) Return(_a0 error) *ClientInterface_Refresh_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClientInterface_Refresh_Call) RunAndReturn(run func(context.Context) error) *ClientInterface_Refresh_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Register provides a mock function with given fields: ctx, data
 func (_m *ClientInterface) Register(ctx context.Context, data requests.UserRegister) error {
 	ret := _m.Called(ctx, data)
@@ -284,6 +674,84 @@ func (_c *ClientInterface_Register_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// Reset provides a mock function with no fields
+func (_m *ClientInterface) Reset() error {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reset")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClientInterface_Reset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reset'
+type ClientInterface_Reset_Call struct {
+	*mock.Call
+}
+
+// Reset is a helper method to define mock.On call
+func (_e *ClientInterface_Expecter) Reset() *ClientInterface_Reset_Call {
+	return &ClientInterface_Reset_Call{Call: _e.mock.On("Reset")}
+}
+
+func (_c *ClientInterface_Reset_Call) Run(run func()) *ClientInterface_Reset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *ClientInterface_Reset_Call) Return(_a0 error) *ClientInterface_Reset_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ClientInterface_Reset_Call) RunAndReturn(run func() error) *ClientInterface_Reset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetCookies provides a mock function with given fields: cookies
+func (_m *ClientInterface) SetCookies(cookies []*nethttp.Cookie) {
+	_m.Called(cookies)
+}
+
+// ClientInterface_SetCookies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetCookies'
+type ClientInterface_SetCookies_Call struct {
+	*mock.Call
+}
+
+// SetCookies is a helper method to define mock.On call
+//   - cookies []*nethttp.Cookie
+func (_e *ClientInterface_Expecter) SetCookies(cookies interface{}) *ClientInterface_SetCookies_Call {
+	return &ClientInterface_SetCookies_Call{Call: _e.mock.On("SetCookies", cookies)}
+}
+
+func (_c *ClientInterface_SetCookies_Call) Run(run func(cookies []*nethttp.Cookie)) *ClientInterface_SetCookies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*nethttp.Cookie))
+	})
+	return _c
+}
+
+func (_c *ClientInterface_SetCookies_Call) Return() *ClientInterface_SetCookies_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *ClientInterface_SetCookies_Call) RunAndReturn(run func([]*nethttp.Cookie)) *ClientInterface_SetCookies_Call {
+	_c.Run(run)
+	return _c
+}
+
 // UpdateData provides a mock function with given fields: ctx, data
 func (_m *ClientInterface) UpdateData(ctx context.Context, data models.DataInfo) (*models.DataInfo, error) {
 	ret := _m.Called(ctx, data)
@@ -0,0 +1,93 @@
+// Code generated by mockery v2.43.2. DO NOT EDIT.
+
+package session
+
+import (
+	session "github.com/ShukinDmitriy/GophKeeper/internal/client/session"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StoreFactory is an autogenerated mock type for the StoreFactory type
+type StoreFactory struct {
+	mock.Mock
+}
+
+type StoreFactory_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StoreFactory) EXPECT() *StoreFactory_Expecter {
+	return &StoreFactory_Expecter{mock: &_m.Mock}
+}
+
+// Execute provides a mock function with given fields: profile
+func (_m *StoreFactory) Execute(profile string) (session.StoreInterface, error) {
+	ret := _m.Called(profile)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Execute")
+	}
+
+	var r0 session.StoreInterface
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (session.StoreInterface, error)); ok {
+		return rf(profile)
+	}
+	if rf, ok := ret.Get(0).(func(string) session.StoreInterface); ok {
+		r0 = rf(profile)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(session.StoreInterface)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(profile)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StoreFactory_Execute_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Execute'
+type StoreFactory_Execute_Call struct {
+	*mock.Call
+}
+
+// Execute is a helper method to define mock.On call
+//   - profile string
+func (_e *StoreFactory_Expecter) Execute(profile interface{}) *StoreFactory_Execute_Call {
+	return &StoreFactory_Execute_Call{Call: _e.mock.On("Execute", profile)}
+}
+
+func (_c *StoreFactory_Execute_Call) Run(run func(profile string)) *StoreFactory_Execute_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *StoreFactory_Execute_Call) Return(_a0 session.StoreInterface, _a1 error) *StoreFactory_Execute_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StoreFactory_Execute_Call) RunAndReturn(run func(string) (session.StoreInterface, error)) *StoreFactory_Execute_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStoreFactory creates a new instance of StoreFactory. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStoreFactory(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StoreFactory {
+	mock := &StoreFactory{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
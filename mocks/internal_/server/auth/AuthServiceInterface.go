@@ -115,6 +115,64 @@ func (_c *AuthServiceInterface_GetUserID_Call) RunAndReturn(run func(echo.Contex
 	return _c
 }
 
+// RefreshTokens provides a mock function with given fields: c
+func (_m *AuthServiceInterface) RefreshTokens(c echo.Context) (*responses.UserInfo, error) {
+	ret := _m.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RefreshTokens")
+	}
+
+	var r0 *responses.UserInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(echo.Context) (*responses.UserInfo, error)); ok {
+		return rf(c)
+	}
+	if rf, ok := ret.Get(0).(func(echo.Context) *responses.UserInfo); ok {
+		r0 = rf(c)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*responses.UserInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(echo.Context) error); ok {
+		r1 = rf(c)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthServiceInterface_RefreshTokens_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RefreshTokens'
+type AuthServiceInterface_RefreshTokens_Call struct {
+	*mock.Call
+}
+
+// RefreshTokens is a helper method to define mock.On call
+//   - c echo.Context
+func (_e *AuthServiceInterface_Expecter) RefreshTokens(c interface{}) *AuthServiceInterface_RefreshTokens_Call {
+	return &AuthServiceInterface_RefreshTokens_Call{Call: _e.mock.On("RefreshTokens", c)}
+}
+
+func (_c *AuthServiceInterface_RefreshTokens_Call) Run(run func(c echo.Context)) *AuthServiceInterface_RefreshTokens_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(echo.Context))
+	})
+	return _c
+}
+
+func (_c *AuthServiceInterface_RefreshTokens_Call) Return(_a0 *responses.UserInfo, _a1 error) *AuthServiceInterface_RefreshTokens_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AuthServiceInterface_RefreshTokens_Call) RunAndReturn(run func(echo.Context) (*responses.UserInfo, error)) *AuthServiceInterface_RefreshTokens_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewAuthServiceInterface creates a new instance of AuthServiceInterface. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewAuthServiceInterface(t interface {
@@ -4,6 +4,7 @@ package repositories
 
 import (
 	models "github.com/ShukinDmitriy/GophKeeper/internal/common/models"
+	repositories "github.com/ShukinDmitriy/GophKeeper/internal/server/repositories"
 	mock "github.com/stretchr/testify/mock"
 
 	requests "github.com/ShukinDmitriy/GophKeeper/internal/common/models/requests"
@@ -22,9 +23,68 @@ func (_m *DataRepositoryInterface) EXPECT() *DataRepositoryInterface_Expecter {
 	return &DataRepositoryInterface_Expecter{mock: &_m.Mock}
 }
 
-// Create provides a mock function with given fields: dataCreate
-func (_m *DataRepositoryInterface) Create(dataCreate requests.DataModel) (*models.DataInfo, error) {
-	ret := _m.Called(dataCreate)
+// Batch provides a mock function with given fields: request, guard
+func (_m *DataRepositoryInterface) Batch(request requests.DataBatch, guard repositories.QuotaGuard) ([]repositories.BatchResult, error) {
+	ret := _m.Called(request, guard)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Batch")
+	}
+
+	var r0 []repositories.BatchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(requests.DataBatch, repositories.QuotaGuard) ([]repositories.BatchResult, error)); ok {
+		return rf(request, guard)
+	}
+	if rf, ok := ret.Get(0).(func(requests.DataBatch, repositories.QuotaGuard) []repositories.BatchResult); ok {
+		r0 = rf(request, guard)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]repositories.BatchResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(requests.DataBatch, repositories.QuotaGuard) error); ok {
+		r1 = rf(request, guard)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataRepositoryInterface_Batch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Batch'
+type DataRepositoryInterface_Batch_Call struct {
+	*mock.Call
+}
+
+// Batch is a helper method to define mock.On call
+//   - request requests.DataBatch
+//   - guard repositories.QuotaGuard
+func (_e *DataRepositoryInterface_Expecter) Batch(request interface{}, guard interface{}) *DataRepositoryInterface_Batch_Call {
+	return &DataRepositoryInterface_Batch_Call{Call: _e.mock.On("Batch", request, guard)}
+}
+
+func (_c *DataRepositoryInterface_Batch_Call) Run(run func(request requests.DataBatch, guard repositories.QuotaGuard)) *DataRepositoryInterface_Batch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(requests.DataBatch), args[1].(repositories.QuotaGuard))
+	})
+	return _c
+}
+
+func (_c *DataRepositoryInterface_Batch_Call) Return(_a0 []repositories.BatchResult, _a1 error) *DataRepositoryInterface_Batch_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataRepositoryInterface_Batch_Call) RunAndReturn(run func(requests.DataBatch, repositories.QuotaGuard) ([]repositories.BatchResult, error)) *DataRepositoryInterface_Batch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: dataCreate, guard
+func (_m *DataRepositoryInterface) Create(dataCreate requests.DataModel, guard repositories.QuotaGuard) (*models.DataInfo, error) {
+	ret := _m.Called(dataCreate, guard)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
@@ -32,19 +92,19 @@ func (_m *DataRepositoryInterface) Create(dataCreate requests.DataModel) (*model
 
 	var r0 *models.DataInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(requests.DataModel) (*models.DataInfo, error)); ok {
-		return rf(dataCreate)
+	if rf, ok := ret.Get(0).(func(requests.DataModel, repositories.QuotaGuard) (*models.DataInfo, error)); ok {
+		return rf(dataCreate, guard)
 	}
-	if rf, ok := ret.Get(0).(func(requests.DataModel) *models.DataInfo); ok {
-		r0 = rf(dataCreate)
+	if rf, ok := ret.Get(0).(func(requests.DataModel, repositories.QuotaGuard) *models.DataInfo); ok {
+		r0 = rf(dataCreate, guard)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.DataInfo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(requests.DataModel) error); ok {
-		r1 = rf(dataCreate)
+	if rf, ok := ret.Get(1).(func(requests.DataModel, repositories.QuotaGuard) error); ok {
+		r1 = rf(dataCreate, guard)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -59,13 +119,14 @@ type DataRepositoryInterface_Create_Call struct {
 
 // Create is a helper method to define mock.On call
 //   - dataCreate requests.DataModel
-func (_e *DataRepositoryInterface_Expecter) Create(dataCreate interface{}) *DataRepositoryInterface_Create_Call {
-	return &DataRepositoryInterface_Create_Call{Call: _e.mock.On("Create", dataCreate)}
+//   - guard repositories.QuotaGuard
+func (_e *DataRepositoryInterface_Expecter) Create(dataCreate interface{}, guard interface{}) *DataRepositoryInterface_Create_Call {
+	return &DataRepositoryInterface_Create_Call{Call: _e.mock.On("Create", dataCreate, guard)}
 }
 
-func (_c *DataRepositoryInterface_Create_Call) Run(run func(dataCreate requests.DataModel)) *DataRepositoryInterface_Create_Call {
+func (_c *DataRepositoryInterface_Create_Call) Run(run func(dataCreate requests.DataModel, guard repositories.QuotaGuard)) *DataRepositoryInterface_Create_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(requests.DataModel))
+		run(args[0].(requests.DataModel), args[1].(repositories.QuotaGuard))
 	})
 	return _c
 }
@@ -75,7 +136,7 @@ func (_c *DataRepositoryInterface_Create_Call) Return(_a0 *models.DataInfo, _a1
 	return _c
 }
 
-func (_c *DataRepositoryInterface_Create_Call) RunAndReturn(run func(requests.DataModel) (*models.DataInfo, error)) *DataRepositoryInterface_Create_Call {
+func (_c *DataRepositoryInterface_Create_Call) RunAndReturn(run func(requests.DataModel, repositories.QuotaGuard) (*models.DataInfo, error)) *DataRepositoryInterface_Create_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -127,6 +188,64 @@ func (_c *DataRepositoryInterface_Delete_Call) RunAndReturn(run func(uint, uint)
 	return _c
 }
 
+// Expiring provides a mock function with given fields: request
+func (_m *DataRepositoryInterface) Expiring(request requests.DataExpiring) ([]*models.DataInfo, error) {
+	ret := _m.Called(request)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Expiring")
+	}
+
+	var r0 []*models.DataInfo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(requests.DataExpiring) ([]*models.DataInfo, error)); ok {
+		return rf(request)
+	}
+	if rf, ok := ret.Get(0).(func(requests.DataExpiring) []*models.DataInfo); ok {
+		r0 = rf(request)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.DataInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(requests.DataExpiring) error); ok {
+		r1 = rf(request)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DataRepositoryInterface_Expiring_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Expiring'
+type DataRepositoryInterface_Expiring_Call struct {
+	*mock.Call
+}
+
+// Expiring is a helper method to define mock.On call
+//   - request requests.DataExpiring
+func (_e *DataRepositoryInterface_Expecter) Expiring(request interface{}) *DataRepositoryInterface_Expiring_Call {
+	return &DataRepositoryInterface_Expiring_Call{Call: _e.mock.On("Expiring", request)}
+}
+
+func (_c *DataRepositoryInterface_Expiring_Call) Run(run func(request requests.DataExpiring)) *DataRepositoryInterface_Expiring_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(requests.DataExpiring))
+	})
+	return _c
+}
+
+func (_c *DataRepositoryInterface_Expiring_Call) Return(_a0 []*models.DataInfo, _a1 error) *DataRepositoryInterface_Expiring_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *DataRepositoryInterface_Expiring_Call) RunAndReturn(run func(requests.DataExpiring) ([]*models.DataInfo, error)) *DataRepositoryInterface_Expiring_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Find provides a mock function with given fields: id, userID
 func (_m *DataRepositoryInterface) Find(id uint, userID uint) (*models.DataInfo, error) {
 	ret := _m.Called(id, userID)
@@ -244,9 +363,9 @@ func (_c *DataRepositoryInterface_List_Call) RunAndReturn(run func(requests.Data
 	return _c
 }
 
-// Update provides a mock function with given fields: id, request
-func (_m *DataRepositoryInterface) Update(id uint, request requests.DataModel) (*models.DataInfo, error) {
-	ret := _m.Called(id, request)
+// Update provides a mock function with given fields: id, request, guard
+func (_m *DataRepositoryInterface) Update(id uint, request requests.DataModel, guard repositories.QuotaGuard) (*models.DataInfo, error) {
+	ret := _m.Called(id, request, guard)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -254,19 +373,19 @@ func (_m *DataRepositoryInterface) Update(id uint, request requests.DataModel) (
 
 	var r0 *models.DataInfo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint, requests.DataModel) (*models.DataInfo, error)); ok {
-		return rf(id, request)
+	if rf, ok := ret.Get(0).(func(uint, requests.DataModel, repositories.QuotaGuard) (*models.DataInfo, error)); ok {
+		return rf(id, request, guard)
 	}
-	if rf, ok := ret.Get(0).(func(uint, requests.DataModel) *models.DataInfo); ok {
-		r0 = rf(id, request)
+	if rf, ok := ret.Get(0).(func(uint, requests.DataModel, repositories.QuotaGuard) *models.DataInfo); ok {
+		r0 = rf(id, request, guard)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*models.DataInfo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(uint, requests.DataModel) error); ok {
-		r1 = rf(id, request)
+	if rf, ok := ret.Get(1).(func(uint, requests.DataModel, repositories.QuotaGuard) error); ok {
+		r1 = rf(id, request, guard)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -282,13 +401,14 @@ type DataRepositoryInterface_Update_Call struct {
 // Update is a helper method to define mock.On call
 //   - id uint
 //   - request requests.DataModel
-func (_e *DataRepositoryInterface_Expecter) Update(id interface{}, request interface{}) *DataRepositoryInterface_Update_Call {
-	return &DataRepositoryInterface_Update_Call{Call: _e.mock.On("Update", id, request)}
+//   - guard repositories.QuotaGuard
+func (_e *DataRepositoryInterface_Expecter) Update(id interface{}, request interface{}, guard interface{}) *DataRepositoryInterface_Update_Call {
+	return &DataRepositoryInterface_Update_Call{Call: _e.mock.On("Update", id, request, guard)}
 }
 
-func (_c *DataRepositoryInterface_Update_Call) Run(run func(id uint, request requests.DataModel)) *DataRepositoryInterface_Update_Call {
+func (_c *DataRepositoryInterface_Update_Call) Run(run func(id uint, request requests.DataModel, guard repositories.QuotaGuard)) *DataRepositoryInterface_Update_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(uint), args[1].(requests.DataModel))
+		run(args[0].(uint), args[1].(requests.DataModel), args[2].(repositories.QuotaGuard))
 	})
 	return _c
 }
@@ -298,7 +418,7 @@ func (_c *DataRepositoryInterface_Update_Call) Return(_a0 *models.DataInfo, _a1
 	return _c
 }
 
-func (_c *DataRepositoryInterface_Update_Call) RunAndReturn(run func(uint, requests.DataModel) (*models.DataInfo, error)) *DataRepositoryInterface_Update_Call {
+func (_c *DataRepositoryInterface_Update_Call) RunAndReturn(run func(uint, requests.DataModel, repositories.QuotaGuard) (*models.DataInfo, error)) *DataRepositoryInterface_Update_Call {
 	_c.Call.Return(run)
 	return _c
 }